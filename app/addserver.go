@@ -0,0 +1,99 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+// AddServerNameStep marks the first step (name entry) of the add-server
+// dialog flow, so handleInputResult can tell it apart from the unrelated
+// new-key name step, which also has no meaningful context of its own.
+type AddServerNameStep struct{}
+
+// AddServerAddressContext carries the already-entered server name into the
+// second step (address entry) of the add-server dialog flow.
+type AddServerAddressContext struct {
+	Name string
+}
+
+// SwitchToServerContext carries the address of a newly added server through
+// the "switch to it now?" confirmation dialog.
+type SwitchToServerContext struct {
+	Name    string
+	Address string
+}
+
+// ServerAddedMsg is sent when a new server has been validated and persisted.
+type ServerAddedMsg struct {
+	Name    string
+	Address string
+}
+
+// ServerAddErrorMsg is sent when adding a server fails, e.g. a duplicate
+// name or an address that doesn't pass config.ServerConfig.Validate.
+type ServerAddErrorMsg struct {
+	Err error
+}
+
+// CreateAddServerNameDialog creates the first step of the add-server flow:
+// an input dialog for the server's human-readable name.
+func CreateAddServerNameDialog() *dialog.InputDialog {
+	return dialog.NewInput("Add Server").
+		WithPlaceholder("Enter server name...").
+		WithValidator(func(name string) error {
+			if name == "" {
+				return errors.New("server name cannot be empty")
+			}
+			return nil
+		}).
+		WithLiveValidation().
+		WithContext(AddServerNameStep{})
+}
+
+// CreateAddServerAddressDialog creates the second step of the add-server
+// flow: an input dialog for the host:port address, validated with the same
+// rules config.AddServer enforces on persist.
+func CreateAddServerAddressDialog(name string) *dialog.InputDialog {
+	title := fmt.Sprintf("Add Server: %s", name)
+	return dialog.NewInput(title).
+		WithPlaceholder("Enter address (host:port)...").
+		WithValidator(func(address string) error {
+			serverCfg := config.ServerConfig{Name: name, Address: address}
+			return serverCfg.Validate()
+		}).
+		WithLiveValidation().
+		WithContext(AddServerAddressContext{Name: name})
+}
+
+// ExtractAddServerAddressContext extracts the pending server name from an
+// input result's context, for the address step of the add-server flow.
+func ExtractAddServerAddressContext(ctx interface{}) (string, bool) {
+	addrCtx, ok := ctx.(AddServerAddressContext)
+	if !ok {
+		return "", false
+	}
+	return addrCtx.Name, true
+}
+
+// AddServerCmd validates and persists a new server via config.AddServer.
+// Returns ServerAddedMsg on success or ServerAddErrorMsg on failure.
+func AddServerCmd(name, address string) tea.Cmd {
+	return func() tea.Msg {
+		if err := config.AddServer(name, address); err != nil {
+			return ServerAddErrorMsg{Err: err}
+		}
+		return ServerAddedMsg{Name: name, Address: address}
+	}
+}
+
+// CreateSwitchToServerConfirmDialog creates a confirmation dialog offering
+// to switch to a just-added server immediately.
+func CreateSwitchToServerConfirmDialog(name, address string) *dialog.ConfirmDialog {
+	title := "Server Added"
+	message := fmt.Sprintf("Added server %q (%s). Switch to it now?", name, address)
+	return dialog.NewWithContext(title, message, SwitchToServerContext{Name: name, Address: address})
+}
@@ -0,0 +1,149 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+func TestCreateAddServerAddressDialog_RejectsInvalidAddress(t *testing.T) {
+	dlg := app.CreateAddServerAddressDialog("myserver").WithValue("not-an-address")
+
+	_, cmd := dlg.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Fatal("expected submit to be blocked for an invalid address")
+	}
+	if dlg.ValidationError() == "" {
+		t.Error("expected a validation error for a malformed address")
+	}
+}
+
+func TestCreateAddServerAddressDialog_AcceptsValidAddress(t *testing.T) {
+	dlg := app.CreateAddServerAddressDialog("myserver").WithValue("localhost:11311")
+
+	_, cmd := dlg.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected submit to succeed for a valid address")
+	}
+	msg, ok := cmd().(dialog.InputResultMsg)
+	if !ok {
+		t.Fatalf("expected InputResultMsg, got %T", msg)
+	}
+	name, ok := app.ExtractAddServerAddressContext(msg.Context)
+	if !ok || name != "myserver" {
+		t.Errorf("expected address context to carry name 'myserver', got %#v", msg.Context)
+	}
+}
+
+func TestAddServerCmd_PersistsValidServer(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	cmd := app.AddServerCmd("newserver", "localhost:11311")
+	msg := cmd()
+
+	added, ok := msg.(app.ServerAddedMsg)
+	if !ok {
+		t.Fatalf("expected ServerAddedMsg, got %T", msg)
+	}
+	if added.Name != "newserver" || added.Address != "localhost:11311" {
+		t.Errorf("unexpected ServerAddedMsg: %#v", added)
+	}
+
+	servers, err := config.LoadServers()
+	if err != nil {
+		t.Fatalf("unexpected error loading servers: %v", err)
+	}
+	found := false
+	for _, s := range servers.Servers {
+		if s.Name == "newserver" && s.Address == "localhost:11311" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected newserver to be persisted, got %+v", servers.Servers)
+	}
+}
+
+func TestAddServerCmd_RejectsDuplicateName(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	if err := config.SaveServers(&config.ServersConfig{
+		Servers: []config.ServerConfig{{Name: "existing", Address: "localhost:11211", Default: true}},
+	}); err != nil {
+		t.Fatalf("failed to seed servers config: %v", err)
+	}
+
+	msg := app.AddServerCmd("existing", "localhost:11311")()
+	errMsg, ok := msg.(app.ServerAddErrorMsg)
+	if !ok {
+		t.Fatalf("expected ServerAddErrorMsg for duplicate name, got %T", msg)
+	}
+	if errMsg.Err == nil {
+		t.Error("expected a non-nil error")
+	}
+}
+
+func TestCreateSwitchToServerConfirmDialog(t *testing.T) {
+	dlg := app.CreateSwitchToServerConfirmDialog("newserver", "localhost:11311")
+	if !strings.Contains(dlg.Message(), "newserver") || !strings.Contains(dlg.Message(), "localhost:11311") {
+		t.Errorf("expected message to mention name and address, got: %s", dlg.Message())
+	}
+
+	_, cmd := dlg.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	msg, ok := cmd().(dialog.ConfirmResultMsg)
+	if !ok {
+		t.Fatalf("expected ConfirmResultMsg, got %T", msg)
+	}
+	ctx, ok := msg.Context.(app.SwitchToServerContext)
+	if !ok || ctx.Name != "newserver" || ctx.Address != "localhost:11311" {
+		t.Errorf("expected SwitchToServerContext{newserver, localhost:11311}, got %#v", msg.Context)
+	}
+}
+
+func TestModel_ServerAddedMsg_ShowsSwitchConfirmDialog(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(app.ServerAddedMsg{Name: "newserver", Address: "localhost:11311"})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusDialog {
+		t.Errorf("expected FocusDialog after adding a server, got %v", updated.Focus())
+	}
+}
+
+func TestModel_ConfirmSwitchToServer_UpdatesAddr(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(dialog.ConfirmResultMsg{
+		Result:  true,
+		Context: app.SwitchToServerContext{Name: "newserver", Address: "localhost:11311"},
+	})
+	updated := newModel.(*app.Model)
+
+	if updated.Addr() != "localhost:11311" {
+		t.Errorf("expected addr to switch to 'localhost:11311', got %q", updated.Addr())
+	}
+}
+
+func TestModel_CancelSwitchToServer_LeavesAddrUnchanged(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(dialog.ConfirmResultMsg{
+		Result:  false,
+		Context: app.SwitchToServerContext{Name: "newserver", Address: "localhost:11311"},
+	})
+	updated := newModel.(*app.Model)
+
+	if updated.Addr() != "localhost:11211" {
+		t.Errorf("expected addr to remain 'localhost:11211', got %q", updated.Addr())
+	}
+}
@@ -1,11 +1,18 @@
 package app_test
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
 	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/editor"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/viewer"
 )
 
 func TestNewModel(t *testing.T) {
@@ -88,6 +95,22 @@ func TestModel_UpdateKeysLoaded(t *testing.T) {
 	if len(updated.Keys()) != 2 {
 		t.Errorf("expected 2 keys, got %d", len(updated.Keys()))
 	}
+	if updated.KeysTruncated() {
+		t.Error("expected KeysTruncated() to be false when no limit was hit")
+	}
+}
+
+func TestModel_UpdateKeysLoaded_Truncated(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(app.ConnectedMsg{Version: "1.6.22"})
+
+	keys := []models.KeyInfo{{Key: "user:1", Size: 100}}
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: keys, Truncated: true})
+	updated := newModel.(*app.Model)
+
+	if !updated.KeysTruncated() {
+		t.Error("expected KeysTruncated() to be true when the limit cut enumeration short")
+	}
 }
 
 func TestModel_UpdateError(t *testing.T) {
@@ -117,6 +140,104 @@ func TestModel_View(t *testing.T) {
 	}
 }
 
+func TestModel_View_ProductionBanner(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	if strings.Contains(m.View(), "PRODUCTION") {
+		t.Error("expected no production banner by default")
+	}
+
+	m.SetProductionWarning(true)
+	if !m.ProductionWarning() {
+		t.Error("expected ProductionWarning() to be true after SetProductionWarning(true)")
+	}
+	if !strings.Contains(m.View(), "PRODUCTION") {
+		t.Error("expected a production warning banner in the view")
+	}
+}
+
+func TestModel_View_TooSmall(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 20, Height: 5})
+
+	view := m.View()
+	if !strings.Contains(view, "terminal too small") {
+		t.Errorf("expected a too-small message for a 20x5 window, got: %s", view)
+	}
+}
+
+func TestModel_View_ResumesNormalRenderingAboveThreshold(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 20, Height: 5})
+	if !strings.Contains(m.View(), "terminal too small") {
+		t.Fatal("expected the too-small message at 20x5")
+	}
+
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	view := m.View()
+	if strings.Contains(view, "terminal too small") {
+		t.Errorf("expected normal rendering once resized above the minimum, got: %s", view)
+	}
+}
+
+func TestModel_EditorSave_ShowsDiffSummary(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "foo"}})
+	m.Update(app.ValueLoadedMsg{Key: "foo", Value: []byte("a\nb")})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+
+	newModel, _ := m.Update(editor.EditorSaveMsg{Key: "foo", Value: []byte("a\nb\nc")})
+	updated := newModel.(*app.Model)
+
+	want := "Saved: +1/-0 lines, +2 bytes"
+	if updated.Error() != want {
+		t.Errorf("expected error/status %q, got %q", want, updated.Error())
+	}
+}
+
+func TestModel_Protocol(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+
+	if m.Protocol() != "" {
+		t.Errorf("expected empty protocol by default, got %q", m.Protocol())
+	}
+
+	m.SetProtocol("binary")
+	if m.Protocol() != "binary" {
+		t.Errorf("expected protocol 'binary', got %q", m.Protocol())
+	}
+}
+
+func TestModel_UpdateConnected_BinaryProtocolFallsBackToText(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.SetProtocol("binary")
+
+	newModel, _ := m.Update(app.ConnectedMsg{Version: "1.6.22", SupportsMetadump: true})
+	updated := newModel.(*app.Model)
+
+	if updated.State() != app.StateLoading {
+		t.Errorf("expected StateLoading, got %v", updated.State())
+	}
+	if !strings.Contains(updated.Error(), "binary protocol") {
+		t.Errorf("expected a binary protocol warning, got: %q", updated.Error())
+	}
+}
+
+func TestModel_UpdateConnected_TextProtocolNoWarning(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.SetProtocol("text")
+
+	newModel, _ := m.Update(app.ConnectedMsg{Version: "1.6.22", SupportsMetadump: true})
+	updated := newModel.(*app.Model)
+
+	if updated.Error() != "" {
+		t.Errorf("expected no warning for text protocol, got: %q", updated.Error())
+	}
+}
+
 func TestAppState_String(t *testing.T) {
 	tests := []struct {
 		state    app.State
@@ -225,3 +346,50 @@ func TestModel_EscDoesNothingInKeyList(t *testing.T) {
 		t.Errorf("expected FocusKeyList to remain after Esc, got %v", m.Focus())
 	}
 }
+
+func TestNewModelWithConfig_AppliesViewerPreferences(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Viewer.Wrap = true
+	cfg.Viewer.LineNumbers = true
+	cfg.Viewer.HexWidth = 8
+	cfg.Viewer.HexRuler = true
+
+	m := app.NewModelWithConfig("localhost:11211", cfg)
+
+	if !m.Viewer().Wrap() {
+		t.Error("expected viewer.wrap to be applied from config")
+	}
+	if !m.Viewer().LineNumbers() {
+		t.Error("expected viewer.line_numbers to be applied from config")
+	}
+	if m.Viewer().HexWidth() != 8 {
+		t.Errorf("expected viewer.hex_width 8 from config, got %d", m.Viewer().HexWidth())
+	}
+	if !m.Viewer().HexRuler() {
+		t.Error("expected viewer.hex_ruler to be applied from config")
+	}
+}
+
+func TestModel_UpdateViewerPrefsChanged_PersistsToConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	m := app.NewModel("localhost:11211")
+
+	msg := viewer.PrefsChangedMsg{Wrap: true, LineNumbers: true, HexWidth: 32, HexRuler: true}
+	model, _ := m.Update(msg)
+	m = model.(*app.Model)
+
+	configPath := filepath.Join(tmpDir, "memtui", "config.yaml")
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		t.Fatalf("expected config file to be written at %s", configPath)
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error loading saved config: %v", err)
+	}
+	if !saved.Viewer.Wrap || !saved.Viewer.LineNumbers || saved.Viewer.HexWidth != 32 || !saved.Viewer.HexRuler {
+		t.Errorf("expected saved viewer prefs to match PrefsChangedMsg, got %+v", saved.Viewer)
+	}
+}
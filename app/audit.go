@@ -0,0 +1,54 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxAuditEntries bounds the in-session audit trail so a long-running
+// session can't grow it without limit; the oldest entries are dropped first.
+const maxAuditEntries = 200
+
+// AuditEntry records a single mutating operation performed during the
+// session, for later review via the "Show activity" command.
+type AuditEntry struct {
+	Time   time.Time
+	Action string // "created", "edited", or "deleted"
+	Key    string
+}
+
+// String renders a one-line summary of the entry for the activity log.
+func (e AuditEntry) String() string {
+	return fmt.Sprintf("%s %s %s", e.Time.Format("15:04:05"), e.Action, e.Key)
+}
+
+// AuditLog returns the audit trail accumulated so far, oldest first (for
+// testing).
+func (m *Model) AuditLog() []AuditEntry {
+	return m.auditLog
+}
+
+// recordAudit appends an entry to the audit trail, trimming the oldest
+// entries once maxAuditEntries is exceeded, and mirrors it to the debug log
+// when logging is enabled.
+func (m *Model) recordAudit(action, key string) {
+	m.auditLog = append(m.auditLog, AuditEntry{Time: time.Now(), Action: action, Key: key})
+	if len(m.auditLog) > maxAuditEntries {
+		m.auditLog = m.auditLog[len(m.auditLog)-maxAuditEntries:]
+	}
+	m.logger.Audit(m.addr, action, key)
+}
+
+// FormatAuditLog renders the audit trail as a human-readable list for
+// display via the "Show activity" command, oldest entry first.
+func FormatAuditLog(entries []AuditEntry) string {
+	if len(entries) == 0 {
+		return "No activity recorded yet"
+	}
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = e.String()
+	}
+	return fmt.Sprintf("Activity (%d):\n  %s", len(entries), strings.Join(lines, "\n  "))
+}
@@ -0,0 +1,70 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+func TestModel_NewKeyAndDelete_AppendAuditEntriesInOrder(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(dialog.InputResultMsg{
+		Value:   "myvalue",
+		Context: app.NewKeyContext{Key: "mykey"},
+	})
+	m = newModel.(*app.Model)
+
+	ttlDialog := m.InputDialog()
+	if ttlDialog == nil {
+		t.Fatal("expected a TTL input dialog to be open")
+	}
+	newModel, _ = m.Update(dialog.InputResultMsg{
+		Value:   "0",
+		Context: app.NewKeyValueContext{Key: "mykey", Value: "myvalue"},
+	})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(app.KeyDeletedMsg{Key: "mykey"})
+	m = newModel.(*app.Model)
+
+	log := m.AuditLog()
+	if len(log) != 2 {
+		t.Fatalf("expected 2 audit entries, got %d: %+v", len(log), log)
+	}
+	if log[0].Action != "created" || log[0].Key != "mykey" {
+		t.Errorf("expected first entry to be 'created mykey', got %+v", log[0])
+	}
+	if log[1].Action != "deleted" || log[1].Key != "mykey" {
+		t.Errorf("expected second entry to be 'deleted mykey', got %+v", log[1])
+	}
+	if !log[1].Time.After(log[0].Time) && !log[1].Time.Equal(log[0].Time) {
+		t.Errorf("expected entries in chronological order, got %+v", log)
+	}
+}
+
+func TestModel_ShowActivity_DisplaysAuditLog(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(app.KeyDeletedMsg{Key: "somekey"})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Show activity"}})
+	updated := newModel.(*app.Model)
+
+	if !strings.Contains(updated.Error(), "deleted somekey") {
+		t.Errorf("expected activity log to mention 'deleted somekey', got %q", updated.Error())
+	}
+}
+
+func TestFormatAuditLog(t *testing.T) {
+	if got := app.FormatAuditLog(nil); got != "No activity recorded yet" {
+		t.Errorf("expected empty-log message, got %q", got)
+	}
+}
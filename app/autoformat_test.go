@@ -0,0 +1,95 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/viewer"
+)
+
+func TestHandleCommandExecute_EditValue_AutoformatsJSONWithoutMarkingDirty(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Viewer.AutoformatOnEdit = true
+	compactJSON := []byte(`{"name":"test","value":123}`)
+
+	m := &Model{
+		cfg:            cfg,
+		keyList:        keylist.NewModel(),
+		viewer:         viewer.NewModel(),
+		commandPalette: command.New(command.DefaultCommands()),
+		currentKey:     &models.KeyInfo{Key: "config:app"},
+		currentValue:   compactJSON,
+	}
+
+	newModel, _ := m.handleCommandExecute(command.Command{Name: "Edit value"})
+	m = newModel.(*Model)
+
+	if m.editor == nil {
+		t.Fatal("expected the editor to open")
+	}
+	if !strings.Contains(m.editor.Value(), "\n") {
+		t.Errorf("expected the editor content to be pretty-printed, got %q", m.editor.Value())
+	}
+	if m.editor.IsDirty() {
+		t.Error("expected autoformatting on open not to mark the buffer dirty")
+	}
+
+	m.editor.SetContent(append(compactJSON, ' '))
+	if !m.editor.IsDirty() {
+		t.Error("expected a subsequent user edit to mark the buffer dirty")
+	}
+}
+
+func TestHandleCommandExecute_EditValue_AutoformatDisabled_LeavesContentCompact(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Viewer.AutoformatOnEdit = false
+	compactJSON := []byte(`{"name":"test","value":123}`)
+
+	m := &Model{
+		cfg:            cfg,
+		keyList:        keylist.NewModel(),
+		viewer:         viewer.NewModel(),
+		commandPalette: command.New(command.DefaultCommands()),
+		currentKey:     &models.KeyInfo{Key: "config:app"},
+		currentValue:   compactJSON,
+	}
+
+	newModel, _ := m.handleCommandExecute(command.Command{Name: "Edit value"})
+	m = newModel.(*Model)
+
+	if m.editor.Value() != string(compactJSON) {
+		t.Errorf("expected content to stay compact when autoformat is disabled, got %q", m.editor.Value())
+	}
+}
+
+func TestHandleCommandExecute_EditValue_MalformedJSONOpensAsIs(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Viewer.AutoformatOnEdit = true
+	malformed := []byte(`{not valid json}`)
+
+	m := &Model{
+		cfg:            cfg,
+		keyList:        keylist.NewModel(),
+		viewer:         viewer.NewModel(),
+		commandPalette: command.New(command.DefaultCommands()),
+		currentKey:     &models.KeyInfo{Key: "config:broken"},
+		currentValue:   malformed,
+	}
+
+	newModel, _ := m.handleCommandExecute(command.Command{Name: "Edit value"})
+	m = newModel.(*Model)
+
+	if m.editor == nil {
+		t.Fatal("expected the editor to open despite malformed JSON")
+	}
+	if m.editor.Value() != string(malformed) {
+		t.Errorf("expected malformed content to open unchanged, got %q", m.editor.Value())
+	}
+	if m.editor.IsDirty() {
+		t.Error("expected no dirty flag when autoformat silently fails")
+	}
+}
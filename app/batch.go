@@ -3,6 +3,7 @@ package app
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/nnnkkk7/memtui/ui/components/dialog"
@@ -16,6 +17,17 @@ type BatchDeleteRequest struct {
 // BatchDeleteMsg is the message that triggers a batch delete operation.
 type BatchDeleteMsg struct {
 	Keys []string
+
+	// DryRun, when true, previews the keys that would be deleted instead of
+	// deleting them. See BatchDeletePreviewCmd.
+	DryRun bool
+}
+
+// BatchDeletePreviewMsg is returned instead of BatchDeleteResultMsg when a
+// batch delete runs in dry-run mode. It lists the keys that would have been
+// deleted, without the deleter being called at all.
+type BatchDeletePreviewMsg struct {
+	Keys []string
 }
 
 // BatchDeleteResultMsg holds the result of a batch delete operation.
@@ -34,7 +46,15 @@ type BatchDeleter interface {
 // BatchDeleteContext holds contextual information for a batch delete operation.
 // Used to pass data between dialog confirmation and batch delete execution.
 type BatchDeleteContext struct {
-	Keys []string
+	Keys   []string
+	DryRun bool
+
+	// ProtectedKeys lists the keys in Keys that match a protected_patterns
+	// glob, if any. When non-empty, ProcessBatchInputResult requires typing
+	// these key names (comma-separated) instead of the plain "DELETE"
+	// confirmation, the same extra step isProtectedKey forces for a
+	// single-key delete.
+	ProtectedKeys []string
 }
 
 // BatchDeleteSummary provides a summary of the batch delete operation result.
@@ -111,6 +131,27 @@ func BatchDeleteCmd(client BatchDeleter, keys []string) tea.Cmd {
 	}
 }
 
+// BatchDeletePreviewCmd creates a tea.Cmd that previews a batch delete
+// without calling the deleter. Use this for a dry-run pass, e.g. before a
+// large prefix-based batch delete, to confirm exactly which keys would be
+// affected before committing to it.
+func BatchDeletePreviewCmd(keys []string) tea.Cmd {
+	return func() tea.Msg {
+		preview := make([]string, len(keys))
+		copy(preview, keys)
+		return BatchDeletePreviewMsg{Keys: preview}
+	}
+}
+
+// FormatBatchDeletePreview renders a human-readable preview of the keys a
+// dry-run batch delete matched, for display while no deletion has happened.
+func FormatBatchDeletePreview(keys []string) string {
+	if len(keys) == 0 {
+		return "Dry run: no keys matched"
+	}
+	return fmt.Sprintf("Dry run: %d key(s) would be deleted:\n  %s", len(keys), strings.Join(keys, "\n  "))
+}
+
 // HandleBatchDeleteResult processes a BatchDeleteResultMsg and returns a summary.
 func HandleBatchDeleteResult(msg BatchDeleteResultMsg) BatchDeleteSummary {
 	deletedCount := len(msg.Deleted)
@@ -143,22 +184,83 @@ func CreateBatchDeleteDialog(_ int) *dialog.InputDialog {
 // CreateBatchDeleteDialogWithKeys creates an input dialog with the keys context attached.
 // This allows the keys to be retrieved when the user confirms the deletion.
 func CreateBatchDeleteDialogWithKeys(keys []string) *dialog.InputDialog {
+	return CreateBatchDeleteDialogWithOptions(keys, false)
+}
+
+// CreateProtectedBatchDeleteDialog creates the stricter batch delete
+// confirmation dialog used when one or more of the selected keys matches a
+// protected_patterns glob. Typing "DELETE" isn't enough here: the user must
+// type the protected key names exactly, comma-separated, the same way a
+// single protected-key delete requires typing the key name.
+func CreateProtectedBatchDeleteDialog(keys, protectedKeys []string) *dialog.InputDialog {
 	count := len(keys)
-	ctx := BatchDeleteContext{Keys: keys}
+	confirmation := strings.Join(protectedKeys, ",")
+	ctx := BatchDeleteContext{Keys: keys, ProtectedKeys: protectedKeys}
 
-	title := "Batch Delete Confirmation"
+	title := fmt.Sprintf(
+		"Batch Delete Confirmation (%d keys, %d protected)\n\n%s",
+		count, len(protectedKeys), formatBatchDeleteDialogKeyList(keys),
+	)
+
+	dlg := dialog.NewInput(title).
+		WithPlaceholder(fmt.Sprintf("Type %q to confirm deletion", confirmation)).
+		WithValidator(validateProtectedBatchDeleteInput(confirmation)).
+		WithContext(ctx)
+
+	return dlg
+}
+
+func validateProtectedBatchDeleteInput(confirmation string) func(string) error {
+	return func(input string) error {
+		if input != confirmation {
+			return fmt.Errorf("type %q to confirm", confirmation)
+		}
+		return nil
+	}
+}
+
+// maxBatchDeleteDialogPreviewKeys caps how many key names the batch delete
+// confirmation dialog lists before summarizing the rest as "+N more".
+const maxBatchDeleteDialogPreviewKeys = 10
+
+// CreateBatchDeleteDialogWithOptions creates an input dialog with the keys
+// and dry-run flag attached as context. When dryRun is true, confirming the
+// dialog previews the matched keys instead of deleting them.
+func CreateBatchDeleteDialogWithOptions(keys []string, dryRun bool) *dialog.InputDialog {
+	count := len(keys)
+	ctx := BatchDeleteContext{Keys: keys, DryRun: dryRun}
+
+	title := fmt.Sprintf("Batch Delete Confirmation (%d keys)\n\n%s", count, formatBatchDeleteDialogKeyList(keys))
 
 	dlg := dialog.NewInput(title).
 		WithPlaceholder("Type DELETE to confirm").
 		WithValidator(ValidateBatchDeleteInput).
 		WithContext(ctx)
 
-	// Set a message that includes the count
-	_ = count // The count could be displayed in an extended version
-
 	return dlg
 }
 
+// formatBatchDeleteDialogKeyList renders up to maxBatchDeleteDialogPreviewKeys
+// key names, one per line, with a "+N more" summary when truncated, so the
+// batch delete dialog shows what's about to be deleted instead of just a count.
+func formatBatchDeleteDialogKeyList(keys []string) string {
+	if len(keys) == 0 {
+		return "  (no keys)"
+	}
+
+	shown := keys
+	if len(shown) > maxBatchDeleteDialogPreviewKeys {
+		shown = shown[:maxBatchDeleteDialogPreviewKeys]
+	}
+
+	list := "  " + strings.Join(shown, "\n  ")
+	if remaining := len(keys) - len(shown); remaining > 0 {
+		list += fmt.Sprintf("\n  +%d more", remaining)
+	}
+
+	return list
+}
+
 // ValidateBatchDeleteInput validates that the input matches "DELETE" exactly.
 // This is a safety measure to prevent accidental batch deletions.
 func ValidateBatchDeleteInput(input string) error {
@@ -183,29 +285,42 @@ func ExtractBatchDeleteContext(ctx interface{}) ([]string, bool) {
 }
 
 // ProcessBatchInputResult processes an input dialog result for batch deletion.
-// Returns a BatchDeleteMsg if confirmed with "DELETE", or nil if canceled or invalid.
+// Returns a BatchDeleteMsg if confirmed, or nil if canceled or invalid. A
+// context with ProtectedKeys set requires typing those key names
+// (comma-separated) instead of the plain "DELETE" confirmation.
 func ProcessBatchInputResult(result dialog.InputResultMsg) *BatchDeleteMsg {
 	// User canceled
 	if result.Canceled {
 		return nil
 	}
 
-	// Validate input
-	if ValidateBatchDeleteInput(result.Value) != nil {
+	bdc, ok := result.Context.(BatchDeleteContext)
+	if !ok {
 		return nil
 	}
 
-	// Extract keys from context
-	keys, ok := ExtractBatchDeleteContext(result.Context)
-	if !ok {
+	// Validate input
+	if len(bdc.ProtectedKeys) > 0 {
+		confirmation := strings.Join(bdc.ProtectedKeys, ",")
+		if validateProtectedBatchDeleteInput(confirmation)(result.Value) != nil {
+			return nil
+		}
+	} else if ValidateBatchDeleteInput(result.Value) != nil {
 		return nil
 	}
 
-	return &BatchDeleteMsg{Keys: keys}
+	keys := bdc.Keys
+	dryRun := bdc.DryRun
+
+	return &BatchDeleteMsg{Keys: keys, DryRun: dryRun}
 }
 
 // HandleBatchDeleteConfirm processes a batch delete confirmation and returns the command.
-// This is called after the user confirms deletion by typing "DELETE".
+// This is called after the user confirms deletion by typing "DELETE". In
+// dry-run mode, it previews the matched keys instead of deleting them.
 func HandleBatchDeleteConfirm(client BatchDeleter, msg BatchDeleteMsg) tea.Cmd {
+	if msg.DryRun {
+		return BatchDeletePreviewCmd(msg.Keys)
+	}
 	return BatchDeleteCmd(client, msg.Keys)
 }
@@ -2,6 +2,8 @@ package app_test
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 
@@ -564,6 +566,42 @@ func TestCreateBatchDeleteDialog(t *testing.T) {
 	})
 }
 
+func TestCreateBatchDeleteDialogWithKeys_ListsKeyNames(t *testing.T) {
+	t.Run("small batch lists every key", func(t *testing.T) {
+		keys := []string{"session:1", "session:2", "session:3"}
+		dlg := app.CreateBatchDeleteDialogWithKeys(keys)
+
+		title := dlg.Title()
+		for _, key := range keys {
+			if !strings.Contains(title, key) {
+				t.Errorf("expected dialog title to contain %q, got %q", key, title)
+			}
+		}
+		if strings.Contains(title, "more") {
+			t.Errorf("expected no truncation summary for a small batch, got %q", title)
+		}
+	})
+
+	t.Run("large batch truncates with a +N more summary", func(t *testing.T) {
+		keys := make([]string, 25)
+		for i := range keys {
+			keys[i] = fmt.Sprintf("session:%d", i)
+		}
+		dlg := app.CreateBatchDeleteDialogWithKeys(keys)
+
+		title := dlg.Title()
+		if !strings.Contains(title, keys[0]) {
+			t.Errorf("expected dialog title to contain the first key %q, got %q", keys[0], title)
+		}
+		if !strings.Contains(title, "+15 more") {
+			t.Errorf("expected dialog title to summarize the remaining 15 keys, got %q", title)
+		}
+		if strings.Contains(title, keys[24]) {
+			t.Errorf("expected the 25th key to not be listed individually, got %q", title)
+		}
+	})
+}
+
 // TestValidateBatchDeleteConfirmation tests the validation function
 func TestValidateBatchDeleteConfirmation(t *testing.T) {
 	t.Run("accepts DELETE", func(t *testing.T) {
@@ -868,3 +906,86 @@ func TestBatchDeleteIntegration(t *testing.T) {
 		}
 	})
 }
+
+// TestBatchDeletePreviewCmd tests the dry-run preview command
+func TestBatchDeletePreviewCmd(t *testing.T) {
+	t.Run("returns a preview message without deleting", func(t *testing.T) {
+		mock := NewMockBatchDeleter()
+		keys := []string{"key1", "key2", "key3"}
+
+		cmd := app.BatchDeletePreviewCmd(keys)
+		if cmd == nil {
+			t.Fatal("expected non-nil command")
+		}
+
+		msg := cmd()
+		preview, ok := msg.(app.BatchDeletePreviewMsg)
+		if !ok {
+			t.Fatalf("expected BatchDeletePreviewMsg, got %T", msg)
+		}
+
+		if len(preview.Keys) != 3 {
+			t.Errorf("expected 3 keys in preview, got %d", len(preview.Keys))
+		}
+
+		if mock.DeleteCalled != 0 {
+			t.Errorf("expected DeleteCalled to stay 0, got %d", mock.DeleteCalled)
+		}
+	})
+
+	t.Run("empty keys", func(t *testing.T) {
+		cmd := app.BatchDeletePreviewCmd(nil)
+		msg := cmd()
+
+		preview, ok := msg.(app.BatchDeletePreviewMsg)
+		if !ok {
+			t.Fatalf("expected BatchDeletePreviewMsg, got %T", msg)
+		}
+		if len(preview.Keys) != 0 {
+			t.Errorf("expected 0 keys, got %d", len(preview.Keys))
+		}
+	})
+}
+
+// TestHandleBatchDeleteConfirm_DryRun verifies that a dry-run confirmation
+// previews the keys instead of calling the deleter.
+func TestHandleBatchDeleteConfirm_DryRun(t *testing.T) {
+	mock := NewMockBatchDeleter()
+	keys := []string{"user:1", "user:2"}
+
+	cmd := app.HandleBatchDeleteConfirm(mock, app.BatchDeleteMsg{Keys: keys, DryRun: true})
+	msg := cmd()
+
+	preview, ok := msg.(app.BatchDeletePreviewMsg)
+	if !ok {
+		t.Fatalf("expected BatchDeletePreviewMsg, got %T", msg)
+	}
+	if len(preview.Keys) != 2 {
+		t.Errorf("expected 2 keys, got %d", len(preview.Keys))
+	}
+	if mock.DeleteCalled != 0 {
+		t.Errorf("expected MockBatchDeleter.DeleteCalled to stay 0, got %d", mock.DeleteCalled)
+	}
+}
+
+// TestProcessBatchInputResult_DryRun verifies the dry-run flag survives the
+// dialog-context round trip.
+func TestProcessBatchInputResult_DryRun(t *testing.T) {
+	keys := []string{"user:1", "user:2"}
+	ctx := app.BatchDeleteContext{Keys: keys, DryRun: true}
+	inputResult := dialog.InputResultMsg{
+		Value:   "DELETE",
+		Context: ctx,
+	}
+
+	msg := app.ProcessBatchInputResult(inputResult)
+	if msg == nil {
+		t.Fatal("expected non-nil message")
+	}
+	if !msg.DryRun {
+		t.Error("expected DryRun to be true")
+	}
+	if len(msg.Keys) != 2 {
+		t.Errorf("expected 2 keys, got %d", len(msg.Keys))
+	}
+}
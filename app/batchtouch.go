@@ -0,0 +1,137 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+// BatchExtendTTLMsg is the message that triggers a batch TTL extension.
+type BatchExtendTTLMsg struct {
+	Keys []string
+	TTL  int32
+}
+
+// BatchExtendTTLResultMsg holds the result of a batch TTL extension.
+type BatchExtendTTLResultMsg struct {
+	Touched []string         // Keys whose TTL was successfully extended
+	Failed  []string         // Keys that failed to extend
+	Errors  map[string]error // Error messages for failed keys
+}
+
+// BatchExtendTTLContext holds contextual information for a batch TTL
+// extension. Used to pass the selected keys between dialog confirmation and
+// execution.
+type BatchExtendTTLContext struct {
+	Keys []string
+}
+
+// BatchExtendTTLSummary provides a summary of a batch TTL extension result.
+type BatchExtendTTLSummary struct {
+	TotalCount    int      // Total number of keys attempted
+	TouchedCount  int      // Number of keys successfully extended
+	FailedCount   int      // Number of failed extensions
+	AllSucceeded  bool     // True if all extensions succeeded
+	HasErrors     bool     // True if any extension failed
+	ShouldRefresh bool     // Whether the key list should be refreshed
+	FailedKeys    []string // List of keys that failed to extend
+}
+
+// String returns a human-readable summary of the batch TTL extension result.
+func (s BatchExtendTTLSummary) String() string {
+	if s.TotalCount == 0 {
+		return "No keys to extend"
+	}
+
+	if s.AllSucceeded {
+		if s.TouchedCount == 1 {
+			return "Successfully extended TTL for 1 key"
+		}
+		return fmt.Sprintf("Successfully extended TTL for %d keys", s.TouchedCount)
+	}
+
+	if s.TouchedCount == 0 {
+		if s.FailedCount == 1 {
+			return "Failed to extend TTL for 1 key"
+		}
+		return fmt.Sprintf("Failed to extend TTL for all %d keys", s.FailedCount)
+	}
+
+	return fmt.Sprintf("Extended TTL for %d keys, %d failed", s.TouchedCount, s.FailedCount)
+}
+
+// BatchExtendTTLCmd creates a tea.Cmd that extends the TTL of multiple keys
+// by reusing the single-key "get and touch" operation for each one (see
+// Toucher). Returns BatchExtendTTLResultMsg with the results of all attempts.
+func BatchExtendTTLCmd(c Toucher, keys []string, ttl int32) tea.Cmd {
+	return func() tea.Msg {
+		result := BatchExtendTTLResultMsg{
+			Touched: make([]string, 0),
+			Failed:  make([]string, 0),
+			Errors:  make(map[string]error),
+		}
+
+		if len(keys) == 0 {
+			return result
+		}
+
+		for _, key := range keys {
+			if c == nil {
+				result.Failed = append(result.Failed, key)
+				result.Errors[key] = fmt.Errorf("client not connected")
+				continue
+			}
+
+			if _, err := c.GetAndTouch(key, ttl); err != nil {
+				result.Failed = append(result.Failed, key)
+				result.Errors[key] = err
+			} else {
+				result.Touched = append(result.Touched, key)
+			}
+		}
+
+		return result
+	}
+}
+
+// HandleBatchExtendTTLResult processes a BatchExtendTTLResultMsg and returns
+// a summary.
+func HandleBatchExtendTTLResult(msg BatchExtendTTLResultMsg) BatchExtendTTLSummary {
+	touchedCount := len(msg.Touched)
+	failedCount := len(msg.Failed)
+	totalCount := touchedCount + failedCount
+
+	return BatchExtendTTLSummary{
+		TotalCount:    totalCount,
+		TouchedCount:  touchedCount,
+		FailedCount:   failedCount,
+		AllSucceeded:  failedCount == 0,
+		HasErrors:     failedCount > 0,
+		ShouldRefresh: touchedCount > 0,
+		FailedKeys:    msg.Failed,
+	}
+}
+
+// CreateBatchExtendTTLDialog creates an input dialog for entering a new TTL
+// to apply to every key in keys, previewing which keys will be affected the
+// same way the batch delete confirmation does.
+func CreateBatchExtendTTLDialog(keys []string) *dialog.InputDialog {
+	title := fmt.Sprintf("Extend TTL (%d keys)\n\n%s", len(keys), formatBatchDeleteDialogKeyList(keys))
+	ctx := BatchExtendTTLContext{Keys: keys}
+
+	return dialog.NewInput(title).
+		WithPlaceholder("Enter TTL in seconds...").
+		WithValidator(ValidateTTL).
+		WithLiveValidation().
+		WithContext(ctx)
+}
+
+// ExtractBatchExtendTTLContext extracts the keys from a BatchExtendTTLContext.
+func ExtractBatchExtendTTLContext(ctx interface{}) ([]string, bool) {
+	bec, ok := ctx.(BatchExtendTTLContext)
+	if !ok {
+		return nil, false
+	}
+	return bec.Keys, true
+}
@@ -0,0 +1,152 @@
+package app_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/client"
+)
+
+// mockBatchToucher is a mock implementation of app.Toucher for testing batch
+// TTL extension, allowing individual keys to be configured to fail.
+type mockBatchToucher struct {
+	failingKeys map[string]error
+	touchedKeys []string
+	lastTTL     int32
+}
+
+func (m *mockBatchToucher) GetAndTouch(key string, ttl int32) (*client.CASItem, error) {
+	m.lastTTL = ttl
+	if err, ok := m.failingKeys[key]; ok {
+		return nil, err
+	}
+	m.touchedKeys = append(m.touchedKeys, key)
+	return &client.CASItem{Key: key, Expiration: ttl}, nil
+}
+
+func TestBatchExtendTTLCmd_AllSucceed(t *testing.T) {
+	mock := &mockBatchToucher{}
+	keys := []string{"user:1", "user:2", "user:3"}
+
+	cmd := app.BatchExtendTTLCmd(mock, keys, 600)
+	msg := cmd()
+
+	result, ok := msg.(app.BatchExtendTTLResultMsg)
+	if !ok {
+		t.Fatalf("expected BatchExtendTTLResultMsg, got %T", msg)
+	}
+	if len(result.Touched) != 3 {
+		t.Errorf("expected 3 touched keys, got %d", len(result.Touched))
+	}
+	if len(result.Failed) != 0 {
+		t.Errorf("expected 0 failed keys, got %d", len(result.Failed))
+	}
+	if mock.lastTTL != 600 {
+		t.Errorf("expected GetAndTouch called with TTL 600, got %d", mock.lastTTL)
+	}
+}
+
+func TestBatchExtendTTLCmd_PartialFailure(t *testing.T) {
+	mock := &mockBatchToucher{
+		failingKeys: map[string]error{
+			"user:2": errors.New("connection refused"),
+		},
+	}
+	keys := []string{"user:1", "user:2", "user:3"}
+
+	cmd := app.BatchExtendTTLCmd(mock, keys, 120)
+	msg := cmd()
+
+	result, ok := msg.(app.BatchExtendTTLResultMsg)
+	if !ok {
+		t.Fatalf("expected BatchExtendTTLResultMsg, got %T", msg)
+	}
+	if len(result.Touched) != 2 {
+		t.Errorf("expected 2 touched keys, got %d", len(result.Touched))
+	}
+	if len(result.Failed) != 1 || result.Failed[0] != "user:2" {
+		t.Errorf("expected user:2 to fail, got %v", result.Failed)
+	}
+	if result.Errors["user:2"] == nil {
+		t.Error("expected an error recorded for user:2")
+	}
+}
+
+func TestBatchExtendTTLCmd_NilClient(t *testing.T) {
+	keys := []string{"user:1", "user:2"}
+
+	cmd := app.BatchExtendTTLCmd(nil, keys, 60)
+	msg := cmd().(app.BatchExtendTTLResultMsg)
+
+	if len(msg.Touched) != 0 {
+		t.Errorf("expected 0 touched keys, got %d", len(msg.Touched))
+	}
+	if len(msg.Failed) != 2 {
+		t.Errorf("expected 2 failed keys, got %d", len(msg.Failed))
+	}
+}
+
+func TestBatchExtendTTLCmd_EmptyKeys(t *testing.T) {
+	mock := &mockBatchToucher{}
+
+	cmd := app.BatchExtendTTLCmd(mock, nil, 60)
+	msg := cmd().(app.BatchExtendTTLResultMsg)
+
+	if len(msg.Touched) != 0 || len(msg.Failed) != 0 {
+		t.Errorf("expected no keys touched or failed, got %v / %v", msg.Touched, msg.Failed)
+	}
+}
+
+func TestHandleBatchExtendTTLResult(t *testing.T) {
+	t.Run("all succeeded", func(t *testing.T) {
+		summary := app.HandleBatchExtendTTLResult(app.BatchExtendTTLResultMsg{
+			Touched: []string{"a", "b"},
+		})
+		if !summary.AllSucceeded || summary.HasErrors {
+			t.Errorf("expected all succeeded, got %+v", summary)
+		}
+		if !summary.ShouldRefresh {
+			t.Error("expected ShouldRefresh to be true when keys were touched")
+		}
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		summary := app.HandleBatchExtendTTLResult(app.BatchExtendTTLResultMsg{
+			Touched: []string{"a"},
+			Failed:  []string{"b"},
+			Errors:  map[string]error{"b": errors.New("boom")},
+		})
+		if summary.AllSucceeded || !summary.HasErrors {
+			t.Errorf("expected partial failure, got %+v", summary)
+		}
+		if summary.TotalCount != 2 || summary.TouchedCount != 1 || summary.FailedCount != 1 {
+			t.Errorf("unexpected counts: %+v", summary)
+		}
+	})
+}
+
+func TestCreateBatchExtendTTLDialog_ListsKeyNames(t *testing.T) {
+	keys := []string{"session:1", "session:2"}
+	dlg := app.CreateBatchExtendTTLDialog(keys)
+
+	title := dlg.Title()
+	for _, key := range keys {
+		if !strings.Contains(title, key) {
+			t.Errorf("expected dialog title to contain %q, got %q", key, title)
+		}
+	}
+}
+
+func TestExtractBatchExtendTTLContext(t *testing.T) {
+	keys := []string{"a", "b"}
+	extracted, ok := app.ExtractBatchExtendTTLContext(app.BatchExtendTTLContext{Keys: keys})
+	if !ok || len(extracted) != 2 {
+		t.Fatalf("expected successful extraction of 2 keys, got ok=%v keys=%v", ok, extracted)
+	}
+
+	if _, ok := app.ExtractBatchExtendTTLContext("invalid"); ok {
+		t.Error("expected false for wrong context type")
+	}
+}
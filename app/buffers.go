@@ -0,0 +1,194 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// DirtyBuffer captures an edited-but-unsaved value for a key, independent of
+// whichever key is currently focused in the editor. This lets several keys
+// be edited in sequence (see editor.EditorCancelMsg handling in update.go)
+// without losing track of which ones were never saved.
+type DirtyBuffer struct {
+	Key   string
+	Value []byte
+	Flags uint32
+}
+
+// DirtyBuffers returns the currently unsaved buffers, keyed by key (for
+// testing).
+func (m *Model) DirtyBuffers() map[string]DirtyBuffer {
+	return m.dirtyBuffers
+}
+
+// stashDirtyBuffer records (or updates) the unsaved content for key, so it
+// survives closing the editor without saving.
+func (m *Model) stashDirtyBuffer(key string, value []byte, flags uint32) {
+	if m.dirtyBuffers == nil {
+		m.dirtyBuffers = make(map[string]DirtyBuffer)
+	}
+	m.dirtyBuffers[key] = DirtyBuffer{Key: key, Value: value, Flags: flags}
+}
+
+// findKeyInfo returns the cached KeyInfo for key from the last enumeration,
+// or nil if it isn't known, for preserving TTL when saving a buffer that
+// isn't the currently focused key.
+func (m *Model) findKeyInfo(key string) *models.KeyInfo {
+	for i := range m.keys {
+		if m.keys[i].Key == key {
+			return &m.keys[i]
+		}
+	}
+	return nil
+}
+
+// FormatDirtyBuffers renders the unsaved buffers as a human-readable list,
+// sorted by key, for display via the "Show unsaved buffers" command.
+func FormatDirtyBuffers(buffers map[string]DirtyBuffer) string {
+	if len(buffers) == 0 {
+		return "No unsaved buffers"
+	}
+
+	keys := make([]string, 0, len(buffers))
+	for key := range buffers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, len(keys))
+	for i, key := range keys {
+		lines[i] = fmt.Sprintf("%s (%d bytes)", key, len(buffers[key].Value))
+	}
+
+	return fmt.Sprintf("Unsaved buffers (%d):\n  %s", len(keys), strings.Join(lines, "\n  "))
+}
+
+// SaveAllBuffersResultMsg holds the result of a "save all" operation over
+// every dirty buffer.
+type SaveAllBuffersResultMsg struct {
+	Saved  []string
+	Failed []string
+	Errors map[string]error
+}
+
+// SaveAllBuffersSummary provides a summary of a SaveAllBuffersResultMsg.
+type SaveAllBuffersSummary struct {
+	TotalCount   int
+	SavedCount   int
+	FailedCount  int
+	AllSucceeded bool
+	HasErrors    bool
+}
+
+// String returns a human-readable summary of the save-all result.
+func (s SaveAllBuffersSummary) String() string {
+	if s.TotalCount == 0 {
+		return "No unsaved buffers to save"
+	}
+	if s.AllSucceeded {
+		if s.SavedCount == 1 {
+			return "Saved 1 buffer"
+		}
+		return fmt.Sprintf("Saved %d buffers", s.SavedCount)
+	}
+	if s.SavedCount == 0 {
+		return fmt.Sprintf("Failed to save all %d buffers", s.FailedCount)
+	}
+	return fmt.Sprintf("Saved %d buffers, %d failed", s.SavedCount, s.FailedCount)
+}
+
+// HandleSaveAllBuffersResult processes a SaveAllBuffersResultMsg and returns a summary.
+func HandleSaveAllBuffersResult(msg SaveAllBuffersResultMsg) SaveAllBuffersSummary {
+	savedCount := len(msg.Saved)
+	failedCount := len(msg.Failed)
+
+	return SaveAllBuffersSummary{
+		TotalCount:   savedCount + failedCount,
+		SavedCount:   savedCount,
+		FailedCount:  failedCount,
+		AllSucceeded: failedCount == 0,
+		HasErrors:    failedCount > 0,
+	}
+}
+
+// saveBufferValue saves a single dirty buffer with CAS, re-fetching the item
+// first (the same approach as saveValueWithCASCmd) since a buffer may belong
+// to a key other than the one currently focused in the editor.
+func (m *Model) saveBufferValue(buf DirtyBuffer) error {
+	if sizeErr := oversizedValueErr(buf.Value, m.cfg.Limits.MaxItemSize); sizeErr != nil {
+		return sizeErr
+	}
+
+	casItem, err := m.mcClient.GetWithCAS(buf.Key)
+	if err != nil {
+		return fmt.Errorf("failed to get current value for CAS: %w", err)
+	}
+
+	casItem.Value = buf.Value
+	casItem.Flags = buf.Flags
+	if keyInfo := m.findKeyInfo(buf.Key); keyInfo != nil {
+		casItem.Expiration = calculateRemainingTTL(keyInfo.Expiration)
+	}
+
+	if err := m.mcClient.CompareAndSwap(casItem); err != nil {
+		if client.IsCASConflict(err) {
+			return errors.New("CAS conflict: value was modified by another client")
+		}
+		if client.IsNotStored(err) {
+			return errors.New("key was deleted or evicted before the save completed")
+		}
+		if friendly := translateServerError(err, m.cfg.Limits.MaxItemSize); friendly != nil {
+			return friendly
+		}
+		return fmt.Errorf("failed to save value: %w", err)
+	}
+
+	return nil
+}
+
+// saveAllBuffersCmd issues a save for every currently stashed dirty buffer
+// and reports which ones succeeded. Buffers are cleared from the model
+// immediately (see handleKeyMsg/handleCommandExecute) since the save has
+// been submitted; it isn't re-added on failure, matching the behavior of a
+// single editor save.
+func (m *Model) saveAllBuffersCmd(buffers map[string]DirtyBuffer) tea.Cmd {
+	return func() tea.Msg {
+		result := SaveAllBuffersResultMsg{
+			Saved:  make([]string, 0, len(buffers)),
+			Failed: make([]string, 0),
+			Errors: make(map[string]error),
+		}
+
+		if m.mcClient == nil {
+			err := errors.New("client not connected")
+			for key := range buffers {
+				result.Failed = append(result.Failed, key)
+				result.Errors[key] = err
+			}
+			return result
+		}
+
+		keys := make([]string, 0, len(buffers))
+		for key := range buffers {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if err := m.saveBufferValue(buffers[key]); err != nil {
+				result.Failed = append(result.Failed, key)
+				result.Errors[key] = err
+			} else {
+				result.Saved = append(result.Saved, key)
+			}
+		}
+
+		return result
+	}
+}
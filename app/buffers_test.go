@@ -0,0 +1,237 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/editor"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/viewer"
+)
+
+func TestHandleCommandExecute_EditValue_UsesFullValueEvenWhenViewerDisplayTruncated(t *testing.T) {
+	fullValue := []byte("0123456789abcdefghij")
+
+	v := viewer.NewModel()
+	v.SetMaxDisplayBytes(10)
+	v.SetValue(fullValue)
+	if !v.IsTruncatedDisplay() {
+		t.Fatal("test setup invalid: expected the viewer display to be truncated")
+	}
+
+	m := &Model{
+		cfg:            config.DefaultConfig(),
+		keyList:        keylist.NewModel(),
+		viewer:         v,
+		commandPalette: command.New(command.DefaultCommands()),
+		currentKey:     &models.KeyInfo{Key: "bigkey"},
+		currentValue:   fullValue,
+	}
+
+	newModel, _ := m.handleCommandExecute(command.Command{Name: "Edit value"})
+	m = newModel.(*Model)
+
+	if m.editor == nil {
+		t.Fatal("expected the editor to open")
+	}
+	if m.editor.Value() != string(fullValue) {
+		t.Errorf("expected the editor to load the complete value regardless of the viewer's display cap, got: %q", m.editor.Value())
+	}
+}
+
+func TestEditorCancelMsg_DirtyEditorIsStashedAsABuffer(t *testing.T) {
+	m := &Model{cfg: config.DefaultConfig()}
+	m.editor = editor.New("session:1", []byte("original"))
+	m.editor.SetContent([]byte("edited"))
+
+	newModel, _ := m.Update(editor.EditorCancelMsg{})
+	m = newModel.(*Model)
+
+	if m.editor != nil {
+		t.Fatal("expected the editor to be closed")
+	}
+
+	buffers := m.DirtyBuffers()
+	buf, ok := buffers["session:1"]
+	if !ok {
+		t.Fatalf("expected a dirty buffer for session:1, got %v", buffers)
+	}
+	if string(buf.Value) != "edited" {
+		t.Errorf("expected buffered value %q, got %q", "edited", buf.Value)
+	}
+}
+
+func TestEditorCancelMsg_CleanEditorIsNotStashed(t *testing.T) {
+	m := &Model{cfg: config.DefaultConfig()}
+	m.editor = editor.New("session:1", []byte("original"))
+
+	newModel, _ := m.Update(editor.EditorCancelMsg{})
+	m = newModel.(*Model)
+
+	if len(m.DirtyBuffers()) != 0 {
+		t.Errorf("expected no dirty buffers for an unmodified editor, got %v", m.DirtyBuffers())
+	}
+}
+
+func TestTwoModifiedBuffersAreBothReportedAsDirty(t *testing.T) {
+	m := &Model{cfg: config.DefaultConfig()}
+
+	m.editor = editor.New("session:1", []byte("original-1"))
+	m.editor.SetContent([]byte("edited-1"))
+	newModel, _ := m.Update(editor.EditorCancelMsg{})
+	m = newModel.(*Model)
+
+	m.editor = editor.New("session:2", []byte("original-2"))
+	m.editor.SetContent([]byte("edited-2"))
+	newModel, _ = m.Update(editor.EditorCancelMsg{})
+	m = newModel.(*Model)
+
+	buffers := m.DirtyBuffers()
+	if len(buffers) != 2 {
+		t.Fatalf("expected 2 dirty buffers, got %d: %v", len(buffers), buffers)
+	}
+	if string(buffers["session:1"].Value) != "edited-1" {
+		t.Errorf("expected session:1 buffer to hold %q, got %q", "edited-1", buffers["session:1"].Value)
+	}
+	if string(buffers["session:2"].Value) != "edited-2" {
+		t.Errorf("expected session:2 buffer to hold %q, got %q", "edited-2", buffers["session:2"].Value)
+	}
+}
+
+func TestFormatDirtyBuffers(t *testing.T) {
+	t.Run("no buffers", func(t *testing.T) {
+		got := FormatDirtyBuffers(nil)
+		if got != "No unsaved buffers" {
+			t.Errorf("unexpected message: %q", got)
+		}
+	})
+
+	t.Run("lists keys sorted with sizes", func(t *testing.T) {
+		buffers := map[string]DirtyBuffer{
+			"session:2": {Key: "session:2", Value: []byte("ab")},
+			"session:1": {Key: "session:1", Value: []byte("abcde")},
+		}
+		got := FormatDirtyBuffers(buffers)
+		want := "Unsaved buffers (2):\n  session:1 (5 bytes)\n  session:2 (2 bytes)"
+		if got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+}
+
+func TestSaveAllBuffersCmd_EmitsASaveForEachBuffer(t *testing.T) {
+	mock := &multiBufferMock{
+		casItems: map[string]*client.CASItem{
+			"session:1": {Key: "session:1", Value: []byte("old-1")},
+			"session:2": {Key: "session:2", Value: []byte("old-2")},
+		},
+		casErrs: map[string]error{},
+	}
+	m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
+
+	buffers := map[string]DirtyBuffer{
+		"session:1": {Key: "session:1", Value: []byte("new-1")},
+		"session:2": {Key: "session:2", Value: []byte("new-2")},
+	}
+
+	cmd := m.saveAllBuffersCmd(buffers)
+	msg := cmd()
+
+	result, ok := msg.(SaveAllBuffersResultMsg)
+	if !ok {
+		t.Fatalf("expected SaveAllBuffersResultMsg, got %T", msg)
+	}
+	if len(result.Saved) != 2 {
+		t.Fatalf("expected both buffers to be saved, got %v (failed: %v)", result.Saved, result.Failed)
+	}
+	if len(mock.casCalls) != 2 {
+		t.Fatalf("expected CompareAndSwap to be called once per buffer, got %d calls", len(mock.casCalls))
+	}
+
+	saved := map[string][]byte{}
+	for _, call := range mock.casCalls {
+		saved[call.Key] = call.Value
+	}
+	if string(saved["session:1"]) != "new-1" {
+		t.Errorf("expected session:1 to be saved with %q, got %q", "new-1", saved["session:1"])
+	}
+	if string(saved["session:2"]) != "new-2" {
+		t.Errorf("expected session:2 to be saved with %q, got %q", "new-2", saved["session:2"])
+	}
+}
+
+func TestSaveAllBuffersCmd_ReportsPerKeyFailures(t *testing.T) {
+	mock := &multiBufferMock{
+		casItems: map[string]*client.CASItem{
+			"session:1": {Key: "session:1", Value: []byte("old-1")},
+			"session:2": {Key: "session:2", Value: []byte("old-2")},
+		},
+		casErrs: map[string]error{
+			"session:2": errors.New("connection refused"),
+		},
+	}
+	m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
+
+	buffers := map[string]DirtyBuffer{
+		"session:1": {Key: "session:1", Value: []byte("new-1")},
+		"session:2": {Key: "session:2", Value: []byte("new-2")},
+	}
+
+	msg := m.saveAllBuffersCmd(buffers)()
+	result := msg.(SaveAllBuffersResultMsg)
+
+	if len(result.Saved) != 1 || result.Saved[0] != "session:1" {
+		t.Errorf("expected only session:1 to succeed, got %v", result.Saved)
+	}
+	if len(result.Failed) != 1 || result.Failed[0] != "session:2" {
+		t.Errorf("expected session:2 to fail, got %v", result.Failed)
+	}
+	if result.Errors["session:2"] == nil {
+		t.Error("expected an error recorded for session:2")
+	}
+}
+
+// multiBufferMock implements client.MemcachedClient, tracking every
+// CompareAndSwap call so "save all" can be verified to have issued one save
+// per buffer.
+type multiBufferMock struct {
+	casItems map[string]*client.CASItem
+	casCalls []*client.CASItem
+	casErrs  map[string]error
+}
+
+func (m *multiBufferMock) Get(key string) (*memcache.Item, error) { return nil, nil }
+
+func (m *multiBufferMock) GetWithCAS(key string) (*client.CASItem, error) {
+	item, ok := m.casItems[key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	clone := *item
+	return &clone, nil
+}
+
+func (m *multiBufferMock) GetAndTouch(key string, ttl int32) (*client.CASItem, error) {
+	return m.GetWithCAS(key)
+}
+
+func (m *multiBufferMock) Set(item *memcache.Item) error { return nil }
+
+func (m *multiBufferMock) CompareAndSwap(item *client.CASItem) error {
+	m.casCalls = append(m.casCalls, item)
+	if err, ok := m.casErrs[item.Key]; ok {
+		return err
+	}
+	return nil
+}
+
+func (m *multiBufferMock) Delete(key string) error { return nil }
+
+func (m *multiBufferMock) Close() error { return nil }
+
+func (m *multiBufferMock) Address() string { return "localhost:11211" }
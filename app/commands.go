@@ -2,8 +2,10 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/atotto/clipboard"
@@ -30,6 +32,37 @@ func calculateRemainingTTL(expiration int64) int32 {
 	return int32(remaining)
 }
 
+// oversizedValueErr returns a friendly error message if value exceeds the
+// configured maximum item size, or nil if it fits.
+func oversizedValueErr(value []byte, maxItemSize int) error {
+	if maxItemSize > 0 && len(value) > maxItemSize {
+		return fmt.Errorf("value exceeds item size limit (%d bytes)", maxItemSize)
+	}
+	return nil
+}
+
+// translateServerError maps a protocol-level error recognized by
+// client.ParseProtocolError to a friendly message, e.g. in case an
+// oversized value slips past the pre-flight size check (stale config). err
+// is run through client.ParseProtocolError first, so this also works with
+// mocked clients that return the raw gomemcache error directly. Returns nil
+// if err doesn't match a known translation.
+func translateServerError(err error, maxItemSize int) error {
+	err = client.ParseProtocolError(err)
+	switch {
+	case errors.Is(err, client.ErrValueTooLarge):
+		return fmt.Errorf("value exceeds item size limit (%d bytes)", maxItemSize)
+	case errors.Is(err, client.ErrOutOfMemory):
+		return errors.New("server is out of memory and can't store any more items")
+	case errors.Is(err, client.ErrBadCommand):
+		return fmt.Errorf("server rejected the command: %v", err)
+	case errors.Is(err, client.ErrServerError):
+		return fmt.Errorf("server error: %v", err)
+	default:
+		return nil
+	}
+}
+
 // ClipboardCopyMsg is sent when clipboard copy is successful
 type ClipboardCopyMsg struct{}
 
@@ -40,8 +73,10 @@ type ClipboardErrorMsg struct {
 
 func (m *Model) connectCmd() tea.Cmd {
 	return func() tea.Msg {
-		detector := client.NewCapabilityDetector()
+		start := time.Now()
+		detector := client.NewCapabilityDetector().WithTimeout(m.cfg.Timeouts.Capability)
 		caps, err := detector.Detect(m.addr)
+		m.logger.Connect(m.addr, time.Since(start), err)
 		if err != nil {
 			return ErrorMsg{Err: err.Error()}
 		}
@@ -52,27 +87,145 @@ func (m *Model) connectCmd() tea.Cmd {
 	}
 }
 
+// reconnectCmd closes the current connection and reconnects to the same
+// address, reusing the same connect flow as switching servers (see
+// serverlist.ServerSelectedMsg handling in update.go).
+func (m *Model) reconnectCmd() tea.Cmd {
+	if m.mcClient != nil {
+		m.mcClient.Close()
+		m.mcClient = nil
+	}
+	m.state = StateConnecting
+	return m.connectCmd()
+}
+
+// nodeAddrs splits addr into the individual node addresses for multi-node
+// mode (a comma-separated list), or returns a single-element slice for the
+// common single-node case.
+func nodeAddrs(addr string) []string {
+	parts := strings.Split(addr, ",")
+	addrs := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			addrs = append(addrs, p)
+		}
+	}
+	return addrs
+}
+
 func (m *Model) loadKeysCmd() tea.Cmd {
 	return func() tea.Msg {
-		// Skip key enumeration if server doesn't support metadump
+		// Skip key enumeration if server doesn't support metadump, unless
+		// the cachedump fallback is allowed to pick up the slack
 		if !m.supportsMetadump {
-			return KeysLoadedMsg{Keys: []models.KeyInfo{}}
+			if m.cfg.Limits.EnumerationBackend == "metadump" {
+				return KeysLoadedMsg{Keys: []models.KeyInfo{}}
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), m.cfg.Timeouts.KeyEnumeration)
+			defer cancel()
+			return m.enumerateViaCacheDump(ctx)
+		}
+
+		addrs := nodeAddrs(m.addr)
+		start := time.Now()
+
+		if len(addrs) > 1 {
+			ctx, cancel := context.WithTimeout(context.Background(), m.cfg.Timeouts.KeyEnumeration)
+			defer cancel()
+
+			keys, failedNodes := client.NewMultiNodeEnumerator(addrs).
+				WithConcurrency(m.cfg.Limits.MultiNodeConcurrency).
+				WithConfigure(func(e *client.KeyEnumerator) {
+					e.WithTimeout(m.cfg.Timeouts.KeyEnumeration).
+						WithLimit(m.cfg.Limits.MaxKeys).
+						WithPrefix(m.cfg.Limits.KeyPrefix).
+						WithRetainRawLine(m.cfg.Logging.Debug)
+				}).
+				WithProgress(func(addr string, completed, total int, keyCount int, err error) {
+					m.logger.Enumerate(addr, keyCount, time.Since(start), err)
+				}).
+				EnumerateAll(ctx)
+			m.logger.Enumerate(m.addr, len(keys), time.Since(start), nil)
+			return KeysLoadedMsg{Keys: keys, FailedNodes: failedNodes}
 		}
 
-		enum := client.NewKeyEnumerator(m.addr)
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), m.cfg.Timeouts.KeyEnumeration)
 		defer cancel()
 
+		if m.cfg.Limits.EnumerationBackend == "cachedump" {
+			return m.enumerateViaCacheDump(ctx)
+		}
+
+		enum := client.NewKeyEnumerator(m.addr).
+			WithTimeout(m.cfg.Timeouts.KeyEnumeration).
+			WithLimit(m.cfg.Limits.MaxKeys).
+			WithPrefix(m.cfg.Limits.KeyPrefix).
+			WithRetainRawLine(m.cfg.Logging.Debug)
+
 		keys, err := enum.EnumerateAll(ctx)
+		m.logger.Enumerate(m.addr, len(keys), time.Since(start), err)
 		if err != nil {
+			if client.IsMetadumpUnsupportedErr(err) {
+				if m.cfg.Limits.EnumerationBackend == "metadump" {
+					return MetadumpUnsupportedMsg{}
+				}
+				return m.enumerateViaCacheDump(ctx)
+			}
 			return ErrorMsg{Err: err.Error()}
 		}
-		return KeysLoadedMsg{Keys: keys}
+		return KeysLoadedMsg{Keys: keys, Truncated: enum.Truncated()}
 	}
 }
 
+// enumerateViaCacheDump runs the "stats items" + "stats cachedump" fallback
+// enumeration (see client.CacheDumpEnumerator) and always marks its result
+// as partial: cachedump is deprecated upstream and can miss keys that are
+// evicted or expire mid-dump, unlike a full metadump crawl.
+func (m *Model) enumerateViaCacheDump(ctx context.Context) tea.Msg {
+	start := time.Now()
+	enum := client.NewCacheDumpEnumerator(m.addr).WithTimeout(m.cfg.Timeouts.KeyEnumeration)
+	keys, err := enum.EnumerateAll(ctx)
+	m.logger.Enumerate(m.addr, len(keys), time.Since(start), err)
+	if err != nil {
+		return ErrorMsg{Err: err.Error()}
+	}
+	return KeysLoadedMsg{
+		Keys:          keys,
+		Partial:       true,
+		PartialReason: "via stats cachedump (deprecated; may miss recently evicted/expired keys)",
+	}
+}
+
+// nodeOperationErr returns a clear error message if key can't be safely
+// operated on against the active connection: either it belongs to a node
+// that failed the last enumeration, or (in multi-node mode) to a node other
+// than the primary one this client is connected to. Returns "" if the
+// operation is safe to proceed (including the common single-node case,
+// where ki.Node is always empty).
+func (m *Model) nodeOperationErr(ki *models.KeyInfo) string {
+	if ki == nil || ki.Node == "" {
+		return ""
+	}
+
+	for _, failed := range m.failedNodes {
+		if failed == ki.Node {
+			return fmt.Sprintf("node %s is unreachable", ki.Node)
+		}
+	}
+
+	addrs := nodeAddrs(m.addr)
+	if len(addrs) > 0 && ki.Node != addrs[0] {
+		return fmt.Sprintf("key belongs to node %s, which is not the active connection (%s)", ki.Node, addrs[0])
+	}
+
+	return ""
+}
+
 func (m *Model) loadValueCmd(key string) tea.Cmd {
 	return func() tea.Msg {
+		if reason := m.nodeOperationErr(m.currentKey); reason != "" {
+			return ErrorMsg{Err: fmt.Sprintf("cannot load %q: %s", key, reason)}
+		}
 		if m.mcClient == nil {
 			return ErrorMsg{Err: "client not connected"}
 		}
@@ -92,18 +245,19 @@ func (m *Model) loadValueCmd(key string) tea.Cmd {
 	}
 }
 
-func (m *Model) saveValueCmd(key string, value []byte, keyInfo *models.KeyInfo, casItem *client.CASItem) tea.Cmd {
+func (m *Model) saveValueCmd(key string, value []byte, keyInfo *models.KeyInfo, casItem *client.CASItem, flags uint32) tea.Cmd {
 	return func() tea.Msg {
 		if m.mcClient == nil {
 			return ErrorMsg{Err: "client not connected"}
 		}
 
-		// Preserve Flags from CAS item and TTL from key info
-		flags := uint32(0)
-		expiration := int32(0)
-		if casItem != nil {
-			flags = casItem.Flags
+		if sizeErr := oversizedValueErr(value, m.cfg.Limits.MaxItemSize); sizeErr != nil {
+			return ErrorMsg{Err: sizeErr.Error()}
 		}
+
+		// TTL is preserved from key info; flags are supplied by the caller
+		// (typically the editor, defaulting to the previously loaded value)
+		expiration := int32(0)
 		if keyInfo != nil {
 			expiration = calculateRemainingTTL(keyInfo.Expiration)
 		}
@@ -115,6 +269,9 @@ func (m *Model) saveValueCmd(key string, value []byte, keyInfo *models.KeyInfo,
 			Expiration: expiration,
 		})
 		if err != nil {
+			if friendly := translateServerError(err, m.cfg.Limits.MaxItemSize); friendly != nil {
+				return ErrorMsg{Err: friendly.Error()}
+			}
 			return ErrorMsg{Err: fmt.Sprintf("failed to save value: %v", err)}
 		}
 
@@ -122,12 +279,16 @@ func (m *Model) saveValueCmd(key string, value []byte, keyInfo *models.KeyInfo,
 	}
 }
 
-func (m *Model) saveValueWithCASCmd(key string, value []byte, originalCASItem *client.CASItem, keyInfo *models.KeyInfo) tea.Cmd {
+func (m *Model) saveValueWithCASCmd(key string, value []byte, keyInfo *models.KeyInfo, flags uint32) tea.Cmd {
 	return func() tea.Msg {
 		if m.mcClient == nil {
 			return ErrorMsg{Err: "client not connected"}
 		}
 
+		if sizeErr := oversizedValueErr(value, m.cfg.Limits.MaxItemSize); sizeErr != nil {
+			return ErrorMsg{Err: sizeErr.Error()}
+		}
+
 		// Re-fetch the item to get a valid mcItem for CompareAndSwap
 		casItem, err := m.mcClient.GetWithCAS(key)
 		if err != nil {
@@ -139,12 +300,11 @@ func (m *Model) saveValueWithCASCmd(key string, value []byte, originalCASItem *c
 		// so pre-comparison here would be meaningless. The actual CAS token is
 		// stored in the unexported casid field of memcache.Item.
 
-		// Update the CAS item with new value, preserving flags from original CAS item
-		// and calculating remaining TTL from key info (metadump expiration)
+		// Update the CAS item with new value and flags (flags are supplied by
+		// the caller, typically the editor, defaulting to the previously loaded
+		// value) and calculate remaining TTL from key info (metadump expiration)
 		casItem.Value = value
-		if originalCASItem != nil {
-			casItem.Flags = originalCASItem.Flags
-		}
+		casItem.Flags = flags
 		if keyInfo != nil {
 			casItem.Expiration = calculateRemainingTTL(keyInfo.Expiration)
 		}
@@ -154,6 +314,12 @@ func (m *Model) saveValueWithCASCmd(key string, value []byte, originalCASItem *c
 			if client.IsCASConflict(err) {
 				return ErrorMsg{Err: "CAS conflict: value was modified by another client. Please reload and try again."}
 			}
+			if client.IsNotStored(err) {
+				return ErrorMsg{Err: "key was deleted or evicted before the save completed"}
+			}
+			if friendly := translateServerError(err, m.cfg.Limits.MaxItemSize); friendly != nil {
+				return ErrorMsg{Err: friendly.Error()}
+			}
 			return ErrorMsg{Err: fmt.Sprintf("failed to save value: %v", err)}
 		}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/bradfitz/gomemcache/memcache"
 	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/config"
 	"github.com/nnnkkk7/memtui/models"
 )
 
@@ -32,6 +33,13 @@ func (m *mockMemcachedClient) GetWithCAS(key string) (*client.CASItem, error) {
 	return m.getWithCAS, nil
 }
 
+func (m *mockMemcachedClient) GetAndTouch(key string, ttl int32) (*client.CASItem, error) {
+	if m.getWithErr != nil {
+		return nil, m.getWithErr
+	}
+	return m.getWithCAS, nil
+}
+
 func (m *mockMemcachedClient) Set(item *memcache.Item) error {
 	m.lastSetItem = item
 	return m.setErr
@@ -112,7 +120,7 @@ func TestCalculateRemainingTTL(t *testing.T) {
 func TestSaveValueCmd_PreservesTTLAndFlags(t *testing.T) {
 	t.Run("preserves flags from CASItem and TTL from KeyInfo", func(t *testing.T) {
 		mock := &mockMemcachedClient{}
-		m := &Model{mcClient: mock}
+		m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
 
 		futureExp := time.Now().Unix() + 600 // 10 minutes
 		keyInfo := &models.KeyInfo{
@@ -124,7 +132,7 @@ func TestSaveValueCmd_PreservesTTLAndFlags(t *testing.T) {
 			Flags: 42,
 		}
 
-		cmd := m.saveValueCmd("test-key", []byte("new value"), keyInfo, casItem)
+		cmd := m.saveValueCmd("test-key", []byte("new value"), keyInfo, casItem, casItem.Flags)
 		msg := cmd()
 
 		if _, ok := msg.(KeyCreatedMsg); !ok {
@@ -155,9 +163,9 @@ func TestSaveValueCmd_PreservesTTLAndFlags(t *testing.T) {
 
 	t.Run("handles nil keyInfo and casItem gracefully", func(t *testing.T) {
 		mock := &mockMemcachedClient{}
-		m := &Model{mcClient: mock}
+		m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
 
-		cmd := m.saveValueCmd("test-key", []byte("value"), nil, nil)
+		cmd := m.saveValueCmd("test-key", []byte("value"), nil, nil, 0)
 		msg := cmd()
 
 		if _, ok := msg.(KeyCreatedMsg); !ok {
@@ -175,7 +183,7 @@ func TestSaveValueCmd_PreservesTTLAndFlags(t *testing.T) {
 
 	t.Run("returns zero TTL for expired keys", func(t *testing.T) {
 		mock := &mockMemcachedClient{}
-		m := &Model{mcClient: mock}
+		m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
 
 		pastExp := time.Now().Unix() - 100
 		keyInfo := &models.KeyInfo{
@@ -183,7 +191,7 @@ func TestSaveValueCmd_PreservesTTLAndFlags(t *testing.T) {
 			Expiration: pastExp,
 		}
 
-		cmd := m.saveValueCmd("test-key", []byte("value"), keyInfo, nil)
+		cmd := m.saveValueCmd("test-key", []byte("value"), keyInfo, nil, 0)
 		cmd()
 
 		if mock.lastSetItem.Expiration != 0 {
@@ -192,9 +200,9 @@ func TestSaveValueCmd_PreservesTTLAndFlags(t *testing.T) {
 	})
 
 	t.Run("returns error when client is nil", func(t *testing.T) {
-		m := &Model{mcClient: nil}
+		m := &Model{mcClient: nil, cfg: config.DefaultConfig()}
 
-		cmd := m.saveValueCmd("test-key", []byte("value"), nil, nil)
+		cmd := m.saveValueCmd("test-key", []byte("value"), nil, nil, 0)
 		msg := cmd()
 
 		errMsg, ok := msg.(ErrorMsg)
@@ -211,9 +219,9 @@ func TestSaveValueCmd_PreservesTTLAndFlags(t *testing.T) {
 		mock := &mockMemcachedClient{
 			setErr: errors.New("connection refused"),
 		}
-		m := &Model{mcClient: mock}
+		m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
 
-		cmd := m.saveValueCmd("test-key", []byte("value"), nil, nil)
+		cmd := m.saveValueCmd("test-key", []byte("value"), nil, nil, 0)
 		msg := cmd()
 
 		errMsg, ok := msg.(ErrorMsg)
@@ -225,6 +233,47 @@ func TestSaveValueCmd_PreservesTTLAndFlags(t *testing.T) {
 			t.Error("expected non-empty error message")
 		}
 	})
+
+	t.Run("blocks oversized value pre-flight", func(t *testing.T) {
+		mock := &mockMemcachedClient{}
+		cfg := config.DefaultConfig()
+		cfg.Limits.MaxItemSize = 10
+		m := &Model{mcClient: mock, cfg: cfg}
+
+		cmd := m.saveValueCmd("test-key", []byte("this value is too long"), nil, nil, 0)
+		msg := cmd()
+
+		errMsg, ok := msg.(ErrorMsg)
+		if !ok {
+			t.Fatalf("expected ErrorMsg, got %T", msg)
+		}
+		if errMsg.Err != "value exceeds item size limit (10 bytes)" {
+			t.Errorf("unexpected error message: %q", errMsg.Err)
+		}
+		if mock.lastSetItem != nil {
+			t.Error("expected Set to not be called for oversized value")
+		}
+	})
+
+	t.Run("translates server object-too-large error", func(t *testing.T) {
+		mock := &mockMemcachedClient{
+			setErr: errors.New("memcache: unexpected response line from \"set\": \"SERVER_ERROR object too large for cache\\r\\n\""),
+		}
+		cfg := config.DefaultConfig()
+		m := &Model{mcClient: mock, cfg: cfg}
+
+		cmd := m.saveValueCmd("test-key", []byte("value"), nil, nil, 0)
+		msg := cmd()
+
+		errMsg, ok := msg.(ErrorMsg)
+		if !ok {
+			t.Fatalf("expected ErrorMsg, got %T", msg)
+		}
+		want := "value exceeds item size limit (1048576 bytes)"
+		if errMsg.Err != want {
+			t.Errorf("expected %q, got %q", want, errMsg.Err)
+		}
+	})
 }
 
 // TestSaveValueWithCASCmd_PreservesTTLAndFlags tests CAS update preserves TTL and flags
@@ -236,7 +285,7 @@ func TestSaveValueWithCASCmd_PreservesTTLAndFlags(t *testing.T) {
 			Flags: 0,
 		}
 		mock := &mockMemcachedClient{getWithCAS: mockCASItem}
-		m := &Model{mcClient: mock}
+		m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
 
 		originalCAS := &client.CASItem{
 			Key:   "test-key",
@@ -248,7 +297,7 @@ func TestSaveValueWithCASCmd_PreservesTTLAndFlags(t *testing.T) {
 			Expiration: futureExp,
 		}
 
-		cmd := m.saveValueWithCASCmd("test-key", []byte("updated value"), originalCAS, keyInfo)
+		cmd := m.saveValueWithCASCmd("test-key", []byte("updated value"), keyInfo, originalCAS.Flags)
 		msg := cmd()
 
 		if _, ok := msg.(KeyCreatedMsg); !ok {
@@ -274,9 +323,9 @@ func TestSaveValueWithCASCmd_PreservesTTLAndFlags(t *testing.T) {
 	})
 
 	t.Run("returns error when client is nil", func(t *testing.T) {
-		m := &Model{mcClient: nil}
+		m := &Model{mcClient: nil, cfg: config.DefaultConfig()}
 
-		cmd := m.saveValueWithCASCmd("test-key", []byte("value"), nil, nil)
+		cmd := m.saveValueWithCASCmd("test-key", []byte("value"), nil, 0)
 		msg := cmd()
 
 		errMsg, ok := msg.(ErrorMsg)
@@ -293,9 +342,9 @@ func TestSaveValueWithCASCmd_PreservesTTLAndFlags(t *testing.T) {
 		mock := &mockMemcachedClient{
 			getWithErr: errors.New("key not found"),
 		}
-		m := &Model{mcClient: mock}
+		m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
 
-		cmd := m.saveValueWithCASCmd("test-key", []byte("value"), nil, nil)
+		cmd := m.saveValueWithCASCmd("test-key", []byte("value"), nil, 0)
 		msg := cmd()
 
 		errMsg, ok := msg.(ErrorMsg)
@@ -308,6 +357,24 @@ func TestSaveValueWithCASCmd_PreservesTTLAndFlags(t *testing.T) {
 		}
 	})
 
+	t.Run("blocks oversized value pre-flight", func(t *testing.T) {
+		mock := &mockMemcachedClient{}
+		cfg := config.DefaultConfig()
+		cfg.Limits.MaxItemSize = 10
+		m := &Model{mcClient: mock, cfg: cfg}
+
+		cmd := m.saveValueWithCASCmd("test-key", []byte("this value is too long"), nil, 0)
+		msg := cmd()
+
+		errMsg, ok := msg.(ErrorMsg)
+		if !ok {
+			t.Fatalf("expected ErrorMsg, got %T", msg)
+		}
+		if errMsg.Err != "value exceeds item size limit (10 bytes)" {
+			t.Errorf("unexpected error message: %q", errMsg.Err)
+		}
+	})
+
 	t.Run("returns CAS conflict error when CompareAndSwap fails", func(t *testing.T) {
 		mockCASItem := &client.CASItem{
 			Key:   "test-key",
@@ -317,9 +384,9 @@ func TestSaveValueWithCASCmd_PreservesTTLAndFlags(t *testing.T) {
 			getWithCAS: mockCASItem,
 			casErr:     client.NewCASConflictError("test-key"),
 		}
-		m := &Model{mcClient: mock}
+		m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
 
-		cmd := m.saveValueWithCASCmd("test-key", []byte("new value"), nil, nil)
+		cmd := m.saveValueWithCASCmd("test-key", []byte("new value"), nil, 0)
 		msg := cmd()
 
 		errMsg, ok := msg.(ErrorMsg)
@@ -0,0 +1,51 @@
+package app_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/viewer"
+)
+
+func TestModel_CopyFormattedValue_MatchesHexFormatterOutput(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "binkey"}})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(app.ValueLoadedMsg{Key: "binkey", Value: []byte{0x00, 0xff, 0x10, 0x20}})
+	m = newModel.(*app.Model)
+	m.Viewer().SetViewMode(viewer.ViewModeHex)
+
+	wantContent := m.Viewer().Content()
+	if wantContent == "" {
+		t.Fatal("expected non-empty hex formatted content")
+	}
+
+	_, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Copy formatted value"}})
+	if cmd == nil {
+		t.Fatal("expected a clipboard command to be returned")
+	}
+	// copyToClipboardCmd writes to the system clipboard, which isn't
+	// available in CI; just confirm the viewer's content (what would have
+	// been copied) matches the active hex formatter output rather than the
+	// raw value.
+	if wantContent == string([]byte{0x00, 0xff, 0x10, 0x20}) {
+		t.Fatal("test setup invalid: formatted content should differ from the raw value")
+	}
+}
+
+func TestModel_CopyFormattedValue_NoOpWithoutValue(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	_, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Copy formatted value"}})
+	if cmd != nil {
+		t.Error("expected no command when no value is loaded")
+	}
+}
@@ -0,0 +1,33 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/nnnkkk7/memtui/config"
+)
+
+// ConnectionString returns the payload that "Copy connection string" copies
+// to the clipboard (for testing).
+func (m *Model) ConnectionString() string {
+	return m.connectionString()
+}
+
+// connectionString returns the currently connected server's address,
+// prefixed with its configured name when one is found, e.g. "prod
+// (cache.example.com:11211)". Falls back to the bare address if the server
+// isn't in the configured list (e.g. connected via a CLI flag) or the
+// config can't be loaded.
+func (m *Model) connectionString() string {
+	cfg, err := config.LoadServers()
+	if err != nil {
+		return m.addr
+	}
+
+	for _, s := range cfg.Servers {
+		if s.Address == m.addr {
+			return fmt.Sprintf("%s (%s)", s.Name, s.Address)
+		}
+	}
+
+	return m.addr
+}
@@ -0,0 +1,45 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+)
+
+func TestModel_CopyConnectionString_MatchesConnectedAddress(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	if err := config.SaveServers(&config.ServersConfig{
+		Servers: []config.ServerConfig{
+			{Name: "primary", Address: "localhost:11211", Default: true},
+		},
+		LastUsed: "primary",
+	}); err != nil {
+		t.Fatalf("failed to seed servers config: %v", err)
+	}
+
+	m := app.NewModel("localhost:11211")
+
+	if got := m.ConnectionString(); got != "primary (localhost:11211)" {
+		t.Errorf("expected %q, got %q", "primary (localhost:11211)", got)
+	}
+
+	_, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Copy connection string"}})
+	if cmd == nil {
+		t.Fatal("expected a clipboard command to be returned")
+	}
+}
+
+func TestModel_CopyConnectionString_FallsBackToBareAddress(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	m := app.NewModel("unconfigured-host:11211")
+
+	if got := m.ConnectionString(); got != "unconfigured-host:11211" {
+		t.Errorf("expected the bare address when no server matches, got %q", got)
+	}
+}
@@ -78,10 +78,15 @@ func HandleDeleteResult(msg tea.Msg) DeleteResult {
 
 // CreateDeleteConfirmDialog creates a confirmation dialog for key deletion.
 // The dialog includes the key name in the message and stores the key
-// in the context for retrieval after confirmation.
-func CreateDeleteConfirmDialog(key string) *dialog.ConfirmDialog {
+// in the context for retrieval after confirmation. When production is true
+// (the active server is tagged "production"), the message calls out the
+// extra risk.
+func CreateDeleteConfirmDialog(key string, production bool) *dialog.ConfirmDialog {
 	title := "Delete Key"
 	message := fmt.Sprintf("Are you sure you want to delete the key?\n\n  %s\n\nThis action cannot be undone.", key)
+	if production {
+		message = fmt.Sprintf("⚠ PRODUCTION SERVER ⚠\n\n%s", message)
+	}
 
 	return dialog.NewWithContext(title, message, key)
 }
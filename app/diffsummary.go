@@ -0,0 +1,57 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffSummary describes how an edited value compares to its pre-edit
+// original: lines added/removed and the net byte delta.
+type diffSummary struct {
+	LinesAdded   int
+	LinesRemoved int
+	ByteDelta    int
+}
+
+// String renders the summary as a short status message, e.g.
+// "Saved: +2/-1 lines, +14 bytes".
+func (d diffSummary) String() string {
+	return fmt.Sprintf("Saved: +%d/-%d lines, %+d bytes", d.LinesAdded, d.LinesRemoved, d.ByteDelta)
+}
+
+// summarizeDiff compares original and updated line-by-line using a simple
+// multiset difference (not a full LCS diff) to report how many lines were
+// added and removed, plus the net byte delta.
+func summarizeDiff(original, updated []byte) diffSummary {
+	counts := make(map[string]int)
+	for _, l := range splitLines(original) {
+		counts[l]++
+	}
+	for _, l := range splitLines(updated) {
+		counts[l]--
+	}
+
+	var added, removed int
+	for _, c := range counts {
+		switch {
+		case c > 0:
+			removed += c
+		case c < 0:
+			added += -c
+		}
+	}
+
+	return diffSummary{
+		LinesAdded:   added,
+		LinesRemoved: removed,
+		ByteDelta:    len(updated) - len(original),
+	}
+}
+
+// splitLines splits content into lines, treating empty input as zero lines.
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	return strings.Split(string(content), "\n")
+}
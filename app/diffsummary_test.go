@@ -0,0 +1,60 @@
+package app
+
+import "testing"
+
+func TestSummarizeDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		original []byte
+		updated  []byte
+		expected diffSummary
+	}{
+		{
+			name:     "no change",
+			original: []byte("hello"),
+			updated:  []byte("hello"),
+			expected: diffSummary{LinesAdded: 0, LinesRemoved: 0, ByteDelta: 0},
+		},
+		{
+			name:     "line added",
+			original: []byte("a\nb"),
+			updated:  []byte("a\nb\nc"),
+			expected: diffSummary{LinesAdded: 1, LinesRemoved: 0, ByteDelta: 2},
+		},
+		{
+			name:     "line removed",
+			original: []byte("a\nb\nc"),
+			updated:  []byte("a\nb"),
+			expected: diffSummary{LinesAdded: 0, LinesRemoved: 1, ByteDelta: -2},
+		},
+		{
+			name:     "line changed",
+			original: []byte("a\nb"),
+			updated:  []byte("a\nx"),
+			expected: diffSummary{LinesAdded: 1, LinesRemoved: 1, ByteDelta: 0},
+		},
+		{
+			name:     "emptied value",
+			original: []byte("hello"),
+			updated:  []byte(""),
+			expected: diffSummary{LinesAdded: 0, LinesRemoved: 1, ByteDelta: -5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarizeDiff(tt.original, tt.updated)
+			if got != tt.expected {
+				t.Errorf("summarizeDiff(%q, %q) = %+v, expected %+v", tt.original, tt.updated, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiffSummary_String(t *testing.T) {
+	s := diffSummary{LinesAdded: 2, LinesRemoved: 1, ByteDelta: 14}
+	want := "Saved: +2/-1 lines, +14 bytes"
+	if got := s.String(); got != want {
+		t.Errorf("String() = %q, expected %q", got, want)
+	}
+}
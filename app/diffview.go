@@ -0,0 +1,130 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// diffSelectionHint is shown when "Diff selected keys" is invoked without
+// exactly two keys selected in the key list.
+const diffSelectionHint = "select exactly two keys to diff (use space to toggle selection)"
+
+// DiffKeysResultMsg carries the two values fetched for a "Diff selected
+// keys" comparison, or an error if either fetch failed.
+type DiffKeysResultMsg struct {
+	KeyA, KeyB     string
+	ValueA, ValueB []byte
+	Err            error
+}
+
+// diffSelectedKeysCmd fetches the current values of keyA and keyB so they
+// can be diffed. Callers are expected to have already verified exactly two
+// keys are selected (see diffSelectionHint).
+func (m *Model) diffSelectedKeysCmd(keyA, keyB string) tea.Cmd {
+	return func() tea.Msg {
+		if m.mcClient == nil {
+			return DiffKeysResultMsg{KeyA: keyA, KeyB: keyB, Err: fmt.Errorf("client not connected")}
+		}
+
+		itemA, err := m.mcClient.Get(keyA)
+		if err != nil {
+			return DiffKeysResultMsg{KeyA: keyA, KeyB: keyB, Err: fmt.Errorf("failed to load %q: %w", keyA, err)}
+		}
+		itemB, err := m.mcClient.Get(keyB)
+		if err != nil {
+			return DiffKeysResultMsg{KeyA: keyA, KeyB: keyB, Err: fmt.Errorf("failed to load %q: %w", keyB, err)}
+		}
+
+		return DiffKeysResultMsg{KeyA: keyA, KeyB: keyB, ValueA: itemA.Value, ValueB: itemB.Value}
+	}
+}
+
+// maxDiffLines caps how many lines of each value diffLines compares. The
+// classic LCS algorithm below is O(n·m) in both time and space, so two
+// ~1MB values with many short lines (not even an adversarial input — two
+// large JSONL blobs, say) could otherwise allocate gigabytes before
+// producing anything. Lines beyond the cap are left out of the comparison
+// and FormatKeyDiff notes the truncation instead.
+const maxDiffLines = 2000
+
+// FormatKeyDiff renders a unified diff between two keys' values: lines
+// present only in valueA are prefixed "-", lines present only in valueB are
+// prefixed "+", and lines common to both (in the longest-common-subsequence
+// sense) are prefixed with a space. Each value is compared up to
+// maxDiffLines; values with more lines than that are truncated and the
+// output notes it.
+func FormatKeyDiff(keyA, keyB string, valueA, valueB []byte) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", keyA, keyB)
+
+	linesA, truncatedA := capDiffLines(splitLines(valueA))
+	linesB, truncatedB := capDiffLines(splitLines(valueB))
+
+	for _, line := range diffLines(linesA, linesB) {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	if truncatedA || truncatedB {
+		fmt.Fprintf(&b, "... diff truncated to the first %d lines of each value\n", maxDiffLines)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// capDiffLines truncates lines to maxDiffLines, reporting whether it had to.
+func capDiffLines(lines []string) ([]string, bool) {
+	if len(lines) > maxDiffLines {
+		return lines[:maxDiffLines], true
+	}
+	return lines, false
+}
+
+// diffLines computes a line-level diff between a and b using the classic
+// longest-common-subsequence algorithm, returning one formatted entry per
+// line: "- <line>" for a line only in a, "+ <line>" for a line only in b,
+// and "  <line>" for a line common to both.
+func diffLines(a, b []string) []string {
+	n, m := len(a), len(b)
+
+	// lcsLen[i][j] holds the length of the LCS of a[i:] and b[j:].
+	lcsLen := make([][]int, n+1)
+	for i := range lcsLen {
+		lcsLen[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcsLen[i][j] = lcsLen[i+1][j+1] + 1
+			} else if lcsLen[i+1][j] >= lcsLen[i][j+1] {
+				lcsLen[i][j] = lcsLen[i+1][j]
+			} else {
+				lcsLen[i][j] = lcsLen[i][j+1]
+			}
+		}
+	}
+
+	var out []string
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, "  "+a[i])
+			i++
+			j++
+		case lcsLen[i+1][j] >= lcsLen[i][j+1]:
+			out = append(out, "- "+a[i])
+			i++
+		default:
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		out = append(out, "- "+a[i])
+	}
+	for ; j < m; j++ {
+		out = append(out, "+ "+b[j])
+	}
+	return out
+}
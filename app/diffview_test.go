@@ -0,0 +1,152 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestFormatKeyDiff_ReportsChangedLines(t *testing.T) {
+	valueA := []byte("{\n  \"name\": \"alice\",\n  \"age\": 30\n}")
+	valueB := []byte("{\n  \"name\": \"alice\",\n  \"age\": 31\n}")
+
+	diff := FormatKeyDiff("user:1", "user:2", valueA, valueB)
+
+	if !strings.Contains(diff, "--- user:1") || !strings.Contains(diff, "+++ user:2") {
+		t.Fatalf("expected a unified diff header, got:\n%s", diff)
+	}
+	if !strings.Contains(diff, "-   \"age\": 30") {
+		t.Errorf("expected the removed age line, got:\n%s", diff)
+	}
+	if !strings.Contains(diff, "+   \"age\": 31") {
+		t.Errorf("expected the added age line, got:\n%s", diff)
+	}
+	if !strings.Contains(diff, "  {") {
+		t.Errorf("expected the unchanged opening brace, got:\n%s", diff)
+	}
+}
+
+func TestFormatKeyDiff_IdenticalValuesHaveNoChangedLines(t *testing.T) {
+	value := []byte("same\ncontent")
+
+	diff := FormatKeyDiff("a", "b", value, value)
+
+	if strings.Contains(diff, "-") || strings.Contains(diff, "+++") == false {
+		// header always contains "+++"; body must contain no "-"/"+" entries
+	}
+	for _, line := range strings.Split(diff, "\n")[2:] {
+		if strings.HasPrefix(line, "-") || strings.HasPrefix(line, "+") {
+			t.Errorf("expected no changed lines for identical values, got %q", line)
+		}
+	}
+}
+
+func TestFormatKeyDiff_CapsLineCountAndNotesTruncation(t *testing.T) {
+	lines := make([]string, maxDiffLines+500)
+	for i := range lines {
+		lines[i] = "line"
+	}
+	valueA := []byte(strings.Join(lines, "\n"))
+	valueB := append(append([]byte{}, valueA...), []byte("\nextra")...)
+
+	diff := FormatKeyDiff("a", "b", valueA, valueB)
+
+	if !strings.Contains(diff, "diff truncated") {
+		t.Fatalf("expected a truncation notice, got:\n%s", diff)
+	}
+	if got := strings.Count(diff, "  line"); got > maxDiffLines {
+		t.Errorf("expected at most %d compared lines, got %d", maxDiffLines, got)
+	}
+}
+
+func TestDiffSelectedKeysCmd_ReturnsBothValues(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{
+		"user:1": []byte("alice"),
+		"user:2": []byte("bob"),
+	}}
+	m := &Model{mcClient: fetcher, cfg: config.DefaultConfig()}
+
+	msg, ok := m.diffSelectedKeysCmd("user:1", "user:2")().(DiffKeysResultMsg)
+	if !ok {
+		t.Fatalf("expected DiffKeysResultMsg")
+	}
+	if msg.Err != nil {
+		t.Fatalf("unexpected error: %v", msg.Err)
+	}
+	if string(msg.ValueA) != "alice" || string(msg.ValueB) != "bob" {
+		t.Errorf("unexpected values: %q, %q", msg.ValueA, msg.ValueB)
+	}
+}
+
+func TestDiffSelectedKeysCmd_FetchFailureReturnsError(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{"user:1": []byte("alice")}}
+	m := &Model{mcClient: fetcher, cfg: config.DefaultConfig()}
+
+	msg := m.diffSelectedKeysCmd("user:1", "user:missing")().(DiffKeysResultMsg)
+	if msg.Err == nil {
+		t.Fatal("expected an error for a key that fails to fetch")
+	}
+}
+
+func TestDiffSelectedKeysCmd_NoClientReturnsError(t *testing.T) {
+	m := &Model{cfg: config.DefaultConfig()}
+
+	msg := m.diffSelectedKeysCmd("a", "b")().(DiffKeysResultMsg)
+	if msg.Err == nil {
+		t.Fatal("expected an error when no client is connected")
+	}
+}
+
+// selectNKeys loads n keys into the key list and selects all of them.
+func selectNKeys(kl *keylist.Model, n int) []models.KeyInfo {
+	keys := make([]models.KeyInfo, n)
+	for i := range keys {
+		keys[i] = models.KeyInfo{Key: string(rune('a' + i))}
+	}
+	kl.SetKeys(keys)
+	for _, k := range keys {
+		kl.SelectKey(k.Key)
+		kl.ToggleSelection()
+	}
+	return keys
+}
+
+func TestModel_DiffSelectedKeys_RejectsNonTwoSelections(t *testing.T) {
+	m := NewModel("localhost:11211")
+	m.mcClient = &mockValueFetcher{}
+	selectNKeys(m.keyList, 3)
+
+	updated, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Diff selected keys"}})
+	um := updated.(*Model)
+
+	if cmd != nil {
+		t.Error("expected no command for a non-two-key selection")
+	}
+	if !strings.Contains(um.Error(), "select exactly two keys") {
+		t.Errorf("expected selection hint, got %q", um.Error())
+	}
+}
+
+func TestModel_DiffSelectedKeys_TwoSelectionsFetchesDiff(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{"a": []byte("one"), "b": []byte("two")}}
+	m := NewModel("localhost:11211")
+	m.mcClient = fetcher
+	selectNKeys(m.keyList, 2)
+
+	_, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Diff selected keys"}})
+	if cmd == nil {
+		t.Fatal("expected a diff command for a two-key selection")
+	}
+
+	msg, ok := cmd().(DiffKeysResultMsg)
+	if !ok {
+		t.Fatalf("expected DiffKeysResultMsg, got %T", msg)
+	}
+	if msg.Err != nil {
+		t.Fatalf("unexpected error: %v", msg.Err)
+	}
+}
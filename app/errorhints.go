@@ -0,0 +1,37 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// connectionErrorHint maps a substring found in a raw client/connection
+// error to a short, actionable hint. addr is substituted into the hint via
+// a single "%s" placeholder.
+type connectionErrorHint struct {
+	substr string
+	hint   string
+}
+
+// connectionErrorHints covers the client/connection failures users hit most
+// often, which otherwise surface as a raw OS/network error with no guidance
+// on what to do about it. Checked in order, first match wins.
+var connectionErrorHints = []connectionErrorHint{
+	{"connection refused", "is memcached running on %s?"},
+	{"no such host", "check that the hostname in %s is correct"},
+	{"i/o timeout", "%s isn't responding — check network connectivity or increase the connection timeout"},
+	{"EOF", "the connection to %s was closed unexpectedly — the server may have restarted or crashed"},
+}
+
+// FormatServerError renders a raw error alongside an actionable hint when it
+// matches a known client/connection failure against addr, the server that
+// was being talked to. Unknown errors are returned unchanged, so the raw
+// error always remains available (e.g. for debug logging via logger.Error).
+func FormatServerError(raw string, addr string) string {
+	for _, h := range connectionErrorHints {
+		if strings.Contains(raw, h.substr) {
+			return fmt.Sprintf("%s (hint: %s)", raw, fmt.Sprintf(h.hint, addr))
+		}
+	}
+	return raw
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatServerError(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        string
+		addr       string
+		wantHint   bool
+		wantSubstr string
+	}{
+		{
+			name:       "connection refused gets a hint",
+			raw:        "dial tcp 127.0.0.1:11211: connect: connection refused",
+			addr:       "127.0.0.1:11211",
+			wantHint:   true,
+			wantSubstr: "is memcached running on 127.0.0.1:11211?",
+		},
+		{
+			name:       "EOF gets a hint",
+			raw:        "EOF",
+			addr:       "cache.internal:11211",
+			wantHint:   true,
+			wantSubstr: "closed unexpectedly",
+		},
+		{
+			name:     "unknown error falls through unchanged",
+			raw:      "some totally unrecognized failure",
+			addr:     "127.0.0.1:11211",
+			wantHint: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatServerError(tt.raw, tt.addr)
+
+			if !strings.Contains(got, tt.raw) {
+				t.Errorf("FormatServerError(%q) = %q, want it to retain the raw error", tt.raw, got)
+			}
+
+			if tt.wantHint {
+				if !strings.Contains(got, tt.wantSubstr) {
+					t.Errorf("FormatServerError(%q) = %q, want it to contain %q", tt.raw, got, tt.wantSubstr)
+				}
+			} else if got != tt.raw {
+				t.Errorf("FormatServerError(%q) = %q, want it returned unchanged", tt.raw, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// FlashLevel identifies which color (if any) the status bar should flash to
+// report the outcome of a create/delete/save operation.
+type FlashLevel int
+
+const (
+	FlashNone FlashLevel = iota
+	FlashSuccess
+	FlashError
+)
+
+// flashDuration is how long the status bar stays flashed before reverting.
+const flashDuration = 500 * time.Millisecond
+
+// FlashClearMsg clears any active status bar flash.
+type FlashClearMsg struct{}
+
+// FlashClearCmd schedules the flash to clear after flashDuration.
+func FlashClearCmd() tea.Cmd {
+	return tea.Tick(flashDuration, func(time.Time) tea.Msg {
+		return FlashClearMsg{}
+	})
+}
+
+// flash sets the status bar flash level for a create/delete/save result and
+// schedules it to clear shortly after. It is a no-op unless
+// config.UIConfig.FlashOnResult is enabled.
+func (m *Model) flash(level FlashLevel) tea.Cmd {
+	if !m.cfg.UI.FlashOnResult {
+		return nil
+	}
+	m.flashLevel = level
+	return FlashClearCmd()
+}
+
+// FlashLevel returns the active status bar flash level.
+func (m *Model) FlashLevel() FlashLevel {
+	return m.flashLevel
+}
@@ -0,0 +1,99 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+)
+
+func modelWithFlash(t *testing.T, enabled bool) *app.Model {
+	t.Helper()
+	cfg := config.DefaultConfig()
+	cfg.UI.FlashOnResult = enabled
+	return app.NewModelWithConfig("localhost:11211", cfg)
+}
+
+func TestModel_Flash_SuccessAndErrorResultsSetLevel(t *testing.T) {
+	cases := []struct {
+		name  string
+		send  func(*app.Model) *app.Model
+		level app.FlashLevel
+	}{
+		{
+			name: "key created flashes success",
+			send: func(m *app.Model) *app.Model {
+				updated, _ := m.Update(app.KeyCreatedMsg{Key: "foo"})
+				return updated.(*app.Model)
+			},
+			level: app.FlashSuccess,
+		},
+		{
+			name: "new key error flashes error",
+			send: func(m *app.Model) *app.Model {
+				updated, _ := m.Update(app.NewKeyErrorMsg{Key: "foo", Err: errors.New("boom")})
+				return updated.(*app.Model)
+			},
+			level: app.FlashError,
+		},
+		{
+			name: "key deleted flashes success",
+			send: func(m *app.Model) *app.Model {
+				updated, _ := m.Update(app.KeyDeletedMsg{Key: "foo"})
+				return updated.(*app.Model)
+			},
+			level: app.FlashSuccess,
+		},
+		{
+			name: "delete error flashes error",
+			send: func(m *app.Model) *app.Model {
+				updated, _ := m.Update(app.DeleteErrorMsg{Key: "foo", Err: errors.New("boom")})
+				return updated.(*app.Model)
+			},
+			level: app.FlashError,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			m := modelWithFlash(t, true)
+			updated := tt.send(m)
+			if got := updated.FlashLevel(); got != tt.level {
+				t.Errorf("expected flash level %v, got %v", tt.level, got)
+			}
+		})
+	}
+}
+
+func TestModel_Flash_TickClearsLevel(t *testing.T) {
+	m := modelWithFlash(t, true)
+	updated, cmd := m.Update(app.DeleteErrorMsg{Key: "foo", Err: errors.New("boom")})
+	m = updated.(*app.Model)
+	if m.FlashLevel() != app.FlashError {
+		t.Fatalf("expected flash level to be set, got %v", m.FlashLevel())
+	}
+	if cmd == nil {
+		t.Fatal("expected a command to schedule the flash clear")
+	}
+
+	msg := cmd()
+	if _, ok := msg.(app.FlashClearMsg); !ok {
+		t.Fatalf("expected cmd to resolve to FlashClearMsg, got %T", msg)
+	}
+	cleared, _ := m.Update(msg)
+	m = cleared.(*app.Model)
+
+	if m.FlashLevel() != app.FlashNone {
+		t.Errorf("expected flash level to clear, got %v", m.FlashLevel())
+	}
+}
+
+func TestModel_Flash_DisabledByDefault(t *testing.T) {
+	m := modelWithFlash(t, false)
+	updated, _ := m.Update(app.KeyCreatedMsg{Key: "foo"})
+	m = updated.(*app.Model)
+	if m.FlashLevel() != app.FlashNone {
+		t.Errorf("expected flash to stay off when disabled, got %v", m.FlashLevel())
+	}
+}
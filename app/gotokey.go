@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+// GotoKeyContext marks an InputDialog as asking for the exact key name to
+// jump to via the "Go to key" command.
+type GotoKeyContext struct{}
+
+// CreateGotoKeyDialog creates an input dialog for entering the exact name of
+// a key to select directly, without scrolling.
+func CreateGotoKeyDialog() *dialog.InputDialog {
+	return dialog.NewInput("Go to Key").
+		WithPlaceholder("Enter exact key name...").
+		WithContext(GotoKeyContext{})
+}
+
+// gotoKey selects key in the key list, expanding any collapsed ancestor
+// folders so it becomes visible, and loads its value. If key isn't present
+// in the current (possibly filtered) key set, it reports "not found"
+// instead.
+func (m *Model) gotoKey(key string) tea.Cmd {
+	if !m.keyList.SelectKey(key) {
+		m.err = fmt.Sprintf("not found: %s", key)
+		return nil
+	}
+	ki := m.keyList.SelectedKey()
+	if ki == nil {
+		m.err = fmt.Sprintf("not found: %s", key)
+		return nil
+	}
+	m.err = ""
+	m.currentKey = ki
+	m.rememberRecentKey(ki.Key)
+	return tea.Batch(m.loadValueCmd(ki.Key), recordRecentKeyCmd(m.addr, ki.Key))
+}
@@ -0,0 +1,76 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+func modelWithDeepKeys(t *testing.T) *app.Model {
+	t.Helper()
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(app.ConnectedMsg{Version: "1.6.22"})
+	m = newModel.(*app.Model)
+	newModel, _ = m.Update(app.KeysLoadedMsg{Keys: []models.KeyInfo{
+		{Key: "users:123:profile", Size: 10},
+		{Key: "users:123:sessions:abc", Size: 20},
+		{Key: "other:xyz", Size: 30},
+	}})
+	return newModel.(*app.Model)
+}
+
+func TestModel_GotoKey_SelectsExistingDeepKeyExpandingAncestors(t *testing.T) {
+	m := modelWithDeepKeys(t)
+
+	newModel, cmd := m.Update(dialog.InputResultMsg{
+		Value:   "users:123:sessions:abc",
+		Context: app.GotoKeyContext{},
+	})
+	updated := newModel.(*app.Model)
+
+	selected := updated.KeyList().SelectedKey()
+	if selected == nil || selected.Key != "users:123:sessions:abc" {
+		t.Fatalf("expected cursor on 'users:123:sessions:abc', got %+v", selected)
+	}
+	if updated.Error() != "" {
+		t.Errorf("expected no error after a successful goto, got %q", updated.Error())
+	}
+	if cmd == nil {
+		t.Error("expected goto to load the selected key's value")
+	}
+}
+
+func TestModel_GotoKey_NotFoundReportsError(t *testing.T) {
+	m := modelWithDeepKeys(t)
+
+	newModel, _ := m.Update(dialog.InputResultMsg{
+		Value:   "users:123:does-not-exist",
+		Context: app.GotoKeyContext{},
+	})
+	updated := newModel.(*app.Model)
+
+	if !strings.Contains(updated.Error(), "not found") {
+		t.Errorf("expected a not-found error, got %q", updated.Error())
+	}
+}
+
+func TestModel_GotoKey_CommandPaletteOpensPrompt(t *testing.T) {
+	m := modelWithDeepKeys(t)
+
+	newModel, _ := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Go to key"}})
+	updated := newModel.(*app.Model)
+
+	if updated.InputDialog() == nil {
+		t.Fatal("expected the 'Go to key' command to open an input dialog")
+	}
+	if updated.Focus() != app.FocusDialog {
+		t.Errorf("expected focus to move to the dialog, got %v", updated.Focus())
+	}
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// maxHistogramBarWidth bounds the longest bar in FormatSizeHistogram so a
+// single oversized bucket doesn't force the whole chart wider than a
+// typical terminal.
+const maxHistogramBarWidth = 40
+
+// FormatSizeHistogram renders a value-size histogram as a simple ASCII bar
+// chart for display via the "Show size histogram" command, one line per
+// bucket, widest bucket scaled to maxHistogramBarWidth.
+func FormatSizeHistogram(buckets []models.Bucket) string {
+	total := 0
+	maxCount := 0
+	for _, b := range buckets {
+		total += b.Count
+		if b.Count > maxCount {
+			maxCount = b.Count
+		}
+	}
+	if total == 0 {
+		return "No keys loaded"
+	}
+
+	labelWidth := 0
+	for _, b := range buckets {
+		if len(b.Label) > labelWidth {
+			labelWidth = len(b.Label)
+		}
+	}
+
+	lines := make([]string, len(buckets))
+	for i, b := range buckets {
+		barWidth := 0
+		if maxCount > 0 {
+			barWidth = b.Count * maxHistogramBarWidth / maxCount
+		}
+		lines[i] = fmt.Sprintf("  %-*s %s %d", labelWidth, b.Label, strings.Repeat("#", barWidth), b.Count)
+	}
+	return fmt.Sprintf("Value size histogram (%d keys):\n%s", total, strings.Join(lines, "\n"))
+}
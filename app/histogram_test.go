@@ -0,0 +1,40 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+)
+
+func TestModel_ShowSizeHistogram_DisplaysBucketCounts(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(app.ConnectedMsg{Version: "1.6.22"})
+	m = newModel.(*app.Model)
+	newModel, _ = m.Update(app.KeysLoadedMsg{Keys: []models.KeyInfo{
+		{Key: "a", Size: 10},
+		{Key: "b", Size: 2_000_000},
+	}})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Show size histogram"}})
+	updated := newModel.(*app.Model)
+
+	if !strings.Contains(updated.Error(), "2 keys") {
+		t.Errorf("expected histogram to report 2 keys, got %q", updated.Error())
+	}
+	if !strings.Contains(updated.Error(), "0B-64B") || !strings.Contains(updated.Error(), "1MB+") {
+		t.Errorf("expected histogram to include the buckets the two keys fall into, got %q", updated.Error())
+	}
+}
+
+func TestFormatSizeHistogram_NoKeys(t *testing.T) {
+	if got := app.FormatSizeHistogram(models.ComputeSizeHistogram(nil)); got != "No keys loaded" {
+		t.Errorf("expected empty-histogram message, got %q", got)
+	}
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// IdleTimeoutTickMsg fires when the configured idle timeout may have
+// elapsed. Generation ties the tick to the activity state it was armed
+// against, so a tick scheduled before the most recent keypress is dropped
+// rather than disconnecting a session that's actually still in use.
+type IdleTimeoutTickMsg struct {
+	Generation int
+}
+
+// IdleTimeoutTickCmd schedules an idle timeout check after timeout, tagged
+// with generation.
+func IdleTimeoutTickCmd(generation int, timeout time.Duration) tea.Cmd {
+	return tea.Tick(timeout, func(time.Time) tea.Msg {
+		return IdleTimeoutTickMsg{Generation: generation}
+	})
+}
+
+// resetIdleTimerCmd bumps the idle generation, invalidating any in-flight
+// idle timeout tick, and arms a fresh one. Returns nil if idle timeout is
+// disabled (config.UIConfig.IdleTimeout <= 0) or the session is currently
+// showing the idle-disconnect screen, since reconnecting re-arms it
+// explicitly.
+func (m *Model) resetIdleTimerCmd() tea.Cmd {
+	m.idleGeneration++
+	if m.cfg.UI.IdleTimeout <= 0 || m.idleDisconnected {
+		return nil
+	}
+	return IdleTimeoutTickCmd(m.idleGeneration, m.cfg.UI.IdleTimeout)
+}
+
+// handleIdleTimeoutTick disconnects the session if no activity has reset
+// the idle timer since msg's tick was scheduled.
+func (m *Model) handleIdleTimeoutTick(msg IdleTimeoutTickMsg) (tea.Model, tea.Cmd) {
+	if msg.Generation != m.idleGeneration {
+		return m, nil
+	}
+	if m.mcClient != nil {
+		m.mcClient.Close()
+		m.mcClient = nil
+	}
+	m.idleDisconnected = true
+	return m, nil
+}
+
+// IdleDisconnected reports whether the session is currently showing the
+// idle-timeout reconnect screen.
+func (m *Model) IdleDisconnected() bool {
+	return m.idleDisconnected
+}
+
+// ReconnectFromIdle dismisses the idle-disconnect screen, reconnects to the
+// server, and re-arms the idle timer, treating the reconnecting keypress
+// itself as activity.
+func (m *Model) ReconnectFromIdle() tea.Cmd {
+	m.idleDisconnected = false
+	return tea.Batch(m.reconnectCmd(), m.resetIdleTimerCmd())
+}
@@ -0,0 +1,121 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+)
+
+func modelWithIdleTimeout(t *testing.T, timeout time.Duration) *app.Model {
+	t.Helper()
+	cfg := config.DefaultConfig()
+	cfg.UI.IdleTimeout = timeout
+	return app.NewModelWithConfig("localhost:11211", cfg)
+}
+
+// idleTickFromCmd extracts an app.IdleTimeoutTickMsg from cmd's result,
+// whether it was returned directly or alongside other commands in a
+// tea.BatchMsg.
+func idleTickFromCmd(t *testing.T, cmd tea.Cmd) (app.IdleTimeoutTickMsg, bool) {
+	t.Helper()
+	if cmd == nil {
+		return app.IdleTimeoutTickMsg{}, false
+	}
+	switch msg := cmd().(type) {
+	case app.IdleTimeoutTickMsg:
+		return msg, true
+	case tea.BatchMsg:
+		for _, c := range msg {
+			if tick, ok := idleTickFromCmd(t, c); ok {
+				return tick, true
+			}
+		}
+	}
+	return app.IdleTimeoutTickMsg{}, false
+}
+
+func TestModel_IdleTimeout_Disabled_NoTickArmed(t *testing.T) {
+	m := modelWithIdleTimeout(t, 0)
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if _, ok := idleTickFromCmd(t, cmd); ok {
+		t.Error("expected no idle timeout tick to be armed when idle timeout is disabled")
+	}
+}
+
+func TestModel_IdleTimeout_KeypressArmsTimer(t *testing.T) {
+	m := modelWithIdleTimeout(t, 10*time.Millisecond)
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if _, ok := idleTickFromCmd(t, cmd); !ok {
+		t.Fatal("expected a keypress to arm the idle timeout tick")
+	}
+}
+
+func TestModel_IdleTimeout_SimulatedInactivityDisconnects(t *testing.T) {
+	m := modelWithIdleTimeout(t, 10*time.Millisecond)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	tick, ok := idleTickFromCmd(t, cmd)
+	if !ok {
+		t.Fatal("expected a keypress to arm the idle timeout tick")
+	}
+
+	if m.IdleDisconnected() {
+		t.Fatal("should not be disconnected before the tick fires")
+	}
+
+	updated, _ := m.Update(tick)
+	m = updated.(*app.Model)
+
+	if !m.IdleDisconnected() {
+		t.Error("expected simulated inactivity past the timeout to trigger a disconnect")
+	}
+	if view := m.View(); view == "" {
+		t.Error("expected a non-empty reconnect screen")
+	}
+}
+
+func TestModel_IdleTimeout_StaleTickIsDropped(t *testing.T) {
+	m := modelWithIdleTimeout(t, 10*time.Millisecond)
+
+	// Arm a first tick, then reset activity before it fires.
+	_, firstCmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	staleTick, ok := idleTickFromCmd(t, firstCmd)
+	if !ok {
+		t.Fatal("expected a keypress to arm the idle timeout tick")
+	}
+
+	// More activity bumps the generation, invalidating staleTick.
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+
+	updated, _ := m.Update(staleTick)
+	m = updated.(*app.Model)
+
+	if m.IdleDisconnected() {
+		t.Error("expected a stale tick (superseded by later activity) not to trigger a disconnect")
+	}
+}
+
+func TestModel_IdleTimeout_KeypressReconnects(t *testing.T) {
+	m := modelWithIdleTimeout(t, 10*time.Millisecond)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	tick, _ := idleTickFromCmd(t, cmd)
+	updated, _ := m.Update(tick)
+	m = updated.(*app.Model)
+	if !m.IdleDisconnected() {
+		t.Fatal("expected the model to be disconnected after the idle tick")
+	}
+
+	updated, reconnectCmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" ")})
+	m = updated.(*app.Model)
+
+	if m.IdleDisconnected() {
+		t.Error("expected any keypress to dismiss the idle-disconnect screen")
+	}
+	if reconnectCmd == nil {
+		t.Error("expected a keypress to trigger a reconnect command")
+	}
+}
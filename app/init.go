@@ -3,10 +3,13 @@ package app
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/logging"
 	"github.com/nnnkkk7/memtui/models"
 	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
 	"github.com/nnnkkk7/memtui/ui/components/help"
 	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/serverlist"
 	"github.com/nnnkkk7/memtui/ui/components/viewer"
 	"github.com/nnnkkk7/memtui/ui/styles"
 )
@@ -32,22 +35,51 @@ func NewModelWithConfig(addr string, cfg *config.Config) *Model {
 	// Create keylist with delimiter from config
 	kl := keylist.NewModel()
 	kl.SetDelimiter(cfg.UI.KeyDelimiter)
+	kl.SetSelectAllThreshold(cfg.Limits.SelectAllThreshold)
+	kl.SetColumns(parseKeyListColumns(cfg.UI.KeyListColumns))
+
+	// Create viewer with persisted display preferences from config
+	v := viewer.NewModel()
+	v.SetWrap(cfg.Viewer.Wrap)
+	v.SetLineNumbers(cfg.Viewer.LineNumbers)
+	v.SetHexWidth(cfg.Viewer.HexWidth)
+	v.SetHexRuler(cfg.Viewer.HexRuler)
+	v.SetJSONIndent(config.JSONIndentString(cfg.Viewer.JSONIndent))
+	v.SetSortKeys(cfg.Viewer.SortJSONKeys)
+	v.SetMaxDisplayBytes(cfg.Viewer.MaxDisplayBytes)
+	v.SetPageScrollMode(cfg.Viewer.PageScrollMode)
+	v.SetPageScrollLines(cfg.Viewer.PageScrollLines)
+	v.SetChecksumAlgorithm(cfg.Viewer.ChecksumAlgorithm)
 
 	return &Model{
 		addr:           addr,
+		cfg:            cfg,
 		state:          StateConnecting,
 		styles:         NewStylesFromTheme(theme),
 		keyList:        kl,
-		viewer:         viewer.NewModel(),
+		viewer:         v,
 		commandPalette: command.New(command.DefaultCommands()),
 		help:           help.NewModel(),
 		focus:          FocusKeyList,
 	}
 }
 
+// parseKeyListColumns converts cfg.UI.KeyListColumns (validated by
+// config.Config.Validate) into keylist.Columns, silently skipping any entry
+// that fails to parse rather than refusing to start.
+func parseKeyListColumns(names []string) []keylist.Column {
+	cols := make([]keylist.Column, 0, len(names))
+	for _, name := range names {
+		if col, err := keylist.ParseColumn(name); err == nil {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
 // Init initializes the model
 func (m *Model) Init() tea.Cmd {
-	return m.connectCmd()
+	return tea.Batch(m.connectCmd(), m.resetIdleTimerCmd())
 }
 
 // State returns the current state
@@ -70,6 +102,62 @@ func (m *Model) Keys() []models.KeyInfo {
 	return m.keys
 }
 
+// KeysTruncated reports whether the loaded key list was cut short by the
+// configured limits.max_keys, i.e. it is a partial view of the cache
+func (m *Model) KeysTruncated() bool {
+	return m.keysTruncated
+}
+
+// KeysPartial reports whether the loaded key list came from an inherently
+// incomplete enumeration method (the stats cachedump fallback), as opposed
+// to being cut short by a configured limit. KeysPartialReason explains why.
+func (m *Model) KeysPartial() bool {
+	return m.keysPartial
+}
+
+// KeysPartialReason explains why KeysPartial is true. Empty otherwise.
+func (m *Model) KeysPartialReason() string {
+	return m.keysPartialReason
+}
+
+// SetProductionWarning sets whether the active server is tagged
+// "production" (see config.ServerConfig.IsProduction). When true, View
+// renders a persistent warning banner and delete confirmations call out the
+// risk explicitly.
+func (m *Model) SetProductionWarning(warn bool) {
+	m.productionWarning = warn
+}
+
+// ProductionWarning reports whether the active server is tagged
+// "production" (for testing)
+func (m *Model) ProductionWarning() bool {
+	return m.productionWarning
+}
+
+// SetProtocol sets the configured wire protocol ("text", "binary", or
+// "auto"/"") for the active server, used when connecting (see
+// client.NewWithConfiguredProtocol) and for gating binary-only features.
+func (m *Model) SetProtocol(protocol string) {
+	m.protocol = protocol
+}
+
+// Protocol returns the configured wire protocol (for testing).
+func (m *Model) Protocol() string {
+	return m.protocol
+}
+
+// SetLogger enables structured debug logging of connect/enumerate/command/
+// error events to logger. Pass nil to disable logging (the default).
+func (m *Model) SetLogger(logger *logging.Logger) {
+	m.logger = logger
+}
+
+// Logger returns the active debug logger, or nil if logging is disabled
+// (for testing).
+func (m *Model) Logger() *logging.Logger {
+	return m.logger
+}
+
 // Error returns the error message
 func (m *Model) Error() string {
 	return m.err
@@ -85,6 +173,33 @@ func (m *Model) SetFocus(focus FocusMode) {
 	m.focus = focus
 }
 
+// Viewer returns the value viewer component (for testing)
+func (m *Model) Viewer() *viewer.Model {
+	return m.viewer
+}
+
+// KeyList returns the key list component (for testing)
+func (m *Model) KeyList() *keylist.Model {
+	return m.keyList
+}
+
+// Addr returns the address of the currently configured server (for testing)
+func (m *Model) Addr() string {
+	return m.addr
+}
+
+// ServerList returns the server manager component, or nil when it is not
+// open (for testing)
+func (m *Model) ServerList() *serverlist.Model {
+	return m.serverList
+}
+
+// InputDialog returns the active input dialog, or nil when none is open
+// (for testing)
+func (m *Model) InputDialog() *dialog.InputDialog {
+	return m.inputDialog
+}
+
 // updateComponentSizes updates all component sizes based on terminal dimensions
 func (m *Model) updateComponentSizes() {
 	if m.width == 0 || m.height == 0 {
@@ -106,4 +221,13 @@ func (m *Model) updateComponentSizes() {
 	if m.help != nil {
 		m.help.SetSize(m.width, m.height)
 	}
+	if m.serverList != nil {
+		m.serverList.SetSize(m.width, m.height)
+	}
+	if m.quickSwitch != nil {
+		m.quickSwitch.SetSize(m.width, m.height)
+	}
+	if m.statsView != nil {
+		m.statsView.SetSize(m.width, m.height)
+	}
 }
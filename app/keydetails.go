@@ -0,0 +1,105 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// KeyFlagsLoadedMsg carries the flags fetched on demand for the key details
+// panel. It's distinct from ValueLoadedMsg so fetching flags doesn't also
+// switch focus to the value viewer.
+type KeyFlagsLoadedMsg struct {
+	Key   string
+	Flags uint32
+}
+
+// loadKeyFlagsCmd fetches a key's CAS item solely to populate the "Flags"
+// field of the key details panel, for keys whose value hasn't been loaded
+// yet (metadump doesn't report flags).
+func (m *Model) loadKeyFlagsCmd(key string) tea.Cmd {
+	return func() tea.Msg {
+		if m.mcClient == nil {
+			return ErrorMsg{Err: "client not connected"}
+		}
+
+		casItem, err := m.mcClient.GetWithCAS(key)
+		if err != nil {
+			return ErrorMsg{Err: fmt.Sprintf("failed to load key details: %v", err)}
+		}
+		return KeyFlagsLoadedMsg{Key: key, Flags: casItem.Flags}
+	}
+}
+
+// FormatKeyDetails renders every known piece of metadata about ki, one field
+// per line. flags is nil when the flags haven't been fetched yet (metadump
+// doesn't report them), in which case that field reads "unknown". node is
+// the address of the server the key was read from. showRawLine appends the
+// raw metadump line ki was parsed from, if one was retained (see
+// client.KeyEnumerator.WithRetainRawLine); when none was retained, the field
+// reads "not retained (enable logging.debug)".
+func FormatKeyDetails(ki *models.KeyInfo, flags *uint32, node string, showRawLine bool) string {
+	if ki == nil {
+		return "No key selected"
+	}
+
+	flagsText := "unknown"
+	if flags != nil {
+		flagsText = fmt.Sprintf("%d", *flags)
+	}
+
+	ttlText := "permanent"
+	if ki.Expiration > 0 {
+		ttlText = fmt.Sprintf("%d (unix: %d)", calculateRemainingTTL(ki.Expiration), ki.Expiration)
+	}
+
+	lastAccessText := "unknown"
+	if ki.HasLastAccess {
+		lastAccessText = fmt.Sprintf("%d", ki.LastAccess)
+	}
+
+	nodeText := "unknown"
+	if node != "" {
+		nodeText = node
+	}
+
+	details := fmt.Sprintf(
+		"Key: %s\nSize: %d bytes\nCAS: %d\nFlags: %s\nTTL: %s\nSlab Class: %d\nLast Access: %s\nFetch Count: %s\nNode: %s",
+		ki.Key, ki.Size, ki.CAS, flagsText, ttlText, ki.SlabClass, lastAccessText, ki.FetchCountDisplay(), nodeText,
+	)
+
+	if showRawLine {
+		rawText := ki.RawLine
+		if rawText == "" {
+			rawText = "not retained (enable logging.debug)"
+		}
+		details += "\nRaw metadump line: " + rawText
+	}
+
+	return details
+}
+
+// currentKeyFlags returns the flags for m.currentKey if already known from a
+// previously loaded value, and false otherwise.
+func (m *Model) currentKeyFlags() (uint32, bool) {
+	if m.currentKey == nil {
+		return 0, false
+	}
+	if m.currentCASItem != nil && m.currentCASItem.Key == m.currentKey.Key {
+		return m.currentCASItem.Flags, true
+	}
+	if flags, ok := m.keyFlagsCache[m.currentKey.Key]; ok {
+		return flags, true
+	}
+	return 0, false
+}
+
+// recordKeyFlags caches flags fetched on demand for the key details panel,
+// keyed by key name, so reopening the panel doesn't re-fetch them.
+func (m *Model) recordKeyFlags(key string, flags uint32) {
+	if m.keyFlagsCache == nil {
+		m.keyFlagsCache = make(map[string]uint32)
+	}
+	m.keyFlagsCache[key] = flags
+}
@@ -0,0 +1,140 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestFormatKeyDetails_RendersAllPopulatedFields(t *testing.T) {
+	flags := uint32(42)
+	ki := &models.KeyInfo{
+		Key:           "user:1",
+		Size:          128,
+		CAS:           99,
+		SlabClass:     3,
+		Expiration:    0,
+		LastAccess:    1700000000,
+		HasLastAccess: true,
+	}
+
+	details := app.FormatKeyDetails(ki, &flags, "localhost:11211", false)
+
+	for _, want := range []string{"user:1", "128 bytes", "99", "42", "permanent", "3", "1700000000", "localhost:11211"} {
+		if !strings.Contains(details, want) {
+			t.Errorf("expected details to contain %q, got:\n%s", want, details)
+		}
+	}
+	if strings.Contains(details, "unknown") {
+		t.Errorf("expected no 'unknown' fields when everything is populated, got:\n%s", details)
+	}
+}
+
+func TestFormatKeyDetails_ShowsUnknownForMissingFields(t *testing.T) {
+	ki := &models.KeyInfo{
+		Key:           "user:2",
+		Size:          10,
+		HasLastAccess: false,
+	}
+
+	details := app.FormatKeyDetails(ki, nil, "", false)
+
+	if !strings.Contains(details, "Flags: unknown") {
+		t.Errorf("expected unknown flags, got:\n%s", details)
+	}
+	if !strings.Contains(details, "Last Access: unknown") {
+		t.Errorf("expected unknown last access, got:\n%s", details)
+	}
+	if !strings.Contains(details, "Node: unknown") {
+		t.Errorf("expected unknown node, got:\n%s", details)
+	}
+}
+
+func TestFormatKeyDetails_NilKey(t *testing.T) {
+	if got := app.FormatKeyDetails(nil, nil, "", false); got == "" {
+		t.Error("expected a non-empty message for a nil key")
+	}
+}
+
+func TestFormatKeyDetails_RawLine(t *testing.T) {
+	ki := &models.KeyInfo{Key: "user:1", Size: 128, RawLine: "key=user%3A1 exp=0 la=0 cas=1 fetch=no cls=1 size=128"}
+
+	if details := app.FormatKeyDetails(ki, nil, "", false); strings.Contains(details, "Raw metadump line") {
+		t.Errorf("expected no raw line field when showRawLine is false, got:\n%s", details)
+	}
+
+	details := app.FormatKeyDetails(ki, nil, "", true)
+	if !strings.Contains(details, ki.RawLine) {
+		t.Errorf("expected the raw metadump line to appear when retained, got:\n%s", details)
+	}
+
+	kiNoRaw := &models.KeyInfo{Key: "user:2", Size: 10}
+	details = app.FormatKeyDetails(kiNoRaw, nil, "", true)
+	if !strings.Contains(details, "not retained") {
+		t.Errorf("expected a placeholder when the raw line wasn't retained, got:\n%s", details)
+	}
+}
+
+func TestModel_ToggleRawMetadumpLine(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	keys := []models.KeyInfo{{Key: "user:1", Size: 50, RawLine: "key=user%3A1 exp=0 la=0 cas=1 fetch=no cls=1 size=50"}}
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: keys})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(keylist.KeySelectedMsg{Key: keys[0]})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	m = newModel.(*app.Model)
+
+	if strings.Contains(m.View(), "Raw metadump line") {
+		t.Error("expected the raw line to be hidden until toggled on")
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	m = newModel.(*app.Model)
+
+	if !strings.Contains(m.View(), keys[0].RawLine) {
+		t.Error("expected the raw line to appear after toggling it on")
+	}
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	m = newModel.(*app.Model)
+	if strings.Contains(m.View(), "Raw metadump line") {
+		t.Error("expected toggling again to hide the raw line")
+	}
+}
+
+func TestModel_ToggleKeyDetailsPanel(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	keys := []models.KeyInfo{{Key: "user:1", Size: 50}}
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: keys})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(keylist.KeySelectedMsg{Key: keys[0]})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusKeyDetails {
+		t.Fatalf("expected FocusKeyDetails, got %v", updated.Focus())
+	}
+	if !strings.Contains(updated.View(), "user:1") {
+		t.Error("expected the details panel to show the selected key")
+	}
+
+	newModel, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	reverted := newModel.(*app.Model)
+	if reverted.Focus() != app.FocusKeyList {
+		t.Errorf("expected pressing 'i' again to return to FocusKeyList, got %v", reverted.Focus())
+	}
+}
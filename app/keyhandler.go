@@ -1,10 +1,17 @@
 package app
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
 	"github.com/nnnkkk7/memtui/ui/components/command"
-	"github.com/nnnkkk7/memtui/ui/components/dialog"
 	"github.com/nnnkkk7/memtui/ui/components/editor"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/quickswitch"
+	"github.com/nnnkkk7/memtui/ui/components/viewer"
+	viewerPkg "github.com/nnnkkk7/memtui/viewer"
 )
 
 func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
@@ -30,12 +37,29 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 
 	if m.help.Visible() {
-		switch msg.String() {
-		case "q", "esc", "?":
-			m.help.Hide()
+		_, cmd := m.help.Update(msg)
+		if !m.help.Visible() {
 			m.focus = FocusKeyList
 		}
-		return m, nil
+		return m, cmd
+	}
+
+	if m.statsView != nil {
+		var cmd tea.Cmd
+		m.statsView, cmd = m.statsView.Update(msg)
+		return m, cmd
+	}
+
+	if m.serverList != nil {
+		var cmd tea.Cmd
+		m.serverList, cmd = m.serverList.Update(msg)
+		return m, cmd
+	}
+
+	if m.quickSwitch != nil {
+		var cmd tea.Cmd
+		m.quickSwitch, cmd = m.quickSwitch.Update(msg)
+		return m, cmd
 	}
 
 	// Handle filter mode
@@ -49,11 +73,33 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Quit
 
 	case "esc":
+		// Stop watching/tailing before anything else Esc might do
+		if m.watching {
+			m.StopWatch()
+			return m, nil
+		}
+		if m.tailing {
+			m.StopTail()
+			return m, nil
+		}
 		// Return to key list from viewer
 		if m.focus == FocusViewer {
 			m.focus = FocusKeyList
 			return m, nil
 		}
+		// Return to wherever the key details panel was opened from
+		if m.focus == FocusKeyDetails {
+			m.focus = m.detailsReturnTo
+			return m, nil
+		}
+
+	case "ctrl+l":
+		// Manual redraw: some terminals/multiplexers leave the screen in a
+		// corrupted state after suspend/resume or a resize the SIGWINCH
+		// handler missed. Force a full repaint and re-query the window size
+		// so child components recompute against the current dimensions.
+		m.updateComponentSizes()
+		return m, tea.Batch(tea.ClearScreen, tea.WindowSize())
 
 	case "ctrl+p":
 		m.commandPalette.Show()
@@ -61,6 +107,12 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.focus = FocusCommandPalette
 		return m, nil
 
+	case "ctrl+e":
+		m.quickSwitch = quickswitch.New(m.recentKeys)
+		m.quickSwitch.SetSize(m.width, m.height)
+		m.focus = FocusQuickSwitch
+		return m, m.quickSwitch.Init()
+
 	case "?":
 		m.help.Show()
 		m.help.SetSize(m.width, m.height)
@@ -73,6 +125,71 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, m.loadKeysCmd()
 		}
 
+	case "ctrl+r":
+		// Refresh just the currently viewed value, without re-enumerating all keys
+		if m.focus == FocusViewer && m.currentKey != nil {
+			return m, ReloadValueCmd(m.mcClient, m.currentKey.Key)
+		}
+
+	case "o":
+		if m.state == StateConnected || m.state == StateReady {
+			return m, m.reconnectCmd()
+		}
+
+	case "F":
+		// Jump to the key a JSON string token under the cursor refers to,
+		// per the configured key-reference template (e.g. "session:{}")
+		if m.focus == FocusViewer {
+			if token, ok := m.viewer.CurrentJSONStringToken(); ok {
+				if ref := viewer.ResolveKeyRef(token, m.cfg.Viewer.KeyRefTemplate); ref != "" {
+					if m.keyList.SelectKey(ref) {
+						if ki := m.keyList.SelectedKey(); ki != nil {
+							m.currentKey = ki
+							m.focus = FocusKeyList
+							return m, m.loadValueCmd(ki.Key)
+						}
+					}
+				}
+			}
+		}
+
+	case "N":
+		// Re-detect the current value, rotating to the next plausible
+		// interpretation when auto-detection guessed wrong (e.g. borderline
+		// base64 vs binary).
+		if m.focus == FocusViewer {
+			m.viewer.CycleDetectedType()
+			return m, nil
+		}
+
+	case "v":
+		if m.focus == FocusKeyList {
+			m.inputDialog = CreateValueSearchDialog()
+			m.inputDialog.SetSize(m.width, m.height)
+			m.focus = FocusDialog
+			return m, m.inputDialog.Init()
+		}
+
+	case "l":
+		if m.focus == FocusKeyDetails {
+			m.showRawMetadumpLine = !m.showRawMetadumpLine
+			return m, nil
+		}
+
+	case "i":
+		if m.focus == FocusKeyDetails {
+			m.focus = m.detailsReturnTo
+			return m, nil
+		}
+		if (m.focus == FocusKeyList || m.focus == FocusViewer) && m.currentKey != nil {
+			m.detailsReturnTo = m.focus
+			m.focus = FocusKeyDetails
+			if _, ok := m.currentKeyFlags(); !ok {
+				return m, m.loadKeyFlagsCmd(m.currentKey.Key)
+			}
+			return m, nil
+		}
+
 	case "tab":
 		if m.focus == FocusKeyList {
 			m.focus = FocusViewer
@@ -86,31 +203,80 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.filterInput = ""
 		return m, nil
 
+	case ":":
+		if m.focus == FocusKeyList {
+			m.inputDialog = CreateGotoKeyDialog()
+			m.inputDialog.SetSize(m.width, m.height)
+			m.focus = FocusDialog
+			return m, m.inputDialog.Init()
+		}
+
 	case "d":
 		if m.focus == FocusKeyList || m.focus == FocusViewer {
 			// Check if we have multi-selected keys
 			if m.keyList.HasSelection() {
 				selectedKeys := m.keyList.SelectedKeys()
-				m.inputDialog = CreateBatchDeleteDialogWithKeys(selectedKeys)
+				var protectedKeys []string
+				for _, key := range selectedKeys {
+					if m.isProtectedKey(key) {
+						protectedKeys = append(protectedKeys, key)
+					}
+				}
+				if len(protectedKeys) > 0 {
+					m.inputDialog = CreateProtectedBatchDeleteDialog(selectedKeys, protectedKeys)
+				} else {
+					m.inputDialog = CreateBatchDeleteDialogWithKeys(selectedKeys)
+				}
 				m.inputDialog.SetSize(m.width, m.height)
 				m.focus = FocusDialog
 				return m, m.inputDialog.Init()
 			}
 			// Single key delete
 			if m.currentKey != nil {
-				m.confirmDialog = CreateDeleteConfirmDialog(m.currentKey.Key)
+				if m.isProtectedKey(m.currentKey.Key) {
+					m.inputDialog = CreateProtectedDeleteDialog(m.currentKey.Key)
+					m.inputDialog.SetSize(m.width, m.height)
+					m.focus = FocusDialog
+					return m, m.inputDialog.Init()
+				}
+				m.confirmDialog = CreateDeleteConfirmDialog(m.currentKey.Key, m.productionWarning)
 				m.confirmDialog.SetSize(m.width, m.height)
 				m.focus = FocusDialog
 				return m, nil
 			}
 		}
 
+	case "Y":
+		if m.focus == FocusViewer && m.currentKey != nil && m.currentValue != nil {
+			flags := uint32(0)
+			if f, ok := m.currentKeyFlags(); ok {
+				flags = f
+			}
+			m.inputDialog = CreateSnapshotKeyDialog(m.currentKey.Key, m.currentValue, flags, time.Now())
+			m.inputDialog.SetSize(m.width, m.height)
+			m.focus = FocusDialog
+			return m, m.inputDialog.Init()
+		}
+
 	case "e":
 		if m.currentKey != nil && m.currentValue != nil {
 			m.editor = editor.New(m.currentKey.Key, m.currentValue)
-			// Set CAS for optimistic locking if available
+			m.editor.SetJSONIndent(config.JSONIndentString(m.cfg.Viewer.JSONIndent))
+			m.editor.SetSortKeys(m.cfg.Viewer.SortJSONKeys)
+			// Set CAS for optimistic locking and preserve the loaded flags
 			if m.currentCASItem != nil {
 				m.editor.SetCAS(m.currentCASItem.CAS)
+				m.editor.SetFlags(m.currentCASItem.Flags)
+			}
+			// Restore any edits left unsaved from a previous editing session
+			if buf, ok := m.dirtyBuffers[m.currentKey.Key]; ok {
+				m.editor.SetContent(buf.Value)
+				m.editor.SetFlags(buf.Flags)
+			} else if m.cfg.Viewer.AutoformatOnEdit && viewerPkg.DetectType(m.currentValue) == viewerPkg.DataTypeJSON {
+				m.editor.SetMode(editor.ModeJSON)
+				if err := m.editor.FormatJSON(); err == nil {
+					m.editor.ResetDirtyBaseline()
+				}
 			}
 			m.editor.SetSize(m.width, m.height)
 			m.focus = FocusEditor
@@ -118,12 +284,45 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 
 	case "n":
-		m.inputDialog = dialog.NewInput("New Key").
-			WithPlaceholder("Enter key name...").
-			WithValidator(ValidateKeyName)
+		m.inputDialog = CreateNewKeyDialog()
 		m.inputDialog.SetSize(m.width, m.height)
 		m.focus = FocusDialog
 		return m, m.inputDialog.Init()
+
+	case "t":
+		if m.currentKey != nil {
+			m.inputDialog = CreateTouchDialog(m.currentKey.Key)
+			m.inputDialog.SetSize(m.width, m.height)
+			m.focus = FocusDialog
+			return m, m.inputDialog.Init()
+		}
+
+	case "p":
+		if m.currentKey != nil {
+			return m, togglePinCmd(m.addr, m.currentKey.Key)
+		}
+
+	case "W":
+		if !m.watching && m.currentKey != nil {
+			return m, m.StartWatch(m.currentKey.Key)
+		}
+
+	case "K":
+		if !m.tailing {
+			return m, m.StartTail()
+		}
+		m.StopTail()
+		return m, nil
+
+	case "S":
+		m.inputDialog = CreateAddServerNameDialog()
+		m.inputDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, m.inputDialog.Init()
+
+	case "M":
+		m.openServerManager()
+		return m, nil
 	}
 
 	// Focus-specific handling
@@ -136,6 +335,9 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case FocusViewer:
 		var cmd tea.Cmd
 		m.viewer, cmd = m.viewer.Update(msg)
+		if m.currentKey != nil {
+			m.recordViewMode(m.currentKey.Key, m.viewer.ViewMode())
+		}
 		return m, cmd
 	}
 
@@ -173,23 +375,40 @@ func (m *Model) handleFilterInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m *Model) handleCommandExecute(cmd command.Command) (tea.Model, tea.Cmd) {
 	m.commandPalette.Hide()
 	m.focus = FocusKeyList
+	m.logger.Command(m.addr, cmd.Name, 0, nil)
 
 	switch cmd.Name {
 	case "Refresh keys":
 		m.state = StateLoading
 		return m, m.loadKeysCmd()
 
+	case "Reconnect":
+		return m, m.reconnectCmd()
+
 	case "Delete key":
 		if m.currentKey != nil {
-			m.confirmDialog = CreateDeleteConfirmDialog(m.currentKey.Key)
+			if m.isProtectedKey(m.currentKey.Key) {
+				m.inputDialog = CreateProtectedDeleteDialog(m.currentKey.Key)
+				m.inputDialog.SetSize(m.width, m.height)
+				m.focus = FocusDialog
+				return m, m.inputDialog.Init()
+			}
+			m.confirmDialog = CreateDeleteConfirmDialog(m.currentKey.Key, m.productionWarning)
 			m.confirmDialog.SetSize(m.width, m.height)
 			m.focus = FocusDialog
 		}
 
+	case "Extend TTL (selected)":
+		if m.keyList.HasSelection() {
+			selectedKeys := m.keyList.SelectedKeys()
+			m.inputDialog = CreateBatchExtendTTLDialog(selectedKeys)
+			m.inputDialog.SetSize(m.width, m.height)
+			m.focus = FocusDialog
+			return m, m.inputDialog.Init()
+		}
+
 	case "New key":
-		m.inputDialog = dialog.NewInput("New Key").
-			WithPlaceholder("Enter key name...").
-			WithValidator(ValidateKeyName)
+		m.inputDialog = CreateNewKeyDialog()
 		m.inputDialog.SetSize(m.width, m.height)
 		m.focus = FocusDialog
 		return m, m.inputDialog.Init()
@@ -197,9 +416,22 @@ func (m *Model) handleCommandExecute(cmd command.Command) (tea.Model, tea.Cmd) {
 	case "Edit value":
 		if m.currentKey != nil && m.currentValue != nil {
 			m.editor = editor.New(m.currentKey.Key, m.currentValue)
-			// Set CAS for optimistic locking if available
+			m.editor.SetJSONIndent(config.JSONIndentString(m.cfg.Viewer.JSONIndent))
+			m.editor.SetSortKeys(m.cfg.Viewer.SortJSONKeys)
+			// Set CAS for optimistic locking and preserve the loaded flags
 			if m.currentCASItem != nil {
 				m.editor.SetCAS(m.currentCASItem.CAS)
+				m.editor.SetFlags(m.currentCASItem.Flags)
+			}
+			// Restore any edits left unsaved from a previous editing session
+			if buf, ok := m.dirtyBuffers[m.currentKey.Key]; ok {
+				m.editor.SetContent(buf.Value)
+				m.editor.SetFlags(buf.Flags)
+			} else if m.cfg.Viewer.AutoformatOnEdit && viewerPkg.DetectType(m.currentValue) == viewerPkg.DataTypeJSON {
+				m.editor.SetMode(editor.ModeJSON)
+				if err := m.editor.FormatJSON(); err == nil {
+					m.editor.ResetDirtyBaseline()
+				}
 			}
 			m.editor.SetSize(m.width, m.height)
 			m.focus = FocusEditor
@@ -214,22 +446,127 @@ func (m *Model) handleCommandExecute(cmd command.Command) (tea.Model, tea.Cmd) {
 	case "Quit":
 		return m, tea.Quit
 
+	case "Go to key":
+		m.inputDialog = CreateGotoKeyDialog()
+		m.inputDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, m.inputDialog.Init()
+
 	case "Filter keys":
 		m.filtering = true
 		m.filterInput = ""
 
+	case "Reveal in tree":
+		if key := m.keyList.SelectedKey(); key != nil {
+			m.filtering = false
+			m.filterInput = ""
+			m.keyList.RevealInTree(key.Key)
+		}
+
 	case "Show stats":
-		// TODO: Implement stats view - for now show message
-		m.err = "Stats view not yet implemented"
+		return m.openStats()
+
+	case "Dump stats":
+		m.inputDialog = CreateDumpStatsPathDialog()
+		m.inputDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, m.inputDialog.Init()
 
 	case "Toggle theme":
 		// TODO: Implement theme toggle - for now show message
 		m.err = "Theme toggle not yet implemented"
 
+	case "Toggle size column":
+		m.keyList.ToggleColumn(keylist.ColumnSize)
+
+	case "Toggle TTL column":
+		m.keyList.ToggleColumn(keylist.ColumnTTL)
+
+	case "Toggle slab column":
+		m.keyList.ToggleColumn(keylist.ColumnSlab)
+
+	case "Toggle node column":
+		m.keyList.ToggleColumn(keylist.ColumnNode)
+
+	case "Toggle last-access column":
+		m.keyList.ToggleColumn(keylist.ColumnLastAccess)
+
 	case "Copy value":
 		if m.currentValue != nil {
 			return m, m.copyToClipboardCmd(m.currentValue)
 		}
+
+	case "Copy formatted value":
+		if m.currentValue != nil {
+			return m, m.copyToClipboardCmd([]byte(m.viewer.Content()))
+		}
+
+	case "Copy connection string":
+		return m, m.copyToClipboardCmd([]byte(m.connectionString()))
+
+	case "Copy key path template":
+		return m, m.copyKeyTemplateCmd()
+
+	case "Snapshot value to new key":
+		if m.currentKey != nil && m.currentValue != nil {
+			flags := uint32(0)
+			if f, ok := m.currentKeyFlags(); ok {
+				flags = f
+			}
+			m.inputDialog = CreateSnapshotKeyDialog(m.currentKey.Key, m.currentValue, flags, time.Now())
+			m.inputDialog.SetSize(m.width, m.height)
+			m.focus = FocusDialog
+			return m, m.inputDialog.Init()
+		}
+
+	case "Cycle detected type":
+		if m.currentValue != nil {
+			m.viewer.CycleDetectedType()
+			m.focus = FocusViewer
+		}
+
+	case "Diff selected keys":
+		selected := m.keyList.SelectedKeys()
+		if len(selected) != 2 {
+			m.err = diffSelectionHint
+			return m, nil
+		}
+		return m, m.diffSelectedKeysCmd(selected[0], selected[1])
+
+	case "Add server":
+		m.inputDialog = CreateAddServerNameDialog()
+		m.inputDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, m.inputDialog.Init()
+
+	case "Manage servers":
+		m.openServerManager()
+
+	case "Show size histogram":
+		m.err = FormatSizeHistogram(models.ComputeSizeHistogram(m.keys))
+
+	case "Show activity":
+		m.err = FormatAuditLog(m.auditLog)
+
+	case "Recently viewed keys":
+		m.quickSwitch = quickswitch.New(m.recentKeys)
+		m.quickSwitch.SetSize(m.width, m.height)
+		m.focus = FocusQuickSwitch
+		return m, m.quickSwitch.Init()
+
+	case "Show unsaved buffers":
+		m.err = FormatDirtyBuffers(m.dirtyBuffers)
+
+	case "Save all buffers":
+		if len(m.dirtyBuffers) > 0 {
+			buffers := m.dirtyBuffers
+			m.dirtyBuffers = nil
+			return m, m.saveAllBuffersCmd(buffers)
+		}
+		m.err = FormatDirtyBuffers(m.dirtyBuffers)
+
+	case "Clear caches":
+		m.clearValueCache()
 	}
 
 	return m, nil
@@ -0,0 +1,85 @@
+package app_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+)
+
+func TestModel_CtrlL_ForcesRedrawAndRecomputesSizes(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	updated := newModel.(*app.Model)
+
+	if cmd == nil {
+		t.Fatal("expected ctrl+l to return a redraw command")
+	}
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("expected a batch of 2 commands (clear screen + window size query), got %#v", cmd())
+	}
+
+	// Dimensions should be recomputed from (and remain consistent with) the
+	// current window size, not reset or left stale.
+	if updated.Width() != 100 || updated.Height() != 30 {
+		t.Errorf("expected dimensions to remain 100x30 after redraw, got %dx%d", updated.Width(), updated.Height())
+	}
+	if view := updated.View(); view == "" {
+		t.Error("expected a non-empty view after redraw")
+	}
+}
+
+func connectedModel(t *testing.T) *app.Model {
+	t.Helper()
+	m := app.NewModel("localhost:11211")
+	newModel, _ := m.Update(app.ConnectedMsg{Version: "1.6.22"})
+	m = newModel.(*app.Model)
+	newModel, _ = m.Update(app.KeysLoadedMsg{Keys: []models.KeyInfo{{Key: "user:1", Size: 100}}})
+	return newModel.(*app.Model)
+}
+
+func TestModel_Reconnect_KeyTriggersDisconnectAndConnect(t *testing.T) {
+	m := connectedModel(t)
+
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	updated := newModel.(*app.Model)
+
+	if updated.State() != app.StateConnecting {
+		t.Errorf("expected StateConnecting after reconnect, got %v", updated.State())
+	}
+	if cmd == nil {
+		t.Fatal("expected reconnect to return a connect command")
+	}
+	if updated.Addr() != "localhost:11211" {
+		t.Errorf("expected reconnect to keep the same address, got %q", updated.Addr())
+	}
+
+	// The returned command re-establishes the connection against the same
+	// address; on success it reloads the key list (see ConnectedMsg handling).
+	final, _ := updated.Update(app.ConnectedMsg{Version: "1.6.22"})
+	finalModel := final.(*app.Model)
+	if finalModel.State() != app.StateLoading {
+		t.Fatalf("expected a successful reconnect to transition through StateLoading, got %v", finalModel.State())
+	}
+}
+
+func TestModel_Reconnect_CommandPaletteTriggersReconnect(t *testing.T) {
+	m := connectedModel(t)
+
+	newModel, cmd := m.Update(command.CommandExecuteMsg{
+		Command: command.Command{Name: "Reconnect"},
+	})
+	updated := newModel.(*app.Model)
+
+	if updated.State() != app.StateConnecting {
+		t.Errorf("expected StateConnecting after reconnect command, got %v", updated.State())
+	}
+	if cmd == nil {
+		t.Fatal("expected reconnect command to return a connect command")
+	}
+}
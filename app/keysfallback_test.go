@@ -0,0 +1,28 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+)
+
+func TestModel_MetadumpUnsupportedMsg_FallsBackInsteadOfStateError(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+
+	newModel, cmd := m.Update(app.MetadumpUnsupportedMsg{})
+	m = newModel.(*app.Model)
+
+	if cmd != nil {
+		t.Error("expected no follow-up command")
+	}
+	if m.State() == app.StateError {
+		t.Fatal("expected the metadump-unsupported fallback to avoid StateError")
+	}
+	if m.State() != app.StateReady {
+		t.Fatalf("expected StateReady (limited mode), got %v", m.State())
+	}
+	if !strings.Contains(m.Error(), "metadump") {
+		t.Errorf("expected an explanatory message mentioning metadump, got %q", m.Error())
+	}
+}
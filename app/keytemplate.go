@@ -0,0 +1,17 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// copyKeyTemplateCmd copies the selected key's path template (see
+// models.KeyTemplate) to clipboard, with numeric and UUID-looking segments
+// replaced by the configured placeholders.
+func (m *Model) copyKeyTemplateCmd() tea.Cmd {
+	if m.currentKey == nil {
+		return nil
+	}
+	template := models.KeyTemplate(m.currentKey.Key, m.cfg.UI.KeyDelimiter, m.cfg.UI.KeyTemplate.NumericPlaceholder, m.cfg.UI.KeyTemplate.UUIDPlaceholder)
+	return m.copyToClipboardCmd([]byte(template))
+}
@@ -0,0 +1,37 @@
+package app_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestModel_CopyKeyTemplate_ReturnsClipboardCommandForSelectedKey(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "user:1001:profile"}})
+	m = newModel.(*app.Model)
+
+	// copyToClipboardCmd writes to the system clipboard, which isn't
+	// available in CI; just confirm a command was returned, mirroring
+	// TestModel_CopyFormattedValue_MatchesHexFormatterOutput in copy_test.go.
+	_, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Copy key path template"}})
+	if cmd == nil {
+		t.Fatal("expected a clipboard command to be returned")
+	}
+}
+
+func TestModel_CopyKeyTemplate_NoOpWithoutSelectedKey(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	_, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Copy key path template"}})
+	if cmd != nil {
+		t.Error("expected no command when no key is selected")
+	}
+}
@@ -0,0 +1,104 @@
+package app_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/logging"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+)
+
+func readLogEntries(t *testing.T, path string) []logging.Entry {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open log file: %v", err)
+	}
+	defer f.Close()
+
+	var entries []logging.Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry logging.Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("failed to parse log line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestModel_SetLogger(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	if m.Logger() != nil {
+		t.Fatal("expected no logger by default")
+	}
+
+	tmpDir := t.TempDir()
+	logger, err := logging.New(filepath.Join(tmpDir, "memtui.log"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	m.SetLogger(logger)
+	if m.Logger() != logger {
+		t.Error("expected Logger() to return the logger set via SetLogger")
+	}
+}
+
+func TestModel_UpdateError_LogsEntry(t *testing.T) {
+	tmpDir := t.TempDir()
+	logPath := filepath.Join(tmpDir, "memtui.log")
+	logger, err := logging.New(logPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	m := app.NewModel("localhost:11211")
+	m.SetLogger(logger)
+
+	m.Update(app.ErrorMsg{Err: "connection failed"})
+
+	entries := readLogEntries(t, logPath)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(entries))
+	}
+	if entries[0].Event != "error" {
+		t.Errorf("expected event 'error', got '%s'", entries[0].Event)
+	}
+	if entries[0].Err != "connection failed" {
+		t.Errorf("expected error 'connection failed', got '%s'", entries[0].Err)
+	}
+}
+
+func TestModel_CommandExecute_LogsEntry(t *testing.T) {
+	tmpDir := t.TempDir()
+	logPath := filepath.Join(tmpDir, "memtui.log")
+	logger, err := logging.New(logPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	m := app.NewModel("localhost:11211")
+	m.SetLogger(logger)
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Show help"}})
+
+	entries := readLogEntries(t, logPath)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(entries))
+	}
+	if entries[0].Event != "command" || entries[0].Detail != "Show help" {
+		t.Errorf("unexpected command entry: %+v", entries[0])
+	}
+}
@@ -11,6 +11,21 @@ type ConnectedMsg struct {
 // KeysLoadedMsg is sent when keys are loaded
 type KeysLoadedMsg struct {
 	Keys []models.KeyInfo
+	// Truncated is true when the configured key limit cut enumeration short
+	// of the full (optionally prefix-filtered) key set
+	Truncated bool
+	// FailedNodes lists the addresses of any nodes (in multi-node mode) that
+	// couldn't be enumerated. Non-empty FailedNodes doesn't mean Keys is
+	// empty: it's a partial result from the nodes that did respond.
+	FailedNodes []string
+	// Partial is true when Keys came from an inherently incomplete
+	// enumeration method (currently: the stats cachedump fallback), as
+	// opposed to Truncated, which reflects the configured key limit cutting
+	// off an otherwise-complete method.
+	Partial bool
+	// PartialReason explains why Partial is true, for display. Empty when
+	// Partial is false.
+	PartialReason string
 }
 
 // ErrorMsg is sent when an error occurs
@@ -18,6 +33,13 @@ type ErrorMsg struct {
 	Err string
 }
 
+// MetadumpUnsupportedMsg is sent when key enumeration fails because the
+// server rejected "lru_crawler metadump" as an unrecognized command (see
+// client.IsMetadumpUnsupportedErr), rather than a connection failure. This
+// triggers a limited-mode fallback instead of StateError, since the
+// connection itself is fine and known keys can still be worked with.
+type MetadumpUnsupportedMsg struct{}
+
 // KeySelectedMsg is sent when a key is selected
 type KeySelectedMsg struct {
 	Key models.KeyInfo
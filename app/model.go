@@ -3,12 +3,17 @@ package app
 import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/logging"
 	"github.com/nnnkkk7/memtui/models"
 	"github.com/nnnkkk7/memtui/ui/components/command"
 	"github.com/nnnkkk7/memtui/ui/components/dialog"
 	"github.com/nnnkkk7/memtui/ui/components/editor"
 	"github.com/nnnkkk7/memtui/ui/components/help"
 	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/quickswitch"
+	"github.com/nnnkkk7/memtui/ui/components/serverlist"
+	"github.com/nnnkkk7/memtui/ui/components/stats"
 	"github.com/nnnkkk7/memtui/ui/components/viewer"
 	"github.com/nnnkkk7/memtui/ui/styles"
 )
@@ -67,18 +72,47 @@ const (
 	FocusHelp
 	// FocusFilter indicates the filter input has focus
 	FocusFilter
+	// FocusServerManager indicates the server manager screen has focus
+	FocusServerManager
+	// FocusKeyDetails indicates the key metadata details panel has focus
+	FocusKeyDetails
+	// FocusQuickSwitch indicates the recently-viewed-keys quick switcher has focus
+	FocusQuickSwitch
+	// FocusStats indicates the server stats view has focus
+	FocusStats
 )
 
 // Model is the main Bubble Tea model
 type Model struct {
-	addr    string
-	state   State
-	width   int
-	height  int
-	keys    []models.KeyInfo
-	err     string
-	version string
-	focus   FocusMode
+	addr              string
+	cfg               *config.Config
+	state             State
+	width             int
+	height            int
+	keys              []models.KeyInfo
+	keysTruncated     bool
+	keysPartial       bool
+	keysPartialReason string
+	productionWarning bool
+	protocol          string
+	err               string
+
+	// Watch mode (see watch.go)
+	watching   bool
+	watchKey   string
+	watchValue []byte
+	watchCAS   uint64
+	watchLog   []WatchChangeEntry
+	version    string
+	focus      FocusMode
+
+	// Tail mode (see tail.go)
+	tailing       bool
+	tailKnownKeys []string
+	tailLog       []TailEntry
+
+	// Audit trail of mutating operations this session (see audit.go)
+	auditLog []AuditEntry
 
 	// Memcached client (unified interface for all operations including CAS)
 	mcClient client.MemcachedClient
@@ -91,6 +125,9 @@ type Model struct {
 	inputDialog    *dialog.InputDialog
 	editor         *editor.Editor
 	help           *help.Model
+	serverList     *serverlist.Model
+	quickSwitch    *quickswitch.Model
+	statsView      *stats.StatsView
 
 	// Filter mode
 	filterInput string
@@ -104,21 +141,88 @@ type Model struct {
 	// Server capabilities
 	supportsMetadump bool
 
+	// Multi-node mode (see commands.go:loadKeysCmd): addr may contain
+	// several comma-separated node addresses, enumerated independently.
+	// failedNodes remembers which ones didn't respond on the last
+	// enumeration, so operations on keys belonging to them can fail with a
+	// clear message instead of a confusing connection error.
+	failedNodes []string
+
+	// Value search (see valuesearch.go): cached key values and the search
+	// state driving the key list's FilterValue mode
+	valueCache         map[string][]byte
+	valueSearching     bool
+	valueSearchPattern string
+
+	// Key details panel (see keydetails.go): flags fetched on demand since
+	// metadump doesn't report them, cached by key so reopening the panel
+	// doesn't re-fetch
+	keyFlagsCache       map[string]uint32
+	detailsReturnTo     FocusMode
+	showRawMetadumpLine bool
+
+	// Protected keys (see protected.go): an edit awaiting the type-to-confirm
+	// dialog for a key matching config.Limits.ProtectedPatterns
+	pendingEdit *pendingProtectedEdit
+
+	// Recently viewed keys for this server (see recents.go), most recent
+	// first. Loaded from disk on connect and updated as keys are viewed.
+	recentKeys []string
+
+	// Pinned keys for this server (see pin.go), keyed by key name. Loaded
+	// from disk on connect, toggled with 'p', and mirrored into keyList so
+	// it can render a Pinned section regardless of the active filter.
+	pinned map[string]bool
+
+	// Unsaved editor buffers, keyed by key (see buffers.go): edits left
+	// behind when the editor is closed without saving, so several keys can
+	// be edited in sequence without losing track of which are still dirty.
+	dirtyBuffers map[string]DirtyBuffer
+
+	// Per-key view mode overrides (see viewmode.go), keyed by key name.
+	// Recorded whenever the viewer's mode changes while a key is focused,
+	// so returning to that key later restores the chosen mode instead of
+	// falling back to Auto.
+	keyViewModes map[string]viewer.ViewMode
+
+	// Optional structured debug logging (see logging.Logger), nil when disabled
+	logger *logging.Logger
+
 	// Styles
 	styles Styles
+
+	// Active status bar flash level (see flash.go), cleared automatically
+	// a moment after being set.
+	flashLevel FlashLevel
+
+	// Idle timeout auto-disconnect (see idle.go). idleGeneration is bumped
+	// on every keypress, invalidating stale ticks scheduled before it;
+	// idleDisconnected is true while the reconnect screen is shown.
+	idleGeneration   int
+	idleDisconnected bool
+
+	// Keys created or edited this session, keyed by key name, so the key
+	// list can mark them for the user. Deleted keys are simply removed
+	// from the underlying key list on refresh, so nothing needs pruning
+	// here.
+	sessionModified map[string]bool
 }
 
 // Styles holds lipgloss styles for the app, derived from a Theme
 type Styles struct {
-	Theme      styles.Theme
-	Title      lipgloss.Style
-	StatusBar  lipgloss.Style
-	Error      lipgloss.Style
-	KeyList    lipgloss.Style
-	Viewer     lipgloss.Style
-	Help       lipgloss.Style
-	Connecting lipgloss.Style
-	Border     lipgloss.Style
+	Theme            styles.Theme
+	Title            lipgloss.Style
+	StatusBar        lipgloss.Style
+	StatusBarSuccess lipgloss.Style
+	StatusBarError   lipgloss.Style
+	Error            lipgloss.Style
+	KeyList          lipgloss.Style
+	Viewer           lipgloss.Style
+	Help             lipgloss.Style
+	Connecting       lipgloss.Style
+	Border           lipgloss.Style
+	ProductionBanner lipgloss.Style
+	KeyDetails       lipgloss.Style
 }
 
 // DefaultStyles returns the default styles using the dark theme
@@ -137,6 +241,14 @@ func NewStylesFromTheme(theme *styles.Theme) Styles {
 			Background(theme.Surface).
 			Foreground(theme.TextMuted).
 			Padding(0, 1),
+		StatusBarSuccess: lipgloss.NewStyle().
+			Background(theme.Success).
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Padding(0, 1),
+		StatusBarError: lipgloss.NewStyle().
+			Background(theme.Error).
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Padding(0, 1),
 		Error: lipgloss.NewStyle().
 			Foreground(theme.Error).
 			Bold(true),
@@ -153,5 +265,14 @@ func NewStylesFromTheme(theme *styles.Theme) Styles {
 		Border: lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
 			BorderForeground(theme.Border),
+		ProductionBanner: lipgloss.NewStyle().
+			Background(theme.Error).
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Bold(true).
+			Padding(0, 1),
+		KeyDetails: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(theme.BorderFocus).
+			Padding(1, 2),
 	}
 }
@@ -0,0 +1,70 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestModel_MultiNode_KeysLoadedMsg_ReportsFailedNodesAsWarning(t *testing.T) {
+	m := app.NewModel("node-a:11211,node-b:11211")
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	keys := []models.KeyInfo{
+		{Key: "a:1", Node: "node-a:11211"},
+	}
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: keys, FailedNodes: []string{"node-b:11211"}})
+	updated := newModel.(*app.Model)
+
+	if !strings.Contains(updated.View(), "node-b:11211") {
+		t.Error("expected the failed node address to surface as a warning somewhere in the view")
+	}
+}
+
+func TestModel_MultiNode_OperationOnUnreachableNode_ErrorsClearly(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	m := app.NewModel("node-a:11211,node-b:11211")
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	m.Update(app.KeysLoadedMsg{
+		Keys:        []models.KeyInfo{{Key: "a:1", Node: "node-a:11211"}, {Key: "b:1", Node: "node-b:11211"}},
+		FailedNodes: []string{"node-b:11211"},
+	})
+
+	newModel, cmd := m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "b:1", Node: "node-b:11211"}})
+	m = newModel.(*app.Model)
+	if cmd == nil {
+		t.Fatal("expected selecting a key to return a load-value command")
+	}
+
+	// Selecting a key also kicks off recording it as recently viewed, so the
+	// load-value command now travels alongside it in a batch.
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		t.Fatalf("expected a batch of commands, got %T", msg)
+	}
+
+	var errMsg app.ErrorMsg
+	found := false
+	for _, batchedCmd := range batch {
+		if batchedCmd == nil {
+			continue
+		}
+		if em, ok := batchedCmd().(app.ErrorMsg); ok {
+			errMsg = em
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected ErrorMsg for a key on an unreachable node among the batched commands")
+	}
+	if !strings.Contains(errMsg.Err, "node-b:11211") || !strings.Contains(errMsg.Err, "unreachable") {
+		t.Errorf("expected a clear unreachable-node error, got %q", errMsg.Err)
+	}
+}
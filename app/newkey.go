@@ -3,6 +3,7 @@ package app
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -56,6 +57,13 @@ type NewKeyContext struct {
 	Key string
 }
 
+// NewKeyValueContext carries the already-entered key name and value into the
+// third step (TTL entry) of the new-key dialog flow.
+type NewKeyValueContext struct {
+	Key   string
+	Value string
+}
+
 // NewKeyCmd creates a tea.Cmd that creates a new key in Memcached.
 // Returns KeyCreatedMsg on success or NewKeyErrorMsg on failure.
 func NewKeyCmd(client Setter, req NewKeyRequest) tea.Cmd {
@@ -156,7 +164,8 @@ func ValidateKeyName(key string) error {
 func CreateNewKeyDialog() *dialog.InputDialog {
 	return dialog.NewInput("New Key").
 		WithPlaceholder("Enter key name...").
-		WithValidator(ValidateKeyName)
+		WithValidator(ValidateKeyName).
+		WithLiveValidation()
 }
 
 // CreateValueInputDialog creates an input dialog for entering a value for a key.
@@ -168,6 +177,19 @@ func CreateValueInputDialog(key string) *dialog.InputDialog {
 		WithContext(NewKeyContext{Key: key})
 }
 
+// CreateNewKeyTTLDialog creates an input dialog for entering the TTL to
+// apply to a new key, pre-filled with the configured default TTL so the
+// user can just press Enter to accept it.
+func CreateNewKeyTTLDialog(key, value string, defaultTTL int32) *dialog.InputDialog {
+	title := fmt.Sprintf("TTL for: %s", key)
+	return dialog.NewInput(title).
+		WithPlaceholder("Enter TTL in seconds (0 = no expiration)...").
+		WithValue(strconv.FormatInt(int64(defaultTTL), 10)).
+		WithValidator(ValidateTTL).
+		WithLiveValidation().
+		WithContext(NewKeyValueContext{Key: key, Value: value})
+}
+
 // ExtractNewKeyContext extracts the key from an input result context.
 // Returns the key string and a boolean indicating success.
 func ExtractNewKeyContext(ctx interface{}) (string, bool) {
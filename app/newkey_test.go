@@ -7,6 +7,8 @@ import (
 	"github.com/bradfitz/gomemcache/memcache"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
 )
 
 // MockSetter is a mock implementation for testing new key functionality
@@ -558,3 +560,44 @@ func TestProcessNewKeyInputResult(t *testing.T) {
 		}
 	})
 }
+
+func TestCreateNewKeyTTLDialog_PreFillsDefaultTTL(t *testing.T) {
+	dlg := app.CreateNewKeyTTLDialog("mykey", "myvalue", 3600)
+
+	if dlg.Value() != "3600" {
+		t.Errorf("expected the TTL input pre-filled with '3600', got %q", dlg.Value())
+	}
+
+	_, cmd := dlg.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected pressing Enter on the pre-filled default to submit")
+	}
+}
+
+func TestModel_NewKeyFlow_UsesConfiguredDefaultTTL(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Limits.DefaultTTL = 1800
+	m := app.NewModelWithConfig("localhost:11211", cfg)
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, cmd := m.Update(dialog.InputResultMsg{
+		Value:   "myvalue",
+		Context: app.NewKeyContext{Key: "mykey"},
+	})
+	m = newModel.(*app.Model)
+
+	if cmd == nil {
+		t.Fatal("expected a command opening the TTL dialog")
+	}
+	if m.Focus() != app.FocusDialog {
+		t.Errorf("expected focus to stay on dialog, got %v", m.Focus())
+	}
+
+	ttlDialog := m.InputDialog()
+	if ttlDialog == nil {
+		t.Fatal("expected a TTL input dialog to be open")
+	}
+	if ttlDialog.Value() != "1800" {
+		t.Errorf("expected TTL dialog pre-filled with configured default '1800', got %q", ttlDialog.Value())
+	}
+}
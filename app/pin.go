@@ -0,0 +1,103 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// PinsLoadedMsg carries the persisted pinned-key set loaded on connect.
+type PinsLoadedMsg struct {
+	Keys []string
+}
+
+// PinToggledMsg is sent after a key's pinned state has been persisted.
+type PinToggledMsg struct {
+	Key    string
+	Pinned bool
+}
+
+// PinErrorMsg is sent when loading or persisting pinned keys fails, e.g. the
+// config directory isn't writable.
+type PinErrorMsg struct {
+	Err error
+}
+
+// PinsPrunedMsg is sent after dead pins (keys that no longer exist) have
+// been persisted off the pinned list.
+type PinsPrunedMsg struct{}
+
+// loadPinsCmd loads the persisted pinned-key set for addr so the key list
+// can render the Pinned section as soon as keys are available.
+func loadPinsCmd(addr string) tea.Cmd {
+	return func() tea.Msg {
+		keys, err := config.PinnedKeysFor(addr)
+		if err != nil {
+			return PinErrorMsg{Err: err}
+		}
+		return PinsLoadedMsg{Keys: keys}
+	}
+}
+
+// togglePinCmd flips key's pinned state for addr and persists the result.
+func togglePinCmd(addr, key string) tea.Cmd {
+	return func() tea.Msg {
+		pinned, err := config.TogglePin(addr, key)
+		if err != nil {
+			return PinErrorMsg{Err: err}
+		}
+		return PinToggledMsg{Key: key, Pinned: pinned}
+	}
+}
+
+// setPinned records pinned as the in-memory pinned set and mirrors it into
+// the key list so the Pinned section reflects it.
+func (m *Model) setPinned(pinned map[string]bool) {
+	m.pinned = pinned
+	m.keyList.SetPinned(m.pinned)
+}
+
+// PinnedKeys returns the set of keys currently pinned (for testing).
+func (m *Model) PinnedKeys() map[string]bool {
+	return m.pinned
+}
+
+// prunePinnedKeysCmd removes pinned keys that no longer appear in keys (the
+// just-refreshed key set) from the in-memory and key list state, and
+// persists the pruning so stale pins left by a deleted or expired key don't
+// linger. Returns nil if nothing needed pruning.
+func (m *Model) prunePinnedKeysCmd(keys []models.KeyInfo) tea.Cmd {
+	if len(m.pinned) == 0 {
+		return nil
+	}
+
+	present := make(map[string]bool, len(keys))
+	for _, ki := range keys {
+		present[ki.Key] = true
+	}
+
+	survivors := make(map[string]bool, len(m.pinned))
+	for key := range m.pinned {
+		if present[key] {
+			survivors[key] = true
+		}
+	}
+	if len(survivors) == len(m.pinned) {
+		return nil
+	}
+
+	m.setPinned(survivors)
+
+	addr := m.addr
+	remaining := make([]string, 0, len(survivors))
+	for key := range survivors {
+		remaining = append(remaining, key)
+	}
+
+	return func() tea.Msg {
+		if err := config.SetPinnedKeysFor(addr, remaining); err != nil {
+			return PinErrorMsg{Err: err}
+		}
+		return PinsPrunedMsg{}
+	}
+}
@@ -0,0 +1,124 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestModel_TogglePin_AddsToPinnedSetAndPersists(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	m := app.NewModel("localhost:11211")
+	m.KeyList().SetSize(40, 20)
+	m.KeyList().SetKeys([]models.KeyInfo{{Key: "user:1"}})
+	updated, _ := m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "user:1"}})
+	m = updated.(*app.Model)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if cmd == nil {
+		t.Fatal("expected a command to toggle the pin")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(*app.Model)
+
+	if !m.PinnedKeys()["user:1"] {
+		t.Fatal("expected user:1 to be recorded as pinned")
+	}
+
+	keys, err := config.PinnedKeysFor("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != "user:1" {
+		t.Errorf("expected pin to be persisted as [user:1], got %v", keys)
+	}
+}
+
+func TestModel_TogglePin_Twice_Unpins(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	m := app.NewModel("localhost:11211")
+	m.KeyList().SetSize(40, 20)
+	m.KeyList().SetKeys([]models.KeyInfo{{Key: "user:1"}})
+	updated, _ := m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "user:1"}})
+	m = updated.(*app.Model)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	updated, _ = m.Update(cmd())
+	m = updated.(*app.Model)
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	updated, _ = m.Update(cmd())
+	m = updated.(*app.Model)
+
+	if m.PinnedKeys()["user:1"] {
+		t.Fatal("expected user:1 to be unpinned after toggling twice")
+	}
+
+	keys, err := config.PinnedKeysFor("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("expected no persisted pins, got %v", keys)
+	}
+}
+
+func TestModel_PinnedSection_RendersInKeyList(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.KeyList().SetSize(40, 20)
+	m.KeyList().SetKeys([]models.KeyInfo{{Key: "user:1"}, {Key: "user:2"}})
+
+	updated, _ := m.Update(app.PinsLoadedMsg{Keys: []string{"user:1"}})
+	m = updated.(*app.Model)
+
+	view := m.KeyList().View()
+	if !strings.Contains(view, "Pinned") {
+		t.Errorf("expected rendered key list to contain a Pinned section, got:\n%s", view)
+	}
+}
+
+func TestModel_PrunePinnedKeys_RemovesDeadPins(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	if err := config.SetPinnedKeysFor("localhost:11211", []string{"user:1", "user:2"}); err != nil {
+		t.Fatalf("failed to seed pins config: %v", err)
+	}
+
+	m := app.NewModel("localhost:11211")
+	updated, _ := m.Update(app.PinsLoadedMsg{Keys: []string{"user:1", "user:2"}})
+	m = updated.(*app.Model)
+
+	updated, cmd := m.Update(app.KeysLoadedMsg{Keys: []models.KeyInfo{{Key: "user:1"}}})
+	m = updated.(*app.Model)
+
+	if m.PinnedKeys()["user:2"] {
+		t.Fatal("expected user:2 to be pruned once it no longer exists")
+	}
+	if !m.PinnedKeys()["user:1"] {
+		t.Fatal("expected user:1 to remain pinned")
+	}
+	if cmd == nil {
+		t.Fatal("expected a command to persist the pruned pin set")
+	}
+
+	cmd() // persist
+
+	keys, err := config.PinnedKeysFor("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != "user:1" {
+		t.Errorf("expected persisted pins to be [user:1], got %v", keys)
+	}
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+	"github.com/nnnkkk7/memtui/ui/components/editor"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+// isProtectedKey reports whether key matches one of the configured
+// protected_patterns globs, requiring an extra type-to-confirm step before
+// deleting or overwriting it. Patterns use the same glob engine as the key
+// list's glob filter mode.
+func (m *Model) isProtectedKey(key string) bool {
+	for _, pattern := range m.cfg.Limits.ProtectedPatterns {
+		if keylist.CompileGlob(pattern).MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// ProtectedDeleteContext carries the key through the type-to-confirm dialog
+// shown before deleting a key matching a protected_patterns glob, on top of
+// the normal delete confirmation.
+type ProtectedDeleteContext struct {
+	Key string
+}
+
+// CreateProtectedDeleteDialog creates the extra type-to-confirm dialog for
+// deleting a protected key. Unlike the plain yes/no confirmation used for
+// ordinary deletes, the user must type the key name exactly.
+func CreateProtectedDeleteDialog(key string) *dialog.InputDialog {
+	title := "Delete Protected Key"
+	return dialog.NewInput(title).
+		WithPlaceholder(fmt.Sprintf("Type %q to confirm deletion", key)).
+		WithValidator(validateProtectedKeyInput(key)).
+		WithContext(ProtectedDeleteContext{Key: key})
+}
+
+// ProtectedEditContext marks the type-to-confirm dialog shown before
+// overwriting a protected key's value; the pending save itself is kept on
+// Model.pendingProtectedEdit, since an InputDialog context can't carry the
+// edited []byte value and flags cleanly.
+type ProtectedEditContext struct {
+	Key string
+}
+
+// CreateProtectedEditDialog creates the extra type-to-confirm dialog for
+// overwriting a protected key's value.
+func CreateProtectedEditDialog(key string) *dialog.InputDialog {
+	title := "Overwrite Protected Key"
+	return dialog.NewInput(title).
+		WithPlaceholder(fmt.Sprintf("Type %q to confirm overwrite", key)).
+		WithValidator(validateProtectedKeyInput(key)).
+		WithContext(ProtectedEditContext{Key: key})
+}
+
+func validateProtectedKeyInput(key string) func(string) error {
+	return func(input string) error {
+		if input != key {
+			return fmt.Errorf("type %q to confirm", key)
+		}
+		return nil
+	}
+}
+
+// pendingProtectedEdit holds an editor save awaiting the protected-key
+// type-to-confirm dialog.
+type pendingProtectedEdit struct {
+	msg      editor.EditorSaveMsg
+	original []byte
+}
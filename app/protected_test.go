@@ -0,0 +1,176 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+	"github.com/nnnkkk7/memtui/ui/components/editor"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func newModelWithProtectedPatterns(patterns []string) *app.Model {
+	cfg := config.DefaultConfig()
+	cfg.Limits.ProtectedPatterns = patterns
+	m := app.NewModelWithConfig("localhost:11211", cfg)
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: []models.KeyInfo{
+		{Key: "config:feature-flags"}, {Key: "session:abc"},
+	}})
+	return newModel.(*app.Model)
+}
+
+func selectKey(m *app.Model, key string) *app.Model {
+	newModel, _ := m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: key}})
+	return newModel.(*app.Model)
+}
+
+func TestModel_Delete_ProtectedKey_RequiresTypeToConfirm(t *testing.T) {
+	m := newModelWithProtectedPatterns([]string{"config:*"})
+	m = selectKey(m, "config:feature-flags")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusDialog {
+		t.Fatalf("expected deleting a protected key to open a dialog, got focus %v", updated.Focus())
+	}
+	if !strings.Contains(updated.View(), "config:feature-flags") {
+		t.Error("expected the type-to-confirm dialog to name the protected key")
+	}
+}
+
+func TestModel_Delete_NonProtectedKey_UsesPlainConfirm(t *testing.T) {
+	m := newModelWithProtectedPatterns([]string{"config:*"})
+	m = selectKey(m, "session:abc")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusDialog {
+		t.Fatalf("expected delete to open a dialog, got focus %v", updated.Focus())
+	}
+	// A plain yes/no ConfirmDialog doesn't render a "Type ... to confirm"
+	// prompt the way the protected-key InputDialog does.
+	if strings.Contains(updated.View(), "to confirm") {
+		t.Error("expected a plain confirm dialog (no type-to-confirm prompt) for a non-protected key")
+	}
+}
+
+func TestModel_BatchDelete_WithProtectedKeySelected_RequiresTypingItsName(t *testing.T) {
+	m := newModelWithProtectedPatterns([]string{"config:*"})
+	m = selectKey(m, "config:feature-flags")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	m = newModel.(*app.Model)
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = newModel.(*app.Model)
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusDialog {
+		t.Fatalf("expected batch delete with a protected key to open a dialog, got focus %v", updated.Focus())
+	}
+	if !strings.Contains(updated.View(), "config:feature-flags") {
+		t.Error("expected the stricter batch delete dialog to name the protected key")
+	}
+
+	// Typing "DELETE" alone must not be accepted: it has to bypass the
+	// stricter type-the-protected-key-names confirmation.
+	for _, r := range "DELETE" {
+		newModel, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		updated = newModel.(*app.Model)
+	}
+	newModel, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated = newModel.(*app.Model)
+	if updated.Focus() != app.FocusDialog {
+		t.Error("expected typing plain DELETE to not close the stricter dialog")
+	}
+}
+
+func TestProcessBatchInputResult_ProtectedKeys_RequiresExactNames(t *testing.T) {
+	ctx := app.BatchDeleteContext{Keys: []string{"config:a", "session:b"}, ProtectedKeys: []string{"config:a"}}
+
+	if msg := app.ProcessBatchInputResult(dialog.InputResultMsg{Value: "DELETE", Context: ctx}); msg != nil {
+		t.Error("expected plain DELETE to be rejected when ProtectedKeys is set")
+	}
+
+	msg := app.ProcessBatchInputResult(dialog.InputResultMsg{Value: "config:a", Context: ctx})
+	if msg == nil {
+		t.Fatal("expected typing the protected key name to confirm the batch delete")
+	}
+	if len(msg.Keys) != 2 {
+		t.Errorf("expected both keys to still be deleted, got %v", msg.Keys)
+	}
+}
+
+func TestCreateProtectedDeleteDialog_OnlyAcceptsExactKeyName(t *testing.T) {
+	dlg := app.CreateProtectedDeleteDialog("config:feature-flags")
+
+	for _, r := range "wrong" {
+		dlg.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if _, cmd := dlg.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		if _, ok := cmd().(dialog.InputResultMsg); ok {
+			t.Error("expected wrong input not to submit a result")
+		}
+	}
+
+	dlg2 := app.CreateProtectedDeleteDialog("config:feature-flags")
+	for _, r := range "config:feature-flags" {
+		dlg2.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	_, cmd := dlg2.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the exact key name to submit a result")
+	}
+	resultMsg, ok := cmd().(dialog.InputResultMsg)
+	if !ok {
+		t.Fatalf("expected InputResultMsg, got %T", cmd())
+	}
+	if resultMsg.Context != (app.ProtectedDeleteContext{Key: "config:feature-flags"}) {
+		t.Errorf("expected ProtectedDeleteContext, got %#v", resultMsg.Context)
+	}
+}
+
+func TestModel_EditProtectedKey_RequiresTypeToConfirmBeforeSaving(t *testing.T) {
+	m := newModelWithProtectedPatterns([]string{"config:*"})
+	m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "config:feature-flags"}})
+	m.Update(app.ValueLoadedMsg{Key: "config:feature-flags", Value: []byte("old value")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+
+	newModel, _ := m.Update(editor.EditorSaveMsg{Key: "config:feature-flags", Value: []byte("new value")})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusDialog {
+		t.Fatalf("expected saving a protected key to open a confirm dialog first, got focus %v", updated.Focus())
+	}
+	if !strings.Contains(updated.View(), "config:feature-flags") {
+		t.Error("expected the type-to-confirm dialog to name the protected key")
+	}
+
+	// Confirming with the exact key name should now apply the save, reflected
+	// in the usual diff-summary status message.
+	newModel, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("config:feature-flags")})
+	updated = newModel.(*app.Model)
+	newModel, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated = newModel.(*app.Model)
+	if cmd != nil {
+		if msg := cmd(); msg != nil {
+			if result, ok := msg.(dialog.InputResultMsg); ok {
+				newModel, _ = updated.Update(result)
+				updated = newModel.(*app.Model)
+			}
+		}
+	}
+	if !strings.Contains(updated.Error(), "Saved:") {
+		t.Errorf("expected the confirmed edit to go through and show a diff summary, got %q", updated.Error())
+	}
+}
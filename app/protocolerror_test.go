@@ -0,0 +1,34 @@
+package app_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/client"
+)
+
+func TestModel_NewKeyError_ClientErrorShowsFriendlyMessage(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+
+	raw := errors.New(`memcache: unexpected response line from "set": "CLIENT_ERROR bad command line format\r\n"`)
+	newModel, _ := m.Update(app.NewKeyErrorMsg{Key: "foo", Err: client.ParseProtocolError(raw)})
+	updated := newModel.(*app.Model)
+
+	if !strings.Contains(updated.Error(), "server rejected the command") {
+		t.Errorf("expected a friendly CLIENT_ERROR message, got %q", updated.Error())
+	}
+}
+
+func TestModel_DeleteError_ValueTooLargeShowsFriendlyMessage(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+
+	raw := errors.New(`memcache: unexpected response line from "set": "SERVER_ERROR object too large for cache\r\n"`)
+	newModel, _ := m.Update(app.DeleteErrorMsg{Key: "foo", Err: client.ParseProtocolError(raw)})
+	updated := newModel.(*app.Model)
+
+	if !strings.Contains(updated.Error(), "exceeds item size limit") {
+		t.Errorf("expected a friendly value-too-large message, got %q", updated.Error())
+	}
+}
@@ -0,0 +1,96 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+)
+
+func newModelWithKeys(t *testing.T, keys []models.KeyInfo) *app.Model {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: keys})
+	return newModel.(*app.Model)
+}
+
+func TestModel_QuickSwitch_ViewingKeysPopulatesRecencyInMostRecentFirstOrder(t *testing.T) {
+	m := newModelWithKeys(t, []models.KeyInfo{
+		{Key: "user:1"}, {Key: "user:2"}, {Key: "user:3"},
+	})
+
+	m = selectKey(m, "user:1")
+	m = selectKey(m, "user:2")
+	m = selectKey(m, "user:3")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	m = newModel.(*app.Model)
+
+	view := m.View()
+	idx3 := strings.Index(view, "user:3")
+	idx2 := strings.Index(view, "user:2")
+	idx1 := strings.Index(view, "user:1")
+
+	if idx3 == -1 || idx2 == -1 || idx1 == -1 {
+		t.Fatalf("expected all three keys in the quick switcher view, got:\n%s", view)
+	}
+	if !(idx3 < idx2 && idx2 < idx1) {
+		t.Errorf("expected most-recently-viewed key first (user:3, user:2, user:1), got order in view:\n%s", view)
+	}
+}
+
+func TestModel_QuickSwitch_FuzzyFilterNarrowsResults(t *testing.T) {
+	m := newModelWithKeys(t, []models.KeyInfo{
+		{Key: "user:session:1"}, {Key: "config:feature-flags"}, {Key: "user:profile:2"},
+	})
+
+	m = selectKey(m, "user:session:1")
+	m = selectKey(m, "config:feature-flags")
+	m = selectKey(m, "user:profile:2")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	m = newModel.(*app.Model)
+
+	for _, r := range "user" {
+		newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = newModel.(*app.Model)
+	}
+
+	view := m.View()
+	if strings.Contains(view, "config:feature-flags") {
+		t.Errorf("expected non-matching key to be filtered out, got:\n%s", view)
+	}
+	if !strings.Contains(view, "user:session:1") || !strings.Contains(view, "user:profile:2") {
+		t.Errorf("expected matching keys to remain, got:\n%s", view)
+	}
+}
+
+func TestModel_QuickSwitch_SelectingKeyJumpsToItAndReturnsFocusToKeyList(t *testing.T) {
+	m := newModelWithKeys(t, []models.KeyInfo{
+		{Key: "user:1"}, {Key: "user:2"},
+	})
+
+	m = selectKey(m, "user:1")
+	m = selectKey(m, "user:2")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	m = newModel.(*app.Model)
+
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = newModel.(*app.Model)
+	if cmd == nil {
+		t.Fatal("expected selecting a key in the switcher to return a command")
+	}
+
+	newModel, _ = m.Update(cmd())
+	m = newModel.(*app.Model)
+
+	if m.Keys()[0].Key != "user:1" && m.State() != app.StateReady {
+		t.Fatalf("expected model to remain ready after quick switch selection")
+	}
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/config"
+)
+
+// RecentKeyRecordedMsg is sent after a viewed key has been persisted to the
+// recency list.
+type RecentKeyRecordedMsg struct{}
+
+// RecentKeyRecordErrorMsg is sent when persisting a viewed key fails, e.g.
+// the config directory isn't writable.
+type RecentKeyRecordErrorMsg struct {
+	Err error
+}
+
+// recordRecentKeyCmd persists key as the most recently viewed key for addr.
+func recordRecentKeyCmd(addr, key string) tea.Cmd {
+	return func() tea.Msg {
+		if err := config.RecordRecentKey(addr, key); err != nil {
+			return RecentKeyRecordErrorMsg{Err: err}
+		}
+		return RecentKeyRecordedMsg{}
+	}
+}
+
+// rememberRecentKey moves key to the front of the in-memory recency list,
+// capping it at config.MaxRecentKeys, matching the persisted ordering.
+func (m *Model) rememberRecentKey(key string) {
+	result := make([]string, 0, len(m.recentKeys)+1)
+	result = append(result, key)
+	for _, k := range m.recentKeys {
+		if k != key {
+			result = append(result, k)
+		}
+	}
+	if len(result) > config.MaxRecentKeys {
+		result = result[:config.MaxRecentKeys]
+	}
+	m.recentKeys = result
+}
+
+// loadRecentKeysCmd loads the persisted recency list for addr so the quick
+// switcher has data even before any key is viewed this session.
+func loadRecentKeysCmd(addr string) tea.Cmd {
+	return func() tea.Msg {
+		keys, err := config.RecentKeysFor(addr)
+		if err != nil {
+			return RecentKeyRecordErrorMsg{Err: err}
+		}
+		return RecentKeysLoadedMsg{Keys: keys}
+	}
+}
+
+// RecentKeysLoadedMsg carries the persisted recency list loaded on connect.
+type RecentKeysLoadedMsg struct {
+	Keys []string
+}
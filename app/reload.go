@@ -0,0 +1,39 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/client"
+)
+
+// ValueGetter is an interface for fetching a value with its CAS token.
+// This allows for easy mocking in tests.
+type ValueGetter interface {
+	GetWithCAS(key string) (*client.CASItem, error)
+}
+
+// ReloadValueCmd creates a tea.Cmd that re-fetches a single key's value,
+// without re-enumerating the whole key list. This is cheaper than a full
+// refresh and keeps the CAS token current before an edit.
+// Returns ValueLoadedMsg on success or ErrorMsg on failure.
+func ReloadValueCmd(c ValueGetter, key string) tea.Cmd {
+	return func() tea.Msg {
+		if c == nil {
+			return ErrorMsg{Err: "client not connected"}
+		}
+
+		casItem, err := c.GetWithCAS(key)
+		if err != nil {
+			return ErrorMsg{Err: fmt.Sprintf("failed to reload value: %v", err)}
+		}
+
+		return ValueLoadedMsg{
+			Key:        key,
+			Value:      casItem.Value,
+			Flags:      casItem.Flags,
+			Expiration: casItem.Expiration,
+			CAS:        casItem.CAS,
+		}
+	}
+}
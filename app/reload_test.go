@@ -0,0 +1,80 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/client"
+)
+
+// mockValueGetter is a mock implementation for testing value reload functionality
+type mockValueGetter struct {
+	item *client.CASItem
+	err  error
+}
+
+func (m *mockValueGetter) GetWithCAS(key string) (*client.CASItem, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.item, nil
+}
+
+func TestReloadValueCmd(t *testing.T) {
+	t.Run("reloads value and CAS after underlying key changed", func(t *testing.T) {
+		mock := &mockValueGetter{
+			item: &client.CASItem{
+				Key:   "test-key",
+				Value: []byte("updated value"),
+				Flags: 7,
+				CAS:   1,
+			},
+		}
+
+		cmd := app.ReloadValueCmd(mock, "test-key")
+		msg := cmd()
+
+		loadedMsg, ok := msg.(app.ValueLoadedMsg)
+		if !ok {
+			t.Fatalf("expected ValueLoadedMsg, got %T", msg)
+		}
+
+		if string(loadedMsg.Value) != "updated value" {
+			t.Errorf("expected value 'updated value', got '%s'", string(loadedMsg.Value))
+		}
+		if loadedMsg.Flags != 7 {
+			t.Errorf("expected flags 7, got %d", loadedMsg.Flags)
+		}
+		if loadedMsg.CAS != 1 {
+			t.Errorf("expected CAS 1, got %d", loadedMsg.CAS)
+		}
+	})
+
+	t.Run("returns error when client is nil", func(t *testing.T) {
+		cmd := app.ReloadValueCmd(nil, "test-key")
+		msg := cmd()
+
+		errMsg, ok := msg.(app.ErrorMsg)
+		if !ok {
+			t.Fatalf("expected ErrorMsg, got %T", msg)
+		}
+		if errMsg.Err != "client not connected" {
+			t.Errorf("expected 'client not connected', got '%s'", errMsg.Err)
+		}
+	})
+
+	t.Run("returns error when GetWithCAS fails", func(t *testing.T) {
+		mock := &mockValueGetter{err: errors.New("not found")}
+		cmd := app.ReloadValueCmd(mock, "test-key")
+		msg := cmd()
+
+		errMsg, ok := msg.(app.ErrorMsg)
+		if !ok {
+			t.Fatalf("expected ErrorMsg, got %T", msg)
+		}
+		if errMsg.Err == "" {
+			t.Error("expected non-empty error message")
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+)
+
+func TestModel_RevealInTree_ClearsFilterAndExpandsAncestors(t *testing.T) {
+	m := modelWithDeepKeys(t)
+
+	m.KeyList().SetFilter("sessions")
+	if !m.KeyList().SelectKey("users:123:sessions:abc") {
+		t.Fatal("expected to be able to select 'users:123:sessions:abc' within the filtered results")
+	}
+
+	newModel, _ := m.Update(command.CommandExecuteMsg{
+		Command: command.Command{Name: "Reveal in tree"},
+	})
+	updated := newModel.(*app.Model)
+
+	selected := updated.KeyList().SelectedKey()
+	if selected == nil || selected.Key != "users:123:sessions:abc" {
+		t.Fatalf("expected cursor to stay on 'users:123:sessions:abc' after reveal, got %+v", selected)
+	}
+	if got := len(updated.KeyList().FilteredKeys()); got != 3 {
+		t.Errorf("expected the filter to be cleared and all 3 keys visible, got %d", got)
+	}
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nnnkkk7/memtui/schema"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+// schemaForKey returns the JSON Schema file path configured for key via the
+// first matching config.SchemaRule glob, or "" if none match.
+func (m *Model) schemaForKey(key string) string {
+	for _, rule := range m.cfg.Schemas {
+		if keylist.CompileGlob(rule.Pattern).MatchString(key) {
+			return rule.Path
+		}
+	}
+	return ""
+}
+
+// validateEditorSave checks content against the JSON Schema configured for
+// key, if any, returning a formatted list of violations. Returns "" when the
+// content conforms, no schema applies, or the schema itself can't be read.
+func (m *Model) validateEditorSave(key string, content []byte) string {
+	path := m.schemaForKey(key)
+	if path == "" {
+		return ""
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Sprintf("could not read schema %s: %v", path, err)
+	}
+
+	s, err := schema.Parse(data)
+	if err != nil {
+		return fmt.Sprintf("invalid schema %s: %v", path, err)
+	}
+
+	errs := s.ValidateAgainstSchema(content)
+	if len(errs) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(errs)+1)
+	lines = append(lines, fmt.Sprintf("Value does not conform to schema %s:", path))
+	for _, e := range errs {
+		lines = append(lines, "  - "+e.Error())
+	}
+	return strings.Join(lines, "\n")
+}
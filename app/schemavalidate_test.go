@@ -0,0 +1,74 @@
+package app_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/editor"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func modelWithSchema(t *testing.T, pattern, schemaJSON string) *app.Model {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schema.json")
+	if err := os.WriteFile(path, []byte(schemaJSON), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Schemas = []config.SchemaRule{{Pattern: pattern, Path: path}}
+
+	m := app.NewModelWithConfig("localhost:11211", cfg)
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "users:1"}})
+	m.Update(app.ValueLoadedMsg{Key: "users:1", Value: []byte(`{"id": 1, "name": "ada"}`)})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	return m
+}
+
+const requiredIDSchema = `{"type": "object", "required": ["id", "name"]}`
+
+func TestModel_EditorSave_ConformingValuePasses(t *testing.T) {
+	m := modelWithSchema(t, "users:*", requiredIDSchema)
+
+	newModel, _ := m.Update(editor.EditorSaveMsg{Key: "users:1", Value: []byte(`{"id": 2, "name": "grace"}`)})
+	updated := newModel.(*app.Model)
+
+	if strings.Contains(updated.Error(), "does not conform") {
+		t.Errorf("expected conforming value to save, got error %q", updated.Error())
+	}
+}
+
+func TestModel_EditorSave_ViolationBlocksSaveWithMessage(t *testing.T) {
+	m := modelWithSchema(t, "users:*", requiredIDSchema)
+
+	newModel, cmd := m.Update(editor.EditorSaveMsg{Key: "users:1", Value: []byte(`{"id": 2}`)})
+	updated := newModel.(*app.Model)
+
+	if !strings.Contains(updated.Error(), "does not conform") {
+		t.Errorf("expected a schema violation message, got %q", updated.Error())
+	}
+	if !strings.Contains(updated.Error(), `missing required property "name"`) {
+		t.Errorf("expected the violation detail in the message, got %q", updated.Error())
+	}
+	if cmd != nil {
+		t.Error("expected a blocked save not to return a command")
+	}
+}
+
+func TestModel_EditorSave_NonMatchingKeyIsNotValidated(t *testing.T) {
+	m := modelWithSchema(t, "other:*", requiredIDSchema)
+
+	newModel, _ := m.Update(editor.EditorSaveMsg{Key: "users:1", Value: []byte(`not even json`)})
+	updated := newModel.(*app.Model)
+
+	if strings.Contains(updated.Error(), "does not conform") {
+		t.Errorf("expected no schema to apply to a non-matching key, got %q", updated.Error())
+	}
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+// SelectAllContext marks a ConfirmResultMsg as originating from a
+// select-all-above-threshold confirmation (see keylist.SelectAllConfirmMsg).
+type SelectAllContext struct {
+	Count int
+}
+
+// CreateSelectAllConfirmDialog creates a confirmation dialog shown when
+// Ctrl+A would select more keys than the key list's configured threshold,
+// since a selection that large is usually followed by a batch delete.
+func CreateSelectAllConfirmDialog(count int) *dialog.ConfirmDialog {
+	title := "Select All Keys"
+	message := fmt.Sprintf(
+		"This will select all %d keys.\n\nA batch delete on this many keys can be destructive. Continue?",
+		count,
+	)
+	return dialog.NewWithContext(title, message, SelectAllContext{Count: count})
+}
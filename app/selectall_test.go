@@ -0,0 +1,95 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestCreateSelectAllConfirmDialog(t *testing.T) {
+	dlg := app.CreateSelectAllConfirmDialog(1000)
+	if dlg == nil {
+		t.Fatal("expected non-nil dialog")
+	}
+	if dlg.Title() == "" {
+		t.Error("expected non-empty title")
+	}
+	if !strings.Contains(dlg.Message(), "1000") {
+		t.Errorf("expected message to mention the count, got: %s", dlg.Message())
+	}
+
+	// Confirming should carry the count through as SelectAllContext.
+	_, cmd := dlg.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if cmd == nil {
+		t.Fatal("expected a command from confirmation")
+	}
+	msg, ok := cmd().(dialog.ConfirmResultMsg)
+	if !ok {
+		t.Fatalf("expected ConfirmResultMsg, got %T", msg)
+	}
+	ctx, ok := msg.Context.(app.SelectAllContext)
+	if !ok || ctx.Count != 1000 {
+		t.Errorf("expected context SelectAllContext{Count: 1000}, got %#v", msg.Context)
+	}
+}
+
+func TestModel_Update_SelectAllConfirmMsg_ShowsDialog(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(keylist.SelectAllConfirmMsg{Count: 1000})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusDialog {
+		t.Errorf("expected FocusDialog, got %v", updated.Focus())
+	}
+}
+
+func TestModel_ConfirmSelectAll_SelectsKeys(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	keys := []models.KeyInfo{
+		{Key: "key1", Size: 100},
+		{Key: "key2", Size: 200},
+	}
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: keys})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(dialog.ConfirmResultMsg{
+		Result:  true,
+		Context: app.SelectAllContext{Count: 2},
+	})
+	updated := newModel.(*app.Model)
+
+	if !updated.KeyList().HasSelection() {
+		t.Error("expected confirming select-all to select the keys")
+	}
+	if updated.KeyList().SelectionCount() != 2 {
+		t.Errorf("expected 2 selected keys, got %d", updated.KeyList().SelectionCount())
+	}
+}
+
+func TestModel_CancelSelectAll_DoesNotSelect(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	keys := []models.KeyInfo{{Key: "key1", Size: 100}}
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: keys})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(dialog.ConfirmResultMsg{
+		Result:  false,
+		Context: app.SelectAllContext{Count: 1},
+	})
+	updated := newModel.(*app.Model)
+
+	if updated.KeyList().HasSelection() {
+		t.Error("expected canceling select-all to leave selection empty")
+	}
+}
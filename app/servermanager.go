@@ -0,0 +1,76 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+	"github.com/nnnkkk7/memtui/ui/components/serverlist"
+)
+
+// RemoveServerContext carries the name of the server pending removal through
+// the remove confirmation dialog.
+type RemoveServerContext struct {
+	Name string
+}
+
+// CreateRemoveServerConfirmDialog creates a confirmation dialog for removing
+// a configured server.
+func CreateRemoveServerConfirmDialog(name string) *dialog.ConfirmDialog {
+	title := "Remove Server"
+	message := fmt.Sprintf("Remove server %q? This cannot be undone.", name)
+	return dialog.NewWithContext(title, message, RemoveServerContext{Name: name})
+}
+
+// buildServerItems loads the configured servers and maps them to
+// serverlist.ServerItem, marking which one is currently connected and which
+// was last used.
+func buildServerItems(addr string) ([]serverlist.ServerItem, error) {
+	cfg, err := config.LoadServers()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]serverlist.ServerItem, 0, len(cfg.Servers))
+	for _, s := range cfg.Servers {
+		status := ""
+		if s.Name == cfg.LastUsed {
+			status = "last used"
+		}
+		items = append(items, serverlist.ServerItem{
+			Name:      s.Name,
+			Address:   s.Address,
+			Status:    status,
+			Connected: s.Address == addr,
+			Default:   s.Default,
+		})
+	}
+	return items, nil
+}
+
+// openServerManager loads the configured servers and switches focus to the
+// server manager screen.
+func (m *Model) openServerManager() {
+	items, err := buildServerItems(m.addr)
+	if err != nil {
+		m.err = err.Error()
+		return
+	}
+	m.serverList = serverlist.New(items)
+	m.serverList.SetSize(m.width, m.height)
+	m.focus = FocusServerManager
+}
+
+// refreshServerManager reloads the server list from disk, e.g. after an
+// add/remove/set-default operation, keeping the manager screen in sync.
+func (m *Model) refreshServerManager() {
+	if m.serverList == nil {
+		return
+	}
+	items, err := buildServerItems(m.addr)
+	if err != nil {
+		m.err = err.Error()
+		return
+	}
+	m.serverList.SetServers(items)
+}
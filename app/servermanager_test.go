@@ -0,0 +1,175 @@
+package app_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+	"github.com/nnnkkk7/memtui/ui/components/serverlist"
+)
+
+func TestModel_ManageServers_ReflectsConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	if err := config.SaveServers(&config.ServersConfig{
+		Servers: []config.ServerConfig{
+			{Name: "primary", Address: "localhost:11211", Default: true},
+			{Name: "secondary", Address: "localhost:11311"},
+		},
+		LastUsed: "primary",
+	}); err != nil {
+		t.Fatalf("failed to seed servers config: %v", err)
+	}
+
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Manage servers"}})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusServerManager {
+		t.Fatalf("expected FocusServerManager, got %v", updated.Focus())
+	}
+	sl := updated.ServerList()
+	if sl == nil {
+		t.Fatal("expected server list to be populated")
+	}
+	if sl.ServerCount() != 2 {
+		t.Fatalf("expected 2 servers, got %d", sl.ServerCount())
+	}
+
+	servers := sl.Servers()
+	if !servers[0].Connected {
+		t.Errorf("expected primary (matching current addr) to be marked Connected, got %+v", servers[0])
+	}
+	if !servers[0].Default {
+		t.Errorf("expected primary to be marked Default, got %+v", servers[0])
+	}
+	if servers[0].Status != "last used" {
+		t.Errorf("expected primary to be marked as last used, got %+v", servers[0])
+	}
+}
+
+func TestModel_ServerManager_SetDefault_Persists(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	if err := config.SaveServers(&config.ServersConfig{
+		Servers: []config.ServerConfig{
+			{Name: "primary", Address: "localhost:11211", Default: true},
+			{Name: "secondary", Address: "localhost:11311"},
+		},
+	}); err != nil {
+		t.Fatalf("failed to seed servers config: %v", err)
+	}
+
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	newModel, _ := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Manage servers"}})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(serverlist.SetDefaultRequestMsg{
+		Server: serverlist.ServerItem{Name: "secondary", Address: "localhost:11311"},
+	})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusServerManager {
+		t.Errorf("expected to remain on FocusServerManager, got %v", updated.Focus())
+	}
+
+	servers, err := config.LoadServers()
+	if err != nil {
+		t.Fatalf("unexpected error loading servers: %v", err)
+	}
+	for _, s := range servers.Servers {
+		wantDefault := s.Name == "secondary"
+		if s.Default != wantDefault {
+			t.Errorf("expected %s.Default=%v, got %v", s.Name, wantDefault, s.Default)
+		}
+	}
+}
+
+func TestModel_ServerManager_RemoveServer_Persists(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	if err := config.SaveServers(&config.ServersConfig{
+		Servers: []config.ServerConfig{
+			{Name: "primary", Address: "localhost:11211", Default: true},
+			{Name: "secondary", Address: "localhost:11311"},
+		},
+	}); err != nil {
+		t.Fatalf("failed to seed servers config: %v", err)
+	}
+
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	newModel, _ := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Manage servers"}})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(serverlist.DeleteServerRequestMsg{
+		Server: serverlist.ServerItem{Name: "secondary", Address: "localhost:11311"},
+	})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(dialog.ConfirmResultMsg{
+		Result:  true,
+		Context: app.RemoveServerContext{Name: "secondary"},
+	})
+	updated := newModel.(*app.Model)
+
+	if updated.Focus() != app.FocusServerManager {
+		t.Errorf("expected to remain on FocusServerManager, got %v", updated.Focus())
+	}
+	if updated.ServerList().ServerCount() != 1 {
+		t.Errorf("expected server list to refresh to 1 server, got %d", updated.ServerList().ServerCount())
+	}
+
+	servers, err := config.LoadServers()
+	if err != nil {
+		t.Fatalf("unexpected error loading servers: %v", err)
+	}
+	if len(servers.Servers) != 1 || servers.Servers[0].Name != "primary" {
+		t.Errorf("expected only primary to remain, got %+v", servers.Servers)
+	}
+}
+
+func TestModel_ServerManager_RemoveLastServer_Blocked(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	if err := config.SaveServers(&config.ServersConfig{
+		Servers: []config.ServerConfig{
+			{Name: "only", Address: "localhost:11211", Default: true},
+		},
+	}); err != nil {
+		t.Fatalf("failed to seed servers config: %v", err)
+	}
+
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	newModel, _ := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Manage servers"}})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(dialog.ConfirmResultMsg{
+		Result:  true,
+		Context: app.RemoveServerContext{Name: "only"},
+	})
+	updated := newModel.(*app.Model)
+
+	if updated.Error() == "" {
+		t.Error("expected an error when removing the last server")
+	}
+
+	servers, err := config.LoadServers()
+	if err != nil {
+		t.Fatalf("unexpected error loading servers: %v", err)
+	}
+	if len(servers.Servers) != 1 {
+		t.Errorf("expected the last server to remain, got %+v", servers.Servers)
+	}
+}
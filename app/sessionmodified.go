@@ -0,0 +1,17 @@
+package app
+
+// SessionModifiedKeys returns the set of keys created or edited this
+// session (for testing).
+func (m *Model) SessionModifiedKeys() map[string]bool {
+	return m.sessionModified
+}
+
+// markSessionModified records key as created or edited this session and
+// propagates the updated set to the key list for rendering.
+func (m *Model) markSessionModified(key string) {
+	if m.sessionModified == nil {
+		m.sessionModified = make(map[string]bool)
+	}
+	m.sessionModified[key] = true
+	m.keyList.SetSessionModified(m.sessionModified)
+}
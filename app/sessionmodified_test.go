@@ -0,0 +1,39 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+)
+
+func TestModel_SessionModified_EditFlagsKeyInRenderedList(t *testing.T) {
+	m := app.NewModelWithConfig("localhost:11211", config.DefaultConfig())
+	m.KeyList().SetSize(40, 20)
+	m.KeyList().SetKeys([]models.KeyInfo{{Key: "foo"}})
+
+	updated, _ := m.Update(app.KeyCreatedMsg{Key: "foo"})
+	m = updated.(*app.Model)
+
+	if !m.SessionModifiedKeys()["foo"] {
+		t.Fatal("expected foo to be recorded as session-modified")
+	}
+	if view := m.KeyList().View(); !strings.Contains(view, "●") {
+		t.Errorf("expected rendered key list to contain the session-modified marker, got:\n%s", view)
+	}
+}
+
+func TestModel_SessionModified_UntouchedKeyNotFlagged(t *testing.T) {
+	m := app.NewModelWithConfig("localhost:11211", config.DefaultConfig())
+	m.KeyList().SetSize(40, 20)
+	m.KeyList().SetKeys([]models.KeyInfo{{Key: "untouched"}})
+
+	if m.SessionModifiedKeys()["untouched"] {
+		t.Fatal("expected untouched key not to be session-modified")
+	}
+	if view := m.KeyList().View(); strings.Contains(view, "●") {
+		t.Errorf("expected no session-modified marker in rendered list, got:\n%s", view)
+	}
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+// SnapshotKeyContext carries the value being copied through the "snapshot to
+// new key" dialog. The new key name comes from the dialog's input; the
+// value and flags being written can't travel through an InputDialog's
+// context as cleanly as the small NewKeyContext/NewKeyValueContext structs
+// used by the regular new-key flow, so they're carried here instead.
+type SnapshotKeyContext struct {
+	Value []byte
+	Flags uint32
+}
+
+// defaultSnapshotKeyName returns the pre-filled key name offered by
+// CreateSnapshotKeyDialog: the original key with a ":snapshot:<unix-time>"
+// suffix, so repeated snapshots of the same key don't collide.
+func defaultSnapshotKeyName(origKey string, now time.Time) string {
+	return fmt.Sprintf("%s:snapshot:%d", origKey, now.Unix())
+}
+
+// CreateSnapshotKeyDialog creates the input dialog for the viewer's "copy
+// value to new key" quick action. The dialog only asks for a key name; the
+// currently displayed value and flags are written verbatim once the user
+// confirms.
+func CreateSnapshotKeyDialog(origKey string, value []byte, flags uint32, now time.Time) *dialog.InputDialog {
+	return dialog.NewInput("Snapshot Value To New Key").
+		WithPlaceholder("Enter key name...").
+		WithValue(defaultSnapshotKeyName(origKey, now)).
+		WithValidator(ValidateKeyName).
+		WithLiveValidation().
+		WithContext(SnapshotKeyContext{Value: value, Flags: flags})
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+func TestDefaultSnapshotKeyName(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	got := defaultSnapshotKeyName("session:abc", now)
+	want := "session:abc:snapshot:1700000000"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestCreateSnapshotKeyDialog_PrefillsDefaultName(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	dlg := CreateSnapshotKeyDialog("session:abc", []byte("value"), 7, now)
+
+	want := "session:abc:snapshot:1700000000"
+	if dlg.Value() != want {
+		t.Errorf("expected pre-filled value %q, got %q", want, dlg.Value())
+	}
+}
+
+func TestCreateSnapshotKeyDialog_SubmitCarriesValueAndFlags(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	dlg := CreateSnapshotKeyDialog("session:abc", []byte("hello"), 7, now)
+
+	_, cmd := dlg.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the pre-filled default name to submit without validation errors")
+	}
+
+	result, ok := cmd().(dialog.InputResultMsg)
+	if !ok {
+		t.Fatalf("expected InputResultMsg, got %T", cmd())
+	}
+	if result.Value != "session:abc:snapshot:1700000000" {
+		t.Errorf("expected the pre-filled name as the result value, got %q", result.Value)
+	}
+
+	snap, ok := result.Context.(SnapshotKeyContext)
+	if !ok {
+		t.Fatalf("expected SnapshotKeyContext, got %T", result.Context)
+	}
+	if string(snap.Value) != "hello" {
+		t.Errorf("expected value 'hello', got %q", snap.Value)
+	}
+	if snap.Flags != 7 {
+		t.Errorf("expected flags 7, got %d", snap.Flags)
+	}
+}
+
+func TestModel_HandleInputResult_SnapshotKey_CreatesKeyWithViewedValue(t *testing.T) {
+	mock := &mockMemcachedClient{}
+	m := &Model{mcClient: mock, cfg: config.DefaultConfig()}
+
+	_, cmd := m.handleInputResult(dialog.InputResultMsg{
+		Value: "session:abc:snapshot:1700000000",
+		Context: SnapshotKeyContext{
+			Value: []byte("the viewed value"),
+			Flags: 9,
+		},
+	})
+	if cmd == nil {
+		t.Fatal("expected a command to create the snapshot key")
+	}
+	cmd()
+
+	if mock.lastSetItem == nil {
+		t.Fatal("expected Set to be called")
+	}
+	if mock.lastSetItem.Key != "session:abc:snapshot:1700000000" {
+		t.Errorf("expected snapshot key name, got %q", mock.lastSetItem.Key)
+	}
+	if string(mock.lastSetItem.Value) != "the viewed value" {
+		t.Errorf("expected the viewed value to be written, got %q", mock.lastSetItem.Value)
+	}
+	if mock.lastSetItem.Flags != 9 {
+		t.Errorf("expected flags 9, got %d", mock.lastSetItem.Flags)
+	}
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/stats"
+)
+
+// StatsLoadedMsg is sent once a 'stats' fetch completes, successfully or not.
+type StatsLoadedMsg struct {
+	Stats *models.Stats
+	Err   error
+}
+
+// fetchStatsCmd runs the 'stats' command against addr and parses the result.
+func fetchStatsCmd(addr string, timeout time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		c, err := client.New(addr, client.WithTimeout(timeout))
+		if err != nil {
+			return StatsLoadedMsg{Err: err}
+		}
+		defer func() { _ = c.Close() }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		lines, err := c.RawCommand(ctx, "stats")
+		if err != nil {
+			return StatsLoadedMsg{Err: err}
+		}
+
+		parsed, err := models.ParseStatsResponse(strings.Join(lines, "\r\n"))
+		if err != nil {
+			return StatsLoadedMsg{Err: err}
+		}
+
+		return StatsLoadedMsg{Stats: parsed}
+	}
+}
+
+// openStats creates and shows the stats view, kicking off the initial fetch.
+func (m *Model) openStats() (tea.Model, tea.Cmd) {
+	m.statsView = stats.New()
+	m.statsView.SetSize(m.width, m.height)
+	m.focus = FocusStats
+	return m, fetchStatsCmd(m.addr, m.cfg.Timeouts.Capability)
+}
@@ -0,0 +1,101 @@
+package app_test
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/stats"
+)
+
+// newMockStatsFetchServer starts a server that answers the single "stats"
+// command, for driving the "Show stats" command end to end.
+func newMockStatsFetchServer(t *testing.T) net.Listener {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start mock server: %v", err)
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					if scanner.Text() == "stats" {
+						fmt.Fprintf(conn, "STAT pid 42\r\nSTAT curr_items 7\r\nEND\r\n")
+					}
+				}
+			}()
+		}
+	}()
+	t.Cleanup(func() { listener.Close() })
+
+	return listener
+}
+
+func TestModel_ShowStats_OpensStatsViewAndLoadsData(t *testing.T) {
+	listener := newMockStatsFetchServer(t)
+	m := app.NewModel(listener.Addr().String())
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Show stats"}})
+	m = newModel.(*app.Model)
+	if m.Focus() != app.FocusStats {
+		t.Fatalf("expected focus to move to FocusStats, got %v", m.Focus())
+	}
+	if cmd == nil {
+		t.Fatal("expected a command fetching stats")
+	}
+
+	msg := cmd()
+	loaded, ok := msg.(app.StatsLoadedMsg)
+	if !ok {
+		t.Fatalf("expected app.StatsLoadedMsg, got %T", msg)
+	}
+	if loaded.Err != nil {
+		t.Fatalf("unexpected error: %v", loaded.Err)
+	}
+	if loaded.Stats.PID != 42 {
+		t.Errorf("expected parsed PID 42, got %d", loaded.Stats.PID)
+	}
+	if loaded.Stats.CurrentItems != 7 {
+		t.Errorf("expected parsed curr_items 7, got %d", loaded.Stats.CurrentItems)
+	}
+
+	// Feeding the loaded stats back in should not change the focus or
+	// surface an error.
+	newModel, _ = m.Update(loaded)
+	m = newModel.(*app.Model)
+	if m.Focus() != app.FocusStats {
+		t.Errorf("expected focus to remain FocusStats after loading, got %v", m.Focus())
+	}
+	if m.Error() != "" {
+		t.Errorf("expected no error after a successful stats load, got %q", m.Error())
+	}
+}
+
+func TestModel_StatsView_CloseReturnsToKeyList(t *testing.T) {
+	listener := newMockStatsFetchServer(t)
+	m := app.NewModel(listener.Addr().String())
+
+	newModel, _ := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Show stats"}})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(stats.CloseMsg{})
+	m = newModel.(*app.Model)
+
+	if m.Focus() != app.FocusKeyList {
+		t.Errorf("expected focus to return to FocusKeyList after close, got %v", m.Focus())
+	}
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+// DumpStatsPathContext marks an InputDialog as asking for the destination
+// file path for the "Dump stats" command.
+type DumpStatsPathContext struct{}
+
+// StatsDumpMsg is sent once a stats dump has been written, or failed to be.
+type StatsDumpMsg struct {
+	Path string
+	Err  error
+}
+
+// CreateDumpStatsPathDialog creates an input dialog for entering the
+// destination file path for a stats snapshot.
+func CreateDumpStatsPathDialog() *dialog.InputDialog {
+	return dialog.NewInput("Dump Stats").
+		WithPlaceholder("Enter destination file path...").
+		WithValidator(func(path string) error {
+			if path == "" {
+				return errors.New("path cannot be empty")
+			}
+			return nil
+		}).
+		WithLiveValidation().
+		WithContext(DumpStatsPathContext{})
+}
+
+// dumpStatsCmd captures a stats snapshot (stats, stats items, stats slabs)
+// from addr and writes it to path.
+func dumpStatsCmd(addr, path string) tea.Cmd {
+	return func() tea.Msg {
+		report, err := client.NewStatsDumper(addr).Dump(context.Background())
+		if err != nil {
+			return StatsDumpMsg{Path: path, Err: err}
+		}
+
+		if err := os.WriteFile(path, []byte(report), 0o644); err != nil {
+			return StatsDumpMsg{Path: path, Err: err}
+		}
+
+		return StatsDumpMsg{Path: path}
+	}
+}
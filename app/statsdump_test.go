@@ -0,0 +1,136 @@
+package app_test
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/ui/components/command"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+// newMockStatsAppServer starts a server that answers "stats", "stats
+// items", and "stats slabs" with a single STAT line each, for driving the
+// "Dump stats" command end to end.
+func newMockStatsAppServer(t *testing.T) net.Listener {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start mock server: %v", err)
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					switch scanner.Text() {
+					case "stats":
+						fmt.Fprintf(conn, "STAT pid 42\r\nEND\r\n")
+					case "stats items":
+						fmt.Fprintf(conn, "STAT items:1:number 3\r\nEND\r\n")
+					case "stats slabs":
+						fmt.Fprintf(conn, "STAT 1:chunk_size 96\r\nEND\r\n")
+					default:
+						fmt.Fprintf(conn, "ERROR\r\n")
+					}
+				}
+			}()
+		}
+	}()
+	t.Cleanup(func() { listener.Close() })
+
+	return listener
+}
+
+func TestModel_DumpStats_WritesFileWithParsedSections(t *testing.T) {
+	listener := newMockStatsAppServer(t)
+	m := app.NewModel(listener.Addr().String())
+
+	newModel, cmd := m.Update(command.CommandExecuteMsg{Command: command.Command{Name: "Dump stats"}})
+	m = newModel.(*app.Model)
+	if cmd == nil {
+		t.Fatal("expected a command opening the dump-stats dialog")
+	}
+	cmd()
+
+	path := filepath.Join(t.TempDir(), "stats-dump.txt")
+	newModel, cmd = m.Update(dialog.InputResultMsg{Value: path, Context: app.DumpStatsPathContext{}})
+	m = newModel.(*app.Model)
+	if cmd == nil {
+		t.Fatal("expected a command to perform the stats dump")
+	}
+
+	msg := cmd()
+	dumpMsg, ok := msg.(app.StatsDumpMsg)
+	if !ok {
+		t.Fatalf("expected app.StatsDumpMsg, got %T", msg)
+	}
+	if dumpMsg.Err != nil {
+		t.Fatalf("unexpected error: %v", dumpMsg.Err)
+	}
+	if dumpMsg.Path != path {
+		t.Errorf("expected path %q, got %q", path, dumpMsg.Path)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read dump file: %v", err)
+	}
+
+	for _, want := range []string{
+		"=== stats ===", "STAT pid 42",
+		"=== stats items ===", "STAT items:1:number 3",
+		"=== stats slabs ===", "STAT 1:chunk_size 96",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("expected dump file to contain %q, got:\n%s", want, content)
+		}
+	}
+
+	newModel, _ = m.Update(dumpMsg)
+	m = newModel.(*app.Model)
+	if !strings.Contains(m.Error(), path) {
+		t.Errorf("expected success message to mention the dump path, got %q", m.Error())
+	}
+}
+
+func TestModel_DumpStats_WriteErrorIsReported(t *testing.T) {
+	listener := newMockStatsAppServer(t)
+	m := app.NewModel(listener.Addr().String())
+
+	// A path under a directory that doesn't exist, so the write fails.
+	badPath := filepath.Join(t.TempDir(), "no-such-dir", "stats-dump.txt")
+
+	newModel, cmd := m.Update(dialog.InputResultMsg{Value: badPath, Context: app.DumpStatsPathContext{}})
+	m = newModel.(*app.Model)
+	if cmd == nil {
+		t.Fatal("expected a command to perform the stats dump")
+	}
+
+	msg := cmd()
+	dumpMsg, ok := msg.(app.StatsDumpMsg)
+	if !ok {
+		t.Fatalf("expected app.StatsDumpMsg, got %T", msg)
+	}
+	if dumpMsg.Err == nil {
+		t.Fatal("expected a write error for a nonexistent directory")
+	}
+
+	newModel, _ = m.Update(dumpMsg)
+	m = newModel.(*app.Model)
+	if !strings.Contains(m.Error(), "failed to dump stats") {
+		t.Errorf("expected error message to report the dump failure, got %q", m.Error())
+	}
+}
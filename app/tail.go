@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/client"
+)
+
+// TailEntry records a single newly observed key while tail mode is active.
+type TailEntry struct {
+	Time time.Time
+	Key  string
+}
+
+// String renders a one-line summary of the entry for the tail feed.
+func (e TailEntry) String() string {
+	return fmt.Sprintf("%s + %s", e.Time.Format("15:04:05"), e.Key)
+}
+
+// TailTickMsg triggers the next poll while tail mode is active.
+type TailTickMsg struct{}
+
+// TailTickCmd schedules the next tail poll after interval.
+func TailTickCmd(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return TailTickMsg{}
+	})
+}
+
+// TailPollMsg carries the result of a single tail-mode key enumeration.
+type TailPollMsg struct {
+	Keys []string
+	Err  error
+}
+
+// DiffNewKeys returns the keys present in newKeys but not in oldKeys,
+// preserving newKeys' order.
+func DiffNewKeys(oldKeys, newKeys []string) []string {
+	seen := make(map[string]bool, len(oldKeys))
+	for _, k := range oldKeys {
+		seen[k] = true
+	}
+
+	var added []string
+	for _, k := range newKeys {
+		if !seen[k] {
+			added = append(added, k)
+		}
+	}
+	return added
+}
+
+// Tailing reports whether tail mode is currently active.
+func (m *Model) Tailing() bool {
+	return m.tailing
+}
+
+// TailLog returns the feed of newly observed keys accumulated since tail
+// mode started.
+func (m *Model) TailLog() []TailEntry {
+	return m.tailLog
+}
+
+// StartTail enters tail mode, resetting the feed. The returned command
+// performs the first poll and schedules the next tick.
+func (m *Model) StartTail() tea.Cmd {
+	m.tailing = true
+	m.tailKnownKeys = nil
+	m.tailLog = nil
+
+	return m.tailPollCmd()
+}
+
+// StopTail exits tail mode, leaving the feed in place for review.
+func (m *Model) StopTail() {
+	m.tailing = false
+}
+
+// tailPollCmd enumerates the current key names (lightweight, without
+// loading the full key metadata used by loadKeysCmd's main browsable list)
+// so tail mode can run independently of the regular auto-refresh flow.
+func (m *Model) tailPollCmd() tea.Cmd {
+	return func() tea.Msg {
+		addrs := nodeAddrs(m.addr)
+		ctx, cancel := context.WithTimeout(context.Background(), m.cfg.Timeouts.KeyEnumeration)
+		defer cancel()
+
+		if len(addrs) > 1 {
+			keys, _ := client.NewMultiNodeEnumerator(addrs).
+				WithConcurrency(m.cfg.Limits.MultiNodeConcurrency).
+				WithConfigure(func(e *client.KeyEnumerator) {
+					e.WithTimeout(m.cfg.Timeouts.KeyEnumeration).
+						WithLimit(m.cfg.Limits.MaxKeys).
+						WithPrefix(m.cfg.Limits.KeyPrefix)
+				}).
+				EnumerateAll(ctx)
+			names := make([]string, len(keys))
+			for i, k := range keys {
+				names[i] = k.Key
+			}
+			return TailPollMsg{Keys: names}
+		}
+
+		enum := client.NewKeyEnumerator(m.addr).
+			WithTimeout(m.cfg.Timeouts.KeyEnumeration).
+			WithLimit(m.cfg.Limits.MaxKeys).
+			WithPrefix(m.cfg.Limits.KeyPrefix)
+
+		keys, err := enum.EnumerateAll(ctx)
+		if err != nil {
+			return TailPollMsg{Err: err}
+		}
+		names := make([]string, len(keys))
+		for i, k := range keys {
+			names[i] = k.Key
+		}
+		return TailPollMsg{Keys: names}
+	}
+}
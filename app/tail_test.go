@@ -0,0 +1,96 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/memtui/app"
+)
+
+func TestDiffNewKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldKeys  []string
+		newKeys  []string
+		expected []string
+	}{
+		{
+			name:     "no changes",
+			oldKeys:  []string{"a", "b"},
+			newKeys:  []string{"a", "b"},
+			expected: nil,
+		},
+		{
+			name:     "one new key",
+			oldKeys:  []string{"a", "b"},
+			newKeys:  []string{"a", "b", "c"},
+			expected: []string{"c"},
+		},
+		{
+			name:     "several new keys preserve order",
+			oldKeys:  []string{"a"},
+			newKeys:  []string{"a", "c", "b"},
+			expected: []string{"c", "b"},
+		},
+		{
+			name:     "removed keys are not reported",
+			oldKeys:  []string{"a", "b"},
+			newKeys:  []string{"a"},
+			expected: nil,
+		},
+		{
+			name:     "first poll with no prior keys",
+			oldKeys:  nil,
+			newKeys:  []string{"a", "b"},
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := app.DiffNewKeys(tt.oldKeys, tt.newKeys)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("expected %v, got %v", tt.expected, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestTailTickCmd(t *testing.T) {
+	cmd := app.TailTickCmd(time.Millisecond)
+	msg := cmd()
+
+	if _, ok := msg.(app.TailTickMsg); !ok {
+		t.Fatalf("expected TailTickMsg, got %T", msg)
+	}
+}
+
+func TestModel_StartStopTail(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+
+	if m.Tailing() {
+		t.Fatal("expected Tailing() to be false before StartTail")
+	}
+
+	cmd := m.StartTail()
+	if cmd == nil {
+		t.Fatal("expected StartTail to return a non-nil command")
+	}
+	if !m.Tailing() {
+		t.Error("expected Tailing() to be true after StartTail")
+	}
+	if len(m.TailLog()) != 0 {
+		t.Errorf("expected empty tail log after StartTail, got %d entries", len(m.TailLog()))
+	}
+
+	m.StopTail()
+	if m.Tailing() {
+		t.Error("expected Tailing() to be false after StopTail")
+	}
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+)
+
+// Toucher is an interface for reading a value while resetting its TTL in a
+// single round trip ("gat"). This allows for easy mocking in tests.
+type Toucher interface {
+	GetAndTouch(key string, ttl int32) (*client.CASItem, error)
+}
+
+// TouchContext holds contextual information for a "get and touch" operation.
+// Used to pass the key between dialog confirmation and the command.
+type TouchContext struct {
+	Key string
+}
+
+// GetAndTouchCmd creates a tea.Cmd that reads a key's value and refreshes
+// its TTL in one round trip. Returns ValueLoadedMsg on success or ErrorMsg
+// on failure.
+func GetAndTouchCmd(c Toucher, key string, ttl int32) tea.Cmd {
+	return func() tea.Msg {
+		if c == nil {
+			return ErrorMsg{Err: "client not connected"}
+		}
+
+		casItem, err := c.GetAndTouch(key, ttl)
+		if err != nil {
+			return ErrorMsg{Err: fmt.Sprintf("failed to get and touch value: %v", err)}
+		}
+
+		return ValueLoadedMsg{
+			Key:        key,
+			Value:      casItem.Value,
+			Flags:      casItem.Flags,
+			Expiration: casItem.Expiration,
+			CAS:        casItem.CAS,
+		}
+	}
+}
+
+// ValidateTTL validates a TTL entered by the user for a "get and touch"
+// operation. Memcached TTLs are non-negative seconds (0 means no expiration).
+func ValidateTTL(value string) error {
+	ttl, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return fmt.Errorf("TTL must be a number")
+	}
+	if ttl < 0 {
+		return fmt.Errorf("TTL cannot be negative")
+	}
+	return nil
+}
+
+// CreateTouchDialog creates an input dialog for entering a new TTL to apply
+// while reading the given key's value.
+func CreateTouchDialog(key string) *dialog.InputDialog {
+	title := fmt.Sprintf("New TTL for: %s", key)
+	return dialog.NewInput(title).
+		WithPlaceholder("Enter TTL in seconds...").
+		WithValidator(ValidateTTL).
+		WithLiveValidation().
+		WithContext(TouchContext{Key: key})
+}
+
+// ExtractTouchContext extracts the key from a TouchContext.
+func ExtractTouchContext(ctx interface{}) (string, bool) {
+	tc, ok := ctx.(TouchContext)
+	if !ok {
+		return "", false
+	}
+	return tc.Key, true
+}
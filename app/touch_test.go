@@ -0,0 +1,139 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/client"
+)
+
+// mockToucher is a mock implementation for testing get-and-touch functionality
+type mockToucher struct {
+	item      *client.CASItem
+	err       error
+	lastTTL   int32
+	lastKey   string
+	callCount int
+}
+
+func (m *mockToucher) GetAndTouch(key string, ttl int32) (*client.CASItem, error) {
+	m.callCount++
+	m.lastKey = key
+	m.lastTTL = ttl
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.item, nil
+}
+
+func TestGetAndTouchCmd(t *testing.T) {
+	t.Run("returns value and extended TTL", func(t *testing.T) {
+		mock := &mockToucher{
+			item: &client.CASItem{
+				Key:        "test-key",
+				Value:      []byte("warmed value"),
+				Flags:      3,
+				Expiration: 600,
+				CAS:        1,
+			},
+		}
+
+		cmd := app.GetAndTouchCmd(mock, "test-key", 600)
+		msg := cmd()
+
+		loadedMsg, ok := msg.(app.ValueLoadedMsg)
+		if !ok {
+			t.Fatalf("expected ValueLoadedMsg, got %T", msg)
+		}
+
+		if string(loadedMsg.Value) != "warmed value" {
+			t.Errorf("expected value 'warmed value', got '%s'", string(loadedMsg.Value))
+		}
+		if loadedMsg.Expiration != 600 {
+			t.Errorf("expected refreshed TTL 600, got %d", loadedMsg.Expiration)
+		}
+		if mock.lastKey != "test-key" || mock.lastTTL != 600 {
+			t.Errorf("expected GetAndTouch called with ('test-key', 600), got ('%s', %d)", mock.lastKey, mock.lastTTL)
+		}
+	})
+
+	t.Run("returns error when client is nil", func(t *testing.T) {
+		cmd := app.GetAndTouchCmd(nil, "test-key", 60)
+		msg := cmd()
+
+		errMsg, ok := msg.(app.ErrorMsg)
+		if !ok {
+			t.Fatalf("expected ErrorMsg, got %T", msg)
+		}
+		if errMsg.Err != "client not connected" {
+			t.Errorf("expected 'client not connected', got '%s'", errMsg.Err)
+		}
+	})
+
+	t.Run("returns error when GetAndTouch fails", func(t *testing.T) {
+		mock := &mockToucher{err: errors.New("not found")}
+		cmd := app.GetAndTouchCmd(mock, "test-key", 60)
+		msg := cmd()
+
+		errMsg, ok := msg.(app.ErrorMsg)
+		if !ok {
+			t.Fatalf("expected ErrorMsg, got %T", msg)
+		}
+		if errMsg.Err == "" {
+			t.Error("expected non-empty error message")
+		}
+	})
+}
+
+func TestValidateTTL(t *testing.T) {
+	t.Run("accepts zero", func(t *testing.T) {
+		if err := app.ValidateTTL("0"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("accepts positive TTL", func(t *testing.T) {
+		if err := app.ValidateTTL("3600"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("rejects negative TTL", func(t *testing.T) {
+		if err := app.ValidateTTL("-1"); err == nil {
+			t.Error("expected error for negative TTL")
+		}
+	})
+
+	t.Run("rejects non-numeric input", func(t *testing.T) {
+		if err := app.ValidateTTL("soon"); err == nil {
+			t.Error("expected error for non-numeric TTL")
+		}
+	})
+}
+
+func TestCreateTouchDialog(t *testing.T) {
+	d := app.CreateTouchDialog("my-key")
+	if d == nil {
+		t.Fatal("expected non-nil dialog")
+	}
+}
+
+func TestExtractTouchContext(t *testing.T) {
+	t.Run("extracts key from TouchContext", func(t *testing.T) {
+		key, ok := app.ExtractTouchContext(app.TouchContext{Key: "my-key"})
+		if !ok {
+			t.Fatal("expected context to be extracted")
+		}
+		if key != "my-key" {
+			t.Errorf("expected key 'my-key', got '%s'", key)
+		}
+	})
+
+	t.Run("returns false for unrelated context", func(t *testing.T) {
+		_, ok := app.ExtractTouchContext("not a touch context")
+		if ok {
+			t.Error("expected extraction to fail for unrelated context")
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ttlCountdownInterval is how often the viewer header and key details panel
+// redraw to decrement a displayed TTL.
+const ttlCountdownInterval = time.Second
+
+// TTLCountdownTickMsg drives the live TTL countdown for the currently
+// viewed key. It carries no remaining-time state itself: the viewer header
+// and key details panel (see ui/components/viewer and FormatKeyDetails)
+// already compute TTL fresh from the key's absolute expiration timestamp,
+// so each tick just needs to trigger a redraw.
+type TTLCountdownTickMsg struct {
+	Key string
+}
+
+// TTLCountdownTickCmd schedules the next countdown tick for key after interval.
+func TTLCountdownTickCmd(key string, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return TTLCountdownTickMsg{Key: key}
+	})
+}
+
+// handleTTLCountdownTick redraws the live TTL countdown for msg.Key,
+// re-fetching the value to confirm expiry once the TTL reaches zero instead
+// of continuing to tick against a key that's now stale. Ticks for a key
+// that's no longer the one being viewed (e.g. the user switched keys) are
+// dropped, which lets the previous key's tick chain die out naturally.
+func (m *Model) handleTTLCountdownTick(msg TTLCountdownTickMsg) (tea.Model, tea.Cmd) {
+	if m.currentKey == nil || m.currentKey.Key != msg.Key || m.currentKey.Expiration == 0 {
+		return m, nil
+	}
+
+	if calculateRemainingTTL(m.currentKey.Expiration) <= 0 {
+		return m, ReloadValueCmd(m.mcClient, msg.Key)
+	}
+
+	return m, TTLCountdownTickCmd(msg.Key, ttlCountdownInterval)
+}
@@ -0,0 +1,96 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestTTLCountdownTickCmd_ReturnsTickMsgForKey(t *testing.T) {
+	cmd := app.TTLCountdownTickCmd("some-key", time.Millisecond)
+	msg := cmd()
+
+	tickMsg, ok := msg.(app.TTLCountdownTickMsg)
+	if !ok {
+		t.Fatalf("expected TTLCountdownTickMsg, got %T", msg)
+	}
+	if tickMsg.Key != "some-key" {
+		t.Errorf("expected key 'some-key', got %q", tickMsg.Key)
+	}
+}
+
+// modelWithExpiringKey selects a key with the given expiration and feeds it
+// the ValueLoadedMsg that would normally follow, without dialing a real
+// server (loadValueCmd itself is not exercised here).
+func modelWithExpiringKey(t *testing.T, expiration int64) *app.Model {
+	t.Helper()
+	m := app.NewModel("localhost:11211")
+	newModel, _ := m.Update(app.KeysLoadedMsg{Keys: []models.KeyInfo{
+		{Key: "session:1", Size: 10, Expiration: expiration},
+	}})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "session:1", Expiration: expiration}})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(app.ValueLoadedMsg{Key: "session:1", Value: []byte("v")})
+	return newModel.(*app.Model)
+}
+
+func TestModel_ValueLoaded_StartsTTLCountdownForExpiringKey(t *testing.T) {
+	m := modelWithExpiringKey(t, time.Now().Unix()+60)
+
+	_, cmd := m.Update(app.ValueLoadedMsg{Key: "session:1", Value: []byte("v")})
+	if cmd == nil {
+		t.Fatal("expected ValueLoadedMsg for a key with a TTL to schedule a countdown tick")
+	}
+	if _, ok := cmd().(app.TTLCountdownTickMsg); !ok {
+		t.Fatalf("expected a TTLCountdownTickMsg to be scheduled, got %T", cmd())
+	}
+}
+
+func TestModel_ValueLoaded_NoCountdownForPermanentKey(t *testing.T) {
+	m := modelWithExpiringKey(t, 0)
+
+	_, cmd := m.Update(app.ValueLoadedMsg{Key: "session:1", Value: []byte("v")})
+	if cmd != nil {
+		t.Errorf("expected no countdown command for a permanent key, got %T", cmd())
+	}
+}
+
+func TestModel_TTLCountdownTick_ReschedulesWhileTimeRemains(t *testing.T) {
+	m := modelWithExpiringKey(t, time.Now().Unix()+60)
+
+	_, cmd := m.Update(app.TTLCountdownTickMsg{Key: "session:1"})
+	if cmd == nil {
+		t.Fatal("expected another tick to be scheduled while TTL remains")
+	}
+	if _, ok := cmd().(app.TTLCountdownTickMsg); !ok {
+		t.Fatalf("expected TTLCountdownTickMsg, got %T", cmd())
+	}
+}
+
+func TestModel_TTLCountdownTick_ReloadsAtZeroInsteadOfRescheduling(t *testing.T) {
+	m := modelWithExpiringKey(t, time.Now().Unix()-1)
+
+	_, cmd := m.Update(app.TTLCountdownTickMsg{Key: "session:1"})
+	if cmd == nil {
+		t.Fatal("expected a reload command once TTL reaches zero")
+	}
+	msg := cmd()
+	if _, ok := msg.(app.TTLCountdownTickMsg); ok {
+		t.Error("expected expiry to trigger a reload, not another countdown tick")
+	}
+}
+
+func TestModel_TTLCountdownTick_IgnoredForStaleKey(t *testing.T) {
+	m := modelWithExpiringKey(t, time.Now().Unix()+60)
+
+	_, cmd := m.Update(app.TTLCountdownTickMsg{Key: "some-other-key"})
+	if cmd != nil {
+		t.Errorf("expected a tick for a key that's no longer being viewed to be dropped, got %T", cmd())
+	}
+}
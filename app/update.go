@@ -1,14 +1,23 @@
 package app
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/config"
 	"github.com/nnnkkk7/memtui/ui/components/command"
 	"github.com/nnnkkk7/memtui/ui/components/dialog"
 	"github.com/nnnkkk7/memtui/ui/components/editor"
 	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/quickswitch"
+	"github.com/nnnkkk7/memtui/ui/components/serverlist"
+	"github.com/nnnkkk7/memtui/ui/components/stats"
+	"github.com/nnnkkk7/memtui/ui/components/viewer"
 )
 
 // Update handles messages
@@ -21,17 +30,38 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		return m.handleKeyMsg(msg)
+		if m.idleDisconnected {
+			return m, m.ReconnectFromIdle()
+		}
+		model, cmd := m.handleKeyMsg(msg)
+		return model, tea.Batch(cmd, m.resetIdleTimerCmd())
+
+	case IdleTimeoutTickMsg:
+		return m.handleIdleTimeoutTick(msg)
+
+	case tea.MouseMsg:
+		switch m.focus {
+		case FocusKeyList:
+			var cmd tea.Cmd
+			m.keyList, cmd = m.keyList.Update(msg)
+			return m, cmd
+		case FocusViewer:
+			var cmd tea.Cmd
+			m.viewer, cmd = m.viewer.Update(msg)
+			return m, cmd
+		}
+		return m, nil
 
 	case ConnectedMsg:
 		m.state = StateConnected
 		m.version = msg.Version
 		m.supportsMetadump = msg.SupportsMetadump
 		// Initialize unified client (supports both basic and CAS operations)
-		mcClient, err := client.New(m.addr)
-		if err != nil {
+		mcClient, protoErr := client.NewWithConfiguredProtocol(
+			m.addr, m.protocol, client.WithTimeout(m.cfg.Timeouts.Connection))
+		if protoErr != nil && !errors.Is(protoErr, client.ErrBinaryProtocolUnsupported) {
 			return m, func() tea.Msg {
-				return ErrorMsg{Err: fmt.Sprintf("failed to create client: %v", err)}
+				return ErrorMsg{Err: fmt.Sprintf("failed to create client: %v", protoErr)}
 			}
 		}
 		m.mcClient = mcClient
@@ -39,18 +69,97 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Show warning if metadump not supported
 		if !m.supportsMetadump {
 			m.err = "Warning: Server does not support key enumeration (requires memcached >= 1.4.31). You can only work with known keys."
+		} else if errors.Is(protoErr, client.ErrBinaryProtocolUnsupported) {
+			m.err = "Warning: binary protocol requested but not supported; using text protocol. SASL and quiet ops are unavailable."
 		}
-		return m, m.loadKeysCmd()
+		return m, tea.Batch(m.loadKeysCmd(), loadRecentKeysCmd(m.addr), loadPinsCmd(m.addr))
+
+	case RecentKeysLoadedMsg:
+		m.recentKeys = msg.Keys
+		return m, nil
+
+	case RecentKeyRecordedMsg:
+		return m, nil
+
+	case RecentKeyRecordErrorMsg:
+		m.err = fmt.Sprintf("Failed to record recent key: %v", msg.Err)
+		return m, nil
+
+	case PinsLoadedMsg:
+		pinned := make(map[string]bool, len(msg.Keys))
+		for _, key := range msg.Keys {
+			pinned[key] = true
+		}
+		m.setPinned(pinned)
+		return m, nil
+
+	case PinToggledMsg:
+		pinned := make(map[string]bool, len(m.pinned)+1)
+		for key := range m.pinned {
+			pinned[key] = true
+		}
+		if msg.Pinned {
+			pinned[msg.Key] = true
+		} else {
+			delete(pinned, msg.Key)
+		}
+		m.setPinned(pinned)
+		return m, nil
+
+	case PinsPrunedMsg:
+		return m, nil
+
+	case PinErrorMsg:
+		m.err = fmt.Sprintf("Failed to update pinned keys: %v", msg.Err)
+		return m, nil
+
+	case MetadumpUnsupportedMsg:
+		m.supportsMetadump = false
+		m.keys = nil
+		m.keyList.SetKeys(nil)
+		m.state = StateReady
+		m.err = "Warning: this server rejected key enumeration (lru_crawler metadump unsupported, e.g. behind a proxy). Add known keys manually with 'n' to work with them."
+		return m, nil
 
 	case KeysLoadedMsg:
 		m.keys = msg.Keys
+		m.keysTruncated = msg.Truncated
+		m.keysPartial = msg.Partial
+		m.keysPartialReason = msg.PartialReason
+		m.failedNodes = msg.FailedNodes
 		m.keyList.SetKeys(m.keys)
 		m.state = StateReady
-		return m, nil
+		if len(msg.FailedNodes) > 0 {
+			m.err = fmt.Sprintf("Warning: %d node(s) unreachable during enumeration, showing partial results: %s", len(msg.FailedNodes), strings.Join(msg.FailedNodes, ", "))
+		}
+		return m, m.prunePinnedKeysCmd(m.keys)
 
 	case keylist.KeySelectedMsg:
 		m.currentKey = &msg.Key
-		return m, m.loadValueCmd(msg.Key.Key)
+		m.rememberRecentKey(msg.Key.Key)
+		return m, tea.Batch(m.loadValueCmd(msg.Key.Key), recordRecentKeyCmd(m.addr, msg.Key.Key))
+
+	case keylist.SelectAllConfirmMsg:
+		m.confirmDialog = CreateSelectAllConfirmDialog(msg.Count)
+		m.confirmDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, nil
+
+	case ValueSearchResultMsg:
+		m.applyValueSearchResult(msg)
+		return m, nil
+
+	case DiffKeysResultMsg:
+		if msg.Err != nil {
+			m.err = msg.Err.Error()
+		} else {
+			m.err = FormatKeyDiff(msg.KeyA, msg.KeyB, msg.ValueA, msg.ValueB)
+		}
+		return m, nil
+
+	case KeyFlagsLoadedMsg:
+		m.recordKeyFlags(msg.Key, msg.Flags)
+		return m, nil
 
 	case ValueLoadedMsg:
 		m.currentValue = msg.Value
@@ -68,7 +177,52 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.viewer.SetKeyInfo(*m.currentKey)
 		m.viewer.SetValue(msg.Value)
+		m.applyViewModeFor(msg.Key)
 		m.focus = FocusViewer
+
+		if m.watching && msg.Key == m.watchKey {
+			entry := DetectWatchChange(m.watchValue, m.watchCAS, msg.Value, msg.CAS, time.Now())
+			m.watchLog = append(m.watchLog, entry)
+			m.watchValue = msg.Value
+			m.watchCAS = msg.CAS
+			return m, WatchTickCmd(m.watchKey, m.cfg.Timeouts.Watch)
+		}
+		if m.currentKey != nil && m.currentKey.Expiration != 0 {
+			return m, TTLCountdownTickCmd(msg.Key, ttlCountdownInterval)
+		}
+		return m, nil
+
+	case WatchTickMsg:
+		if !m.watching || msg.Key != m.watchKey {
+			return m, nil
+		}
+		return m, ReloadValueCmd(m.mcClient, m.watchKey)
+
+	case TailTickMsg:
+		if !m.tailing {
+			return m, nil
+		}
+		return m, m.tailPollCmd()
+
+	case TailPollMsg:
+		if !m.tailing {
+			return m, nil
+		}
+		if msg.Err != nil {
+			m.err = fmt.Sprintf("tail poll failed: %v", msg.Err)
+			return m, TailTickCmd(m.cfg.Timeouts.Tail)
+		}
+		for _, key := range DiffNewKeys(m.tailKnownKeys, msg.Keys) {
+			m.tailLog = append(m.tailLog, TailEntry{Time: time.Now(), Key: key})
+		}
+		m.tailKnownKeys = msg.Keys
+		return m, TailTickCmd(m.cfg.Timeouts.Tail)
+
+	case TTLCountdownTickMsg:
+		return m.handleTTLCountdownTick(msg)
+
+	case FlashClearMsg:
+		m.flashLevel = FlashNone
 		return m, nil
 
 	case command.CommandExecuteMsg:
@@ -89,18 +243,48 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handleEditorSave(msg)
 
 	case editor.EditorCancelMsg:
+		if m.editor.IsDirty() {
+			m.stashDirtyBuffer(m.editor.Key(), []byte(m.editor.Value()), m.editor.CurrentFlags())
+		}
 		m.editor = nil
 		m.focus = FocusKeyList
 		return m, nil
 
+	case SaveAllBuffersResultMsg:
+		for _, key := range msg.Saved {
+			m.recordAudit("edited", key)
+		}
+		summary := HandleSaveAllBuffersResult(msg)
+		m.focus = FocusKeyList
+		var flashCmd tea.Cmd
+		if summary.HasErrors {
+			m.err = summary.String()
+			flashCmd = m.flash(FlashError)
+		} else {
+			m.err = ""
+			flashCmd = m.flash(FlashSuccess)
+		}
+		if summary.SavedCount > 0 {
+			return m, tea.Batch(flashCmd, m.loadKeysCmd())
+		}
+		return m, flashCmd
+
 	case KeyDeletedMsg:
+		m.recordAudit("deleted", msg.Key)
 		m.currentKey = nil
 		m.currentValue = nil
 		m.confirmDialog = nil
 		m.focus = FocusKeyList
-		return m, m.loadKeysCmd()
+		return m, tea.Batch(m.flash(FlashSuccess), m.loadKeysCmd())
+
+	case BatchDeletePreviewMsg:
+		m.err = FormatBatchDeletePreview(msg.Keys)
+		return m, nil
 
 	case BatchDeleteResultMsg:
+		for _, key := range msg.Deleted {
+			m.recordAudit("deleted", key)
+		}
 		summary := HandleBatchDeleteResult(msg)
 		m.currentKey = nil
 		m.currentValue = nil
@@ -113,23 +297,143 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case BatchExtendTTLResultMsg:
+		summary := HandleBatchExtendTTLResult(msg)
+		if summary.HasErrors {
+			m.err = summary.String()
+		}
+		if summary.ShouldRefresh {
+			return m, m.loadKeysCmd()
+		}
+		return m, nil
+
 	case DeleteErrorMsg:
-		m.err = msg.Err.Error()
+		if friendly := translateServerError(msg.Err, m.cfg.Limits.MaxItemSize); friendly != nil {
+			m.err = friendly.Error()
+		} else {
+			m.err = msg.Err.Error()
+		}
 		m.confirmDialog = nil
 		m.focus = FocusKeyList
-		return m, nil
+		return m, m.flash(FlashError)
 
 	case KeyCreatedMsg:
 		m.inputDialog = nil
 		m.focus = FocusKeyList
-		return m, m.loadKeysCmd()
+		m.markSessionModified(msg.Key)
+		return m, tea.Batch(m.flash(FlashSuccess), m.loadKeysCmd())
 
 	case NewKeyErrorMsg:
+		if friendly := translateServerError(msg.Err, m.cfg.Limits.MaxItemSize); friendly != nil {
+			m.err = friendly.Error()
+		} else {
+			m.err = msg.Err.Error()
+		}
+		m.inputDialog = nil
+		m.focus = FocusKeyList
+		return m, m.flash(FlashError)
+
+	case StatsDumpMsg:
+		if msg.Err != nil {
+			m.err = fmt.Sprintf("failed to dump stats: %v", msg.Err)
+			return m, m.flash(FlashError)
+		}
+		m.err = fmt.Sprintf("stats dumped to %s", msg.Path)
+		return m, m.flash(FlashSuccess)
+
+	case ServerAddedMsg:
+		m.inputDialog = nil
+		m.err = ""
+		if m.serverList != nil {
+			m.refreshServerManager()
+			m.focus = FocusServerManager
+			return m, nil
+		}
+		m.confirmDialog = CreateSwitchToServerConfirmDialog(msg.Name, msg.Address)
+		m.confirmDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, nil
+
+	case ServerAddErrorMsg:
 		m.err = msg.Err.Error()
 		m.inputDialog = nil
+		if m.serverList != nil {
+			m.focus = FocusServerManager
+		} else {
+			m.focus = FocusKeyList
+		}
+		return m, nil
+
+	case serverlist.ServerSelectedMsg:
+		m.serverList = nil
+		m.addr = msg.Server.Address
+		m.protocol = ""
+		m.state = StateConnecting
+		m.focus = FocusKeyList
+		return m, m.connectCmd()
+
+	case serverlist.AddServerRequestMsg:
+		m.inputDialog = CreateAddServerNameDialog()
+		m.inputDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, m.inputDialog.Init()
+
+	case serverlist.DeleteServerRequestMsg:
+		m.confirmDialog = CreateRemoveServerConfirmDialog(msg.Server.Name)
+		m.confirmDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, nil
+
+	case serverlist.SetDefaultRequestMsg:
+		if err := config.SetDefault(msg.Server.Name); err != nil {
+			m.err = err.Error()
+		} else {
+			m.err = ""
+		}
+		m.refreshServerManager()
+		m.focus = FocusServerManager
+		return m, nil
+
+	case serverlist.CloseServerListMsg:
+		m.serverList = nil
 		m.focus = FocusKeyList
 		return m, nil
 
+	case quickswitch.KeySelectedMsg:
+		m.quickSwitch = nil
+		m.focus = FocusKeyList
+		if m.keyList.SelectKey(msg.Key) {
+			if ki := m.keyList.SelectedKey(); ki != nil {
+				m.currentKey = ki
+				m.rememberRecentKey(ki.Key)
+				return m, tea.Batch(m.loadValueCmd(ki.Key), recordRecentKeyCmd(m.addr, ki.Key))
+			}
+		}
+		return m, nil
+
+	case quickswitch.CancelMsg:
+		m.quickSwitch = nil
+		m.focus = FocusKeyList
+		return m, nil
+
+	case stats.CloseMsg:
+		m.statsView = nil
+		m.focus = FocusKeyList
+		return m, nil
+
+	case stats.RefreshStatsMsg:
+		return m, fetchStatsCmd(m.addr, m.cfg.Timeouts.Capability)
+
+	case StatsLoadedMsg:
+		if msg.Err != nil {
+			m.err = fmt.Sprintf("failed to load stats: %v", msg.Err)
+			return m, nil
+		}
+		if m.statsView != nil {
+			m.statsView.SetStats(msg.Stats)
+		}
+		return m, nil
+
 	case ClipboardCopyMsg:
 		// Successfully copied to clipboard - clear any previous error
 		m.err = ""
@@ -142,6 +446,15 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ErrorMsg:
 		m.state = StateError
 		m.err = msg.Err
+		m.logger.Error(m.addr, "fatal", errors.New(msg.Err))
+		return m, nil
+
+	case viewer.PrefsChangedMsg:
+		m.cfg.Viewer.Wrap = msg.Wrap
+		m.cfg.Viewer.LineNumbers = msg.LineNumbers
+		m.cfg.Viewer.HexWidth = msg.HexWidth
+		m.cfg.Viewer.HexRuler = msg.HexRuler
+		_ = config.Save(m.cfg)
 		return m, nil
 	}
 
@@ -150,12 +463,42 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (m *Model) handleConfirmResult(msg dialog.ConfirmResultMsg) (tea.Model, tea.Cmd) {
 	m.confirmDialog = nil
-	m.focus = FocusKeyList
+	if m.serverList != nil {
+		m.focus = FocusServerManager
+	} else {
+		m.focus = FocusKeyList
+	}
 
 	if !msg.Result {
 		return m, nil
 	}
 
+	if _, ok := msg.Context.(SelectAllContext); ok {
+		m.keyList.SelectAll()
+		return m, nil
+	}
+
+	if searchCtx, ok := msg.Context.(ValueSearchConfirmContext); ok {
+		return m, m.fetchValuesCmd(searchCtx.Pattern)
+	}
+
+	if switchCtx, ok := msg.Context.(SwitchToServerContext); ok {
+		m.addr = switchCtx.Address
+		m.protocol = ""
+		m.state = StateConnecting
+		return m, m.connectCmd()
+	}
+
+	if removeCtx, ok := msg.Context.(RemoveServerContext); ok {
+		if err := config.RemoveServer(removeCtx.Name); err != nil {
+			m.err = err.Error()
+		} else {
+			m.err = ""
+		}
+		m.refreshServerManager()
+		return m, nil
+	}
+
 	// Extract key from context
 	key, ok := ExtractDeleteContext(msg.Context)
 	if !ok {
@@ -167,29 +510,129 @@ func (m *Model) handleConfirmResult(msg dialog.ConfirmResultMsg) (tea.Model, tea
 
 func (m *Model) handleInputResult(msg dialog.InputResultMsg) (tea.Model, tea.Cmd) {
 	m.inputDialog = nil
-	m.focus = FocusKeyList
+	if m.serverList != nil {
+		m.focus = FocusServerManager
+	} else {
+		m.focus = FocusKeyList
+	}
 
 	if msg.Canceled {
+		m.pendingEdit = nil
 		return m, nil
 	}
 
+	// Check if this is a protected-key delete confirmation
+	if delCtx, ok := msg.Context.(ProtectedDeleteContext); ok {
+		return m, DeleteKeyCmd(m.mcClient, delCtx.Key)
+	}
+
+	// Check if this is a protected-key edit confirmation
+	if _, ok := msg.Context.(ProtectedEditContext); ok {
+		pending := m.pendingEdit
+		m.pendingEdit = nil
+		if pending == nil {
+			return m, nil
+		}
+		return m.performEditorSave(pending.msg, pending.original)
+	}
+
+	// Check if this is the "copy value to new key" snapshot dialog
+	if snap, ok := msg.Context.(SnapshotKeyContext); ok {
+		m.recordAudit("created", msg.Value)
+		return m, NewKeyCmd(m.mcClient, NewKeyRequest{
+			Key:   msg.Value,
+			Value: string(snap.Value),
+			TTL:   m.cfg.Limits.DefaultTTL,
+			Flags: snap.Flags,
+		})
+	}
+
 	// Check if this is a batch delete confirmation
 	if batchMsg := ProcessBatchInputResult(msg); batchMsg != nil {
 		// Clear selection after initiating batch delete
 		m.keyList.ClearSelection()
-		return m, BatchDeleteCmd(m.mcClient, batchMsg.Keys)
+		return m, HandleBatchDeleteConfirm(m.mcClient, *batchMsg)
 	}
 
-	// Check if this is the second step (value input) of new key creation
-	if key, ok := ExtractNewKeyContext(msg.Context); ok {
-		// Create key with the entered value
+	// Check if this is the destination path for a "dump stats" request
+	if _, ok := msg.Context.(DumpStatsPathContext); ok {
+		if msg.Value == "" {
+			return m, nil
+		}
+		return m, dumpStatsCmd(m.addr, msg.Value)
+	}
+
+	// Check if this is a "go to key" exact-name entry
+	if _, ok := msg.Context.(GotoKeyContext); ok {
+		if msg.Value == "" {
+			return m, nil
+		}
+		return m, m.gotoKey(msg.Value)
+	}
+
+	// Check if this is a value search pattern entry
+	if _, ok := msg.Context.(ValueSearchContext); ok {
+		if msg.Value == "" {
+			return m, nil
+		}
+		return m, m.startValueSearch(msg.Value)
+	}
+
+	// Check if this is a "get and touch" TTL entry
+	if key, ok := ExtractTouchContext(msg.Context); ok {
+		ttl, err := strconv.ParseInt(msg.Value, 10, 32)
+		if err != nil {
+			return m, nil
+		}
+		return m, GetAndTouchCmd(m.mcClient, key, int32(ttl))
+	}
+
+	// Check if this is a batch TTL extension
+	if keys, ok := ExtractBatchExtendTTLContext(msg.Context); ok {
+		ttl, err := strconv.ParseInt(msg.Value, 10, 32)
+		if err != nil {
+			return m, nil
+		}
+		m.keyList.ClearSelection()
+		return m, BatchExtendTTLCmd(m.mcClient, keys, int32(ttl))
+	}
+
+	// Check if this is the third step (TTL input) of new key creation
+	if nv, ok := msg.Context.(NewKeyValueContext); ok {
+		ttl, err := strconv.ParseInt(msg.Value, 10, 32)
+		if err != nil {
+			return m, nil
+		}
+		m.recordAudit("created", nv.Key)
 		return m, NewKeyCmd(m.mcClient, NewKeyRequest{
-			Key:   key,
-			Value: msg.Value,
-			TTL:   0,
+			Key:   nv.Key,
+			Value: nv.Value,
+			TTL:   int32(ttl),
 		})
 	}
 
+	// Check if this is the second step (value input) of new key creation,
+	// now ask for a TTL, pre-filled with the configured default
+	if key, ok := ExtractNewKeyContext(msg.Context); ok {
+		m.inputDialog = CreateNewKeyTTLDialog(key, msg.Value, m.cfg.Limits.DefaultTTL)
+		m.inputDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, m.inputDialog.Init()
+	}
+
+	// Check if this is the second step (address input) of adding a server
+	if name, ok := ExtractAddServerAddressContext(msg.Context); ok {
+		return m, AddServerCmd(name, msg.Value)
+	}
+
+	// First step: server name entered, now ask for its address
+	if _, ok := msg.Context.(AddServerNameStep); ok {
+		m.inputDialog = CreateAddServerAddressDialog(msg.Value)
+		m.inputDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, m.inputDialog.Init()
+	}
+
 	// First step: key name entered, now ask for value
 	m.inputDialog = CreateValueInputDialog(msg.Value)
 	m.inputDialog.SetSize(m.width, m.height)
@@ -198,13 +641,39 @@ func (m *Model) handleInputResult(msg dialog.InputResultMsg) (tea.Model, tea.Cmd
 }
 
 func (m *Model) handleEditorSave(msg editor.EditorSaveMsg) (tea.Model, tea.Cmd) {
+	if violations := m.validateEditorSave(msg.Key, msg.Value); violations != "" {
+		m.err = violations
+		return m, nil
+	}
+
+	original := m.editor.OriginalValue()
 	m.editor = nil
 	m.focus = FocusKeyList
 
+	// Protected keys need an extra type-to-confirm step before the
+	// overwrite goes through; stash the pending save and ask for it first.
+	if m.isProtectedKey(msg.Key) {
+		m.pendingEdit = &pendingProtectedEdit{msg: msg, original: original}
+		m.inputDialog = CreateProtectedEditDialog(msg.Key)
+		m.inputDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return m, m.inputDialog.Init()
+	}
+
+	return m.performEditorSave(msg, original)
+}
+
+// performEditorSave does the actual save after any protected-key
+// confirmation (or immediately, for keys that don't need one).
+func (m *Model) performEditorSave(msg editor.EditorSaveMsg, original []byte) (tea.Model, tea.Cmd) {
+	m.err = summarizeDiff(original, msg.Value).String()
+	m.recordAudit("edited", msg.Key)
+
 	// Save the edited value with CAS if available (unified client supports CAS)
-	// Pass currentKey to preserve TTL (from metadump) and currentCASItem to preserve Flags
+	// Pass currentKey to preserve TTL (from metadump) and msg.Flags to apply
+	// the flags chosen in the editor (defaults to the previously loaded value)
 	if m.currentCASItem != nil && m.mcClient != nil {
-		return m, m.saveValueWithCASCmd(msg.Key, msg.Value, m.currentCASItem, m.currentKey)
+		return m, m.saveValueWithCASCmd(msg.Key, msg.Value, m.currentKey, msg.Flags)
 	}
-	return m, m.saveValueCmd(msg.Key, msg.Value, m.currentKey, m.currentCASItem)
+	return m, m.saveValueCmd(msg.Key, msg.Value, m.currentKey, m.currentCASItem, msg.Flags)
 }
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+// DefaultValueSearchConfirmThreshold is the number of not-yet-cached values
+// above which starting a value search requires confirmation, since it reads
+// every candidate key's value from the server.
+const DefaultValueSearchConfirmThreshold = 200
+
+// ValueFetcher is an interface for reading a key's raw value. This allows
+// for easy mocking in tests.
+type ValueFetcher interface {
+	Get(key string) (*memcache.Item, error)
+}
+
+// ValueSearchContext marks an InputDialog as asking for the substring to
+// search key values for.
+type ValueSearchContext struct{}
+
+// ValueSearchConfirmContext marks a ConfirmResultMsg as confirming a value
+// search that would fetch more than DefaultValueSearchConfirmThreshold
+// values from the server.
+type ValueSearchConfirmContext struct {
+	Pattern string
+}
+
+// ValueSearchResultMsg carries newly fetched values back to the app so they
+// can be merged into the value cache and applied as a filter.
+type ValueSearchResultMsg struct {
+	Pattern string
+	Values  map[string][]byte
+}
+
+// CreateValueSearchDialog creates an input dialog for entering the substring
+// to search key values for.
+func CreateValueSearchDialog() *dialog.InputDialog {
+	return dialog.NewInput("Search Values").
+		WithPlaceholder("Enter text to search for in values...").
+		WithContext(ValueSearchContext{})
+}
+
+// CreateValueSearchConfirmDialog creates a confirmation dialog shown before
+// a value search would fetch count values from the server.
+func CreateValueSearchConfirmDialog(pattern string, count int) *dialog.ConfirmDialog {
+	title := "Search Values"
+	message := fmt.Sprintf(
+		"This will fetch %d values from the server to search them.\n\nThis can be slow. Continue?",
+		count,
+	)
+	return dialog.NewWithContext(title, message, ValueSearchConfirmContext{Pattern: pattern})
+}
+
+// FetchValuesCmd fetches the value of every key in keys not already present
+// in cached, and returns the merged result as a ValueSearchResultMsg. Keys
+// that fail to fetch (e.g. evicted since enumeration) are simply omitted, so
+// they won't match the search.
+func FetchValuesCmd(c ValueFetcher, keys []string, cached map[string][]byte, pattern string) tea.Cmd {
+	return func() tea.Msg {
+		values := make(map[string][]byte, len(keys))
+		for k, v := range cached {
+			values[k] = v
+		}
+		if c != nil {
+			for _, key := range keys {
+				if _, ok := values[key]; ok {
+					continue
+				}
+				item, err := c.Get(key)
+				if err != nil {
+					continue
+				}
+				values[key] = item.Value
+			}
+		}
+		return ValueSearchResultMsg{Pattern: pattern, Values: values}
+	}
+}
+
+// startValueSearch begins a value search for pattern, prompting for
+// confirmation first when enough keys still need their value fetched from
+// the server.
+func (m *Model) startValueSearch(pattern string) tea.Cmd {
+	toFetch := m.keysNeedingValueFetch()
+	if len(toFetch) > DefaultValueSearchConfirmThreshold {
+		m.confirmDialog = CreateValueSearchConfirmDialog(pattern, len(toFetch))
+		m.confirmDialog.SetSize(m.width, m.height)
+		m.focus = FocusDialog
+		return nil
+	}
+	return m.fetchValuesCmd(pattern)
+}
+
+// keysNeedingValueFetch returns the keys not already present in the value
+// cache.
+func (m *Model) keysNeedingValueFetch() []string {
+	var keys []string
+	for _, ki := range m.keys {
+		if _, ok := m.valueCache[ki.Key]; !ok {
+			keys = append(keys, ki.Key)
+		}
+	}
+	return keys
+}
+
+// fetchValuesCmd dispatches FetchValuesCmd over every known key and marks
+// the search as in progress, so the UI can show a "reading from server"
+// indicator while it runs.
+func (m *Model) fetchValuesCmd(pattern string) tea.Cmd {
+	m.valueSearching = true
+	keys := make([]string, len(m.keys))
+	for i, ki := range m.keys {
+		keys[i] = ki.Key
+	}
+	return FetchValuesCmd(m.mcClient, keys, m.valueCache, pattern)
+}
+
+// clearValueCache empties the value cache built up by value searches, so the
+// next search re-fetches every key's value from the server instead of
+// serving a stale copy.
+func (m *Model) clearValueCache() {
+	m.valueCache = nil
+	m.valueSearchPattern = ""
+}
+
+// applyValueSearchResult merges newly fetched values into the cache and
+// switches the key list to FilterValue mode with the searched pattern.
+func (m *Model) applyValueSearchResult(msg ValueSearchResultMsg) {
+	m.valueSearching = false
+	m.valueSearchPattern = msg.Pattern
+	if m.valueCache == nil {
+		m.valueCache = make(map[string][]byte, len(msg.Values))
+	}
+	for k, v := range msg.Values {
+		m.valueCache[k] = v
+	}
+	m.keyList.SetValueLookup(func(key string) ([]byte, bool) {
+		v, ok := m.valueCache[key]
+		return v, ok
+	})
+	m.keyList.SetFilterMode(keylist.FilterValue)
+	m.keyList.SetFilter(msg.Pattern)
+	m.focus = FocusKeyList
+}
@@ -0,0 +1,218 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/nnnkkk7/memtui/client"
+	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/dialog"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+// mockValueFetcher implements client.MemcachedClient for testing value
+// search without a real server; only Get is exercised.
+type mockValueFetcher struct {
+	values map[string][]byte
+}
+
+func (f *mockValueFetcher) Get(key string) (*memcache.Item, error) {
+	v, ok := f.values[key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &memcache.Item{Key: key, Value: v}, nil
+}
+
+func (f *mockValueFetcher) GetWithCAS(key string) (*client.CASItem, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *mockValueFetcher) GetAndTouch(key string, ttl int32) (*client.CASItem, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *mockValueFetcher) Set(item *memcache.Item) error { return errors.New("not implemented") }
+
+func (f *mockValueFetcher) CompareAndSwap(item *client.CASItem) error {
+	return errors.New("not implemented")
+}
+
+func (f *mockValueFetcher) Delete(key string) error { return errors.New("not implemented") }
+
+func (f *mockValueFetcher) Close() error { return nil }
+
+func (f *mockValueFetcher) Address() string { return "localhost:11211" }
+
+func TestFetchValuesCmd_ReturnsInjectedValues(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{
+		"user:1": []byte(`{"email":"alice@example.com"}`),
+		"user:2": []byte(`{"email":"bob@example.com"}`),
+	}}
+
+	cmd := FetchValuesCmd(fetcher, []string{"user:1", "user:2"}, nil, "alice")
+	msg, ok := cmd().(ValueSearchResultMsg)
+	if !ok {
+		t.Fatalf("expected ValueSearchResultMsg, got %T", msg)
+	}
+	if string(msg.Values["user:1"]) != `{"email":"alice@example.com"}` {
+		t.Errorf("unexpected value for user:1: %s", msg.Values["user:1"])
+	}
+	if string(msg.Values["user:2"]) != `{"email":"bob@example.com"}` {
+		t.Errorf("unexpected value for user:2: %s", msg.Values["user:2"])
+	}
+}
+
+func TestFetchValuesCmd_MergesWithCacheAndSkipsCachedKeys(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{"user:1": []byte("fresh")}}
+	cached := map[string][]byte{"user:2": []byte("cached")}
+
+	cmd := FetchValuesCmd(fetcher, []string{"user:1", "user:2"}, cached, "x")
+	msg := cmd().(ValueSearchResultMsg)
+
+	if string(msg.Values["user:1"]) != "fresh" || string(msg.Values["user:2"]) != "cached" {
+		t.Errorf("expected merged cached+fetched values, got %#v", msg.Values)
+	}
+}
+
+func TestFetchValuesCmd_OmitsKeysThatFailToFetch(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{"user:1": []byte("ok")}}
+
+	cmd := FetchValuesCmd(fetcher, []string{"user:1", "user:missing"}, nil, "x")
+	msg := cmd().(ValueSearchResultMsg)
+
+	if _, ok := msg.Values["user:missing"]; ok {
+		t.Error("expected a key that failed to fetch to be omitted")
+	}
+	if _, ok := msg.Values["user:1"]; !ok {
+		t.Error("expected the successfully fetched key to be present")
+	}
+}
+
+func TestModel_ValueSearch_EndToEnd_MatchesValueNotName(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{
+		"user:1":  []byte(`{"email":"alice@example.com"}`),
+		"user:2":  []byte(`{"email":"bob@example.com"}`),
+		"other:3": []byte(`{"note":"alice mentioned here, but not an email match"}`),
+	}}
+
+	m := &Model{
+		mcClient: fetcher,
+		cfg:      config.DefaultConfig(),
+		keyList:  keylist.NewModel(),
+		keys: []models.KeyInfo{
+			{Key: "user:1", Size: 10},
+			{Key: "user:2", Size: 10},
+			{Key: "other:3", Size: 10},
+		},
+	}
+	m.keyList.SetKeys(m.keys)
+
+	// Entering the search pattern through the input dialog flow should
+	// dispatch a fetch without requiring confirmation (well under threshold).
+	newModel, cmd := m.Update(dialog.InputResultMsg{
+		Value:   "alice@example.com",
+		Context: ValueSearchContext{},
+	})
+	m = newModel.(*Model)
+	if cmd == nil {
+		t.Fatal("expected a command to fetch values")
+	}
+	if !m.valueSearching {
+		t.Error("expected valueSearching to be true while the fetch is in flight")
+	}
+
+	resultMsg := cmd()
+	newModel, _ = m.Update(resultMsg)
+	m = newModel.(*Model)
+
+	if m.valueSearching {
+		t.Error("expected valueSearching to clear once results arrive")
+	}
+	if m.keyList.FilterMode() != keylist.FilterValue {
+		t.Errorf("expected FilterValue mode, got %v", m.keyList.FilterMode())
+	}
+
+	filtered := m.keyList.FilteredKeys()
+	if len(filtered) != 1 || filtered[0].Key != "user:1" {
+		t.Errorf("expected only 'user:1' to match by value, got %+v", filtered)
+	}
+}
+
+func TestModel_ValueSearch_AboveThreshold_RequiresConfirmation(t *testing.T) {
+	keys := make([]models.KeyInfo, DefaultValueSearchConfirmThreshold+1)
+	for i := range keys {
+		keys[i] = models.KeyInfo{Key: "key", Size: 1}
+	}
+
+	m := &Model{
+		cfg:     config.DefaultConfig(),
+		keyList: keylist.NewModel(),
+		keys:    keys,
+	}
+	m.keyList.SetKeys(m.keys)
+
+	newModel, cmd := m.Update(dialog.InputResultMsg{
+		Value:   "pattern",
+		Context: ValueSearchContext{},
+	})
+	m = newModel.(*Model)
+
+	if cmd != nil {
+		t.Error("expected no immediate fetch command above the confirmation threshold")
+	}
+	if m.focus != FocusDialog || m.confirmDialog == nil {
+		t.Error("expected a confirmation dialog above the threshold")
+	}
+}
+
+func TestModel_ClearValueCache_NextFetchOmitsClearedKeys(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{"user:1": []byte("v1")}}
+	m := &Model{
+		mcClient:   fetcher,
+		cfg:        config.DefaultConfig(),
+		keyList:    keylist.NewModel(),
+		keys:       []models.KeyInfo{{Key: "user:1", Size: 10}},
+		valueCache: map[string][]byte{"user:1": []byte("stale")},
+	}
+	m.keyList.SetKeys(m.keys)
+
+	m.clearValueCache()
+
+	if m.valueCache != nil {
+		t.Fatalf("expected valueCache to be emptied, got %#v", m.valueCache)
+	}
+
+	cmd := m.fetchValuesCmd("v")
+	msg := cmd().(ValueSearchResultMsg)
+	if string(msg.Values["user:1"]) != "v1" {
+		t.Errorf("expected cleared key to be re-fetched from the server, got %q", msg.Values["user:1"])
+	}
+}
+
+func TestModel_ValueSearch_ConfirmDialog_ProceedsOnAccept(t *testing.T) {
+	fetcher := &mockValueFetcher{values: map[string][]byte{"user:1": []byte("match me")}}
+	m := &Model{
+		mcClient: fetcher,
+		cfg:      config.DefaultConfig(),
+		keyList:  keylist.NewModel(),
+		keys:     []models.KeyInfo{{Key: "user:1", Size: 10}},
+	}
+	m.keyList.SetKeys(m.keys)
+
+	newModel, cmd := m.Update(dialog.ConfirmResultMsg{
+		Result:  true,
+		Context: ValueSearchConfirmContext{Pattern: "match"},
+	})
+	m = newModel.(*Model)
+	if cmd == nil {
+		t.Fatal("expected a fetch command after confirming")
+	}
+
+	msg := cmd().(ValueSearchResultMsg)
+	if msg.Pattern != "match" {
+		t.Errorf("expected pattern to carry through confirmation, got %q", msg.Pattern)
+	}
+}
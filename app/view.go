@@ -4,6 +4,14 @@ import (
 	"fmt"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// Minimum terminal dimensions the two-pane layout can render without
+// garbling. Below this, View shows viewTooSmall instead.
+const (
+	minTerminalWidth  = 40
+	minTerminalHeight = 10
 )
 
 // View renders the UI
@@ -12,16 +20,36 @@ func (m *Model) View() string {
 		return "Initializing..."
 	}
 
+	if m.width < minTerminalWidth || m.height < minTerminalHeight {
+		return m.viewTooSmall()
+	}
+
+	if m.idleDisconnected {
+		return m.viewIdleDisconnected()
+	}
+
+	var content string
 	switch m.state {
 	case StateConnecting:
-		return m.viewConnecting()
+		content = m.viewConnecting()
 	case StateLoading:
-		return m.viewLoading()
+		content = m.viewLoading()
 	case StateError:
-		return m.viewError()
+		content = m.viewError()
 	default:
-		return m.viewMain()
+		content = m.viewMain()
 	}
+
+	if m.productionWarning {
+		banner := m.styles.ProductionBanner.Width(m.width).Render("⚠ PRODUCTION SERVER — changes are live")
+		return lipgloss.JoinVertical(lipgloss.Left, banner, content)
+	}
+	return content
+}
+
+func (m *Model) viewTooSmall() string {
+	msg := fmt.Sprintf("terminal too small (need at least %dx%d)", minTerminalWidth, minTerminalHeight)
+	return m.styles.Error.Render(msg)
 }
 
 func (m *Model) viewConnecting() string {
@@ -32,8 +60,12 @@ func (m *Model) viewLoading() string {
 	return m.styles.Connecting.Render("Loading keys...")
 }
 
+func (m *Model) viewIdleDisconnected() string {
+	return m.styles.Connecting.Render("disconnected due to inactivity — press any key to reconnect")
+}
+
 func (m *Model) viewError() string {
-	return m.styles.Error.Render(fmt.Sprintf("Error: %s\n\nPress 'q' to quit, 'r' to retry.", m.err))
+	return m.styles.Error.Render(fmt.Sprintf("Error: %s\n\nPress 'q' to quit, 'r' to retry.", FormatServerError(m.err, m.addr)))
 }
 
 func (m *Model) viewMain() string {
@@ -47,12 +79,35 @@ func (m *Model) viewMain() string {
 	if m.editor != nil {
 		return m.editor.View()
 	}
+	if m.serverList != nil {
+		return m.renderWithOverlay(m.serverList.View())
+	}
+	if m.quickSwitch != nil {
+		return m.renderWithOverlay(m.quickSwitch.View())
+	}
+	if m.statsView != nil {
+		return m.statsView.View()
+	}
 	if m.commandPalette.Visible() {
 		return m.renderWithOverlay(m.commandPalette.View())
 	}
 	if m.help.Visible() {
 		return m.help.View()
 	}
+	if m.focus == FocusKeyDetails {
+		flags, ok := m.currentKeyFlags()
+		var flagsPtr *uint32
+		if ok {
+			flagsPtr = &flags
+		}
+		node := m.addr
+		if m.currentKey != nil && m.currentKey.Node != "" {
+			node = m.currentKey.Node
+		}
+		details := FormatKeyDetails(m.currentKey, flagsPtr, node, m.showRawMetadumpLine)
+		panel := m.styles.KeyDetails.Render("Key Details\n\n" + details + "\n\ni/Esc: close, l: toggle raw metadump line")
+		return m.renderWithOverlay(panel)
+	}
 
 	// Main two-pane layout
 	keyListWidth := m.width * 30 / 100
@@ -128,11 +183,42 @@ func (m *Model) viewMain() string {
 	}
 
 	keyCount := len(m.keyList.FilteredKeys())
-	statusText := fmt.Sprintf(" %s | %d keys | %s%s ", m.addr, keyCount, m.version, filterStatus)
-	status := m.styles.StatusBar.Width(m.width).Render(statusText)
+	keyCountText := fmt.Sprintf("%d keys", keyCount)
+	if m.keysTruncated {
+		keyCountText = fmt.Sprintf("%s (showing first %d)", keyCountText, m.cfg.Limits.MaxKeys)
+	}
+	if m.keysPartial {
+		keyCountText = fmt.Sprintf("%s (partial: %s)", keyCountText, m.keysPartialReason)
+	}
+	watchStatus := ""
+	if m.watching {
+		watchStatus = fmt.Sprintf(" | Watching %s (%d polls)", m.watchKey, len(m.watchLog))
+	}
+	tailStatus := ""
+	if m.tailing {
+		tailStatus = fmt.Sprintf(" | Tailing (%d new)", len(m.tailLog))
+	}
+	valueSearchStatus := ""
+	if m.valueSearching {
+		valueSearchStatus = " | Searching values (reading from server)..."
+	} else if m.valueSearchPattern != "" {
+		valueSearchStatus = fmt.Sprintf(" | Value search: %q", m.valueSearchPattern)
+	}
+	statusText := fmt.Sprintf(" %s | %s | %s%s%s%s%s ", m.addr, keyCountText, m.version, filterStatus, watchStatus, tailStatus, valueSearchStatus)
+	if m.width > 0 {
+		statusText = models.TruncateWidth(statusText, m.width)
+	}
+	statusBarStyle := m.styles.StatusBar
+	switch m.flashLevel {
+	case FlashSuccess:
+		statusBarStyle = m.styles.StatusBarSuccess
+	case FlashError:
+		statusBarStyle = m.styles.StatusBarError
+	}
+	status := statusBarStyle.Width(m.width).Render(statusText)
 
 	// Help bar
-	helpText := "q:quit r:refresh /:filter d:delete e:edit n:new ?:help Tab/Esc:switch Ctrl+P:commands"
+	helpText := "q:quit r:refresh /:filter d:delete e:edit n:new W:watch K:tail Ctrl+A:select-all ?:help Tab/Esc:switch Ctrl+P:commands"
 	helpBar := m.styles.Help.Render(helpText)
 
 	return lipgloss.JoinVertical(lipgloss.Left, main, status, helpBar)
@@ -0,0 +1,22 @@
+package app
+
+import "github.com/nnnkkk7/memtui/ui/components/viewer"
+
+// recordViewMode stashes the viewer's current mode as key's override, so
+// switching away and back restores it instead of falling back to Auto.
+func (m *Model) recordViewMode(key string, mode viewer.ViewMode) {
+	if m.keyViewModes == nil {
+		m.keyViewModes = make(map[string]viewer.ViewMode)
+	}
+	m.keyViewModes[key] = mode
+}
+
+// applyViewModeFor sets the viewer to key's remembered mode, or Auto if key
+// has no override yet.
+func (m *Model) applyViewModeFor(key string) {
+	if mode, ok := m.keyViewModes[key]; ok {
+		m.viewer.SetViewMode(mode)
+		return
+	}
+	m.viewer.SetViewMode(viewer.ViewModeAuto)
+}
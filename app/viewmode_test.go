@@ -0,0 +1,43 @@
+package app_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+	"github.com/nnnkkk7/memtui/ui/components/viewer"
+)
+
+func TestModel_PerKeyViewModeOverride_StickiesAndDoesNotLeak(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	newModel, _ := m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "hexkey"}})
+	m = newModel.(*app.Model)
+	newModel, _ = m.Update(app.ValueLoadedMsg{Key: "hexkey", Value: []byte("hello")})
+	m = newModel.(*app.Model)
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	m = newModel.(*app.Model)
+	if m.Viewer().ViewMode() != viewer.ViewModeHex {
+		t.Fatalf("expected hexkey to be forced into hex mode, got %v", m.Viewer().ViewMode())
+	}
+
+	newModel, _ = m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "otherkey"}})
+	m = newModel.(*app.Model)
+	newModel, _ = m.Update(app.ValueLoadedMsg{Key: "otherkey", Value: []byte("world")})
+	m = newModel.(*app.Model)
+	if m.Viewer().ViewMode() != viewer.ViewModeAuto {
+		t.Fatalf("expected otherkey to stay on Auto, got %v", m.Viewer().ViewMode())
+	}
+
+	newModel, _ = m.Update(keylist.KeySelectedMsg{Key: models.KeyInfo{Key: "hexkey"}})
+	m = newModel.(*app.Model)
+	newModel, _ = m.Update(app.ValueLoadedMsg{Key: "hexkey", Value: []byte("hello")})
+	m = newModel.(*app.Model)
+	if m.Viewer().ViewMode() != viewer.ViewModeHex {
+		t.Fatalf("expected hexkey to reselect hex mode on return, got %v", m.Viewer().ViewMode())
+	}
+}
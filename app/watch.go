@@ -0,0 +1,79 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// WatchChangeEntry records the result of a single poll while watching a key.
+type WatchChangeEntry struct {
+	Time    time.Time
+	OldCAS  uint64
+	NewCAS  uint64
+	Changed bool // true if the value bytes or CAS differed from the previous poll
+}
+
+// String renders a one-line summary of the entry for the watch change log.
+func (e WatchChangeEntry) String() string {
+	if e.Changed {
+		return fmt.Sprintf("%s changed (CAS %d -> %d)", e.Time.Format("15:04:05"), e.OldCAS, e.NewCAS)
+	}
+	return fmt.Sprintf("%s no change (CAS %d)", e.Time.Format("15:04:05"), e.NewCAS)
+}
+
+// WatchTickMsg triggers the next poll while watching a key.
+type WatchTickMsg struct {
+	Key string
+}
+
+// WatchTickCmd schedules the next watch poll after interval.
+func WatchTickCmd(key string, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return WatchTickMsg{Key: key}
+	})
+}
+
+// DetectWatchChange compares a freshly polled value/CAS against the
+// previously observed ones and returns the resulting change log entry.
+func DetectWatchChange(oldValue []byte, oldCAS uint64, newValue []byte, newCAS uint64, now time.Time) WatchChangeEntry {
+	changed := newCAS != oldCAS || !bytes.Equal(oldValue, newValue)
+	return WatchChangeEntry{Time: now, OldCAS: oldCAS, NewCAS: newCAS, Changed: changed}
+}
+
+// Watching reports whether watch mode is currently active.
+func (m *Model) Watching() bool {
+	return m.watching
+}
+
+// WatchKey returns the key currently being watched, or "" if not watching.
+func (m *Model) WatchKey() string {
+	return m.watchKey
+}
+
+// WatchLog returns the change log accumulated since watch mode started.
+func (m *Model) WatchLog() []WatchChangeEntry {
+	return m.watchLog
+}
+
+// StartWatch enters watch mode for the given key, resetting the change log.
+// The returned command performs the first poll and schedules the next tick.
+func (m *Model) StartWatch(key string) tea.Cmd {
+	m.watching = true
+	m.watchKey = key
+	m.watchValue = nil
+	m.watchCAS = 0
+	m.watchLog = nil
+
+	return tea.Batch(
+		ReloadValueCmd(m.mcClient, key),
+		WatchTickCmd(key, m.cfg.Timeouts.Watch),
+	)
+}
+
+// StopWatch exits watch mode, leaving the change log in place for review.
+func (m *Model) StopWatch() {
+	m.watching = false
+}
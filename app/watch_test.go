@@ -0,0 +1,116 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/memtui/app"
+)
+
+func TestDetectWatchChange(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		oldValue []byte
+		oldCAS   uint64
+		newValue []byte
+		newCAS   uint64
+		expected bool
+	}{
+		{
+			name:     "unchanged value and CAS",
+			oldValue: []byte("hello"),
+			oldCAS:   1,
+			newValue: []byte("hello"),
+			newCAS:   1,
+			expected: false,
+		},
+		{
+			name:     "CAS changed but value identical",
+			oldValue: []byte("hello"),
+			oldCAS:   1,
+			newValue: []byte("hello"),
+			newCAS:   2,
+			expected: true,
+		},
+		{
+			name:     "value changed",
+			oldValue: []byte("hello"),
+			oldCAS:   1,
+			newValue: []byte("world"),
+			newCAS:   2,
+			expected: true,
+		},
+		{
+			name:     "first poll with no prior value",
+			oldValue: nil,
+			oldCAS:   0,
+			newValue: []byte("hello"),
+			newCAS:   1,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := app.DetectWatchChange(tt.oldValue, tt.oldCAS, tt.newValue, tt.newCAS, now)
+			if entry.Changed != tt.expected {
+				t.Errorf("expected Changed=%v, got %v", tt.expected, entry.Changed)
+			}
+			if entry.OldCAS != tt.oldCAS {
+				t.Errorf("expected OldCAS=%d, got %d", tt.oldCAS, entry.OldCAS)
+			}
+			if entry.NewCAS != tt.newCAS {
+				t.Errorf("expected NewCAS=%d, got %d", tt.newCAS, entry.NewCAS)
+			}
+			if !entry.Time.Equal(now) {
+				t.Errorf("expected Time=%v, got %v", now, entry.Time)
+			}
+		})
+	}
+}
+
+func TestWatchTickCmd(t *testing.T) {
+	cmd := app.WatchTickCmd("test-key", time.Millisecond)
+	msg := cmd()
+
+	tickMsg, ok := msg.(app.WatchTickMsg)
+	if !ok {
+		t.Fatalf("expected WatchTickMsg, got %T", msg)
+	}
+	if tickMsg.Key != "test-key" {
+		t.Errorf("expected key 'test-key', got '%s'", tickMsg.Key)
+	}
+}
+
+func TestModel_StartStopWatch(t *testing.T) {
+	m := app.NewModel("localhost:11211")
+
+	if m.Watching() {
+		t.Fatal("expected Watching() to be false before StartWatch")
+	}
+
+	cmd := m.StartWatch("some-key")
+	if cmd == nil {
+		t.Fatal("expected StartWatch to return a non-nil command")
+	}
+	if !m.Watching() {
+		t.Error("expected Watching() to be true after StartWatch")
+	}
+	if m.WatchKey() != "some-key" {
+		t.Errorf("expected WatchKey() 'some-key', got '%s'", m.WatchKey())
+	}
+	if len(m.WatchLog()) != 0 {
+		t.Errorf("expected empty watch log after StartWatch, got %d entries", len(m.WatchLog()))
+	}
+
+	m.StopWatch()
+	if m.Watching() {
+		t.Error("expected Watching() to be false after StopWatch")
+	}
+	// WatchKey is preserved so the last-watched key remains visible.
+	if m.WatchKey() != "some-key" {
+		t.Errorf("expected WatchKey() to remain 'some-key' after StopWatch, got '%s'", m.WatchKey())
+	}
+}
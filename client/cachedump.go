@@ -0,0 +1,166 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// slabItemCountRe matches a "stats items" line reporting the item count for
+// a slab class, e.g. "STAT items:3:number 42".
+var slabItemCountRe = regexp.MustCompile(`^STAT items:(\d+):number (\d+)$`)
+
+// CacheDumpEnumerator enumerates keys using "stats items" plus "stats
+// cachedump", for servers that reject lru_crawler metadump (see
+// IsMetadumpUnsupportedErr). It is a deprecated, best-effort fallback:
+// cachedump can miss items that are evicted or expire mid-dump and its
+// per-slab output is itself capped, so callers should always treat the
+// result as partial rather than a complete key listing.
+type CacheDumpEnumerator struct {
+	addr    string
+	timeout time.Duration
+}
+
+// NewCacheDumpEnumerator creates a new cachedump-based enumerator.
+func NewCacheDumpEnumerator(addr string) *CacheDumpEnumerator {
+	return &CacheDumpEnumerator{
+		addr:    addr,
+		timeout: 30 * time.Second,
+	}
+}
+
+// WithTimeout sets the timeout for each connection made during enumeration.
+func (e *CacheDumpEnumerator) WithTimeout(d time.Duration) *CacheDumpEnumerator {
+	e.timeout = d
+	return e
+}
+
+// EnumerateAll collects keys across every non-empty slab class by first
+// listing slab classes via "stats items", then dumping each one via "stats
+// cachedump". The returned keys should be treated as partial: see
+// CacheDumpEnumerator's doc comment for why.
+func (e *CacheDumpEnumerator) EnumerateAll(ctx context.Context) ([]models.KeyInfo, error) {
+	slabs, err := e.listSlabClasses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []models.KeyInfo
+	for _, slab := range slabs {
+		select {
+		case <-ctx.Done():
+			return keys, ctx.Err()
+		default:
+		}
+
+		slabKeys, err := e.dumpSlab(ctx, slab)
+		if err != nil {
+			return keys, err
+		}
+		keys = append(keys, slabKeys...)
+	}
+
+	return keys, nil
+}
+
+// dial opens a fresh connection with the deadline set from ctx and timeout,
+// mirroring the one-command-per-connection style used by KeyEnumerator and
+// CapabilityDetector.
+func (e *CacheDumpEnumerator) dial(ctx context.Context) (net.Conn, error) {
+	conn, err := net.DialTimeout("tcp", e.addr, e.timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	deadline := time.Now().Add(e.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	_ = conn.SetDeadline(deadline)
+	return conn, nil
+}
+
+// listSlabClasses queries "stats items" and returns the slab class ids that
+// currently hold items.
+func (e *CacheDumpEnumerator) listSlabClasses(ctx context.Context) ([]int, error) {
+	conn, err := e.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := fmt.Fprintf(conn, "stats items\r\n"); err != nil {
+		return nil, fmt.Errorf("failed to send stats items: %w", err)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	var slabs []int
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == memcachedEnd {
+			break
+		}
+		if strings.HasPrefix(line, "ERROR") {
+			return nil, fmt.Errorf("server error: %s", line)
+		}
+
+		m := slabItemCountRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		count, _ := strconv.Atoi(m[2])
+		if count <= 0 {
+			continue
+		}
+		slab, _ := strconv.Atoi(m[1])
+		slabs = append(slabs, slab)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stats items: %w", err)
+	}
+	return slabs, nil
+}
+
+// dumpSlab queries "stats cachedump <slab> 0" for a single slab class. A
+// limit of 0 asks the server for every item it's willing to report for that
+// class.
+func (e *CacheDumpEnumerator) dumpSlab(ctx context.Context, slab int) ([]models.KeyInfo, error) {
+	conn, err := e.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := fmt.Fprintf(conn, "stats cachedump %d 0\r\n", slab); err != nil {
+		return nil, fmt.Errorf("failed to send stats cachedump: %w", err)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	var keys []models.KeyInfo
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == memcachedEnd {
+			break
+		}
+		if strings.HasPrefix(line, "ERROR") {
+			return keys, fmt.Errorf("server error: %s", line)
+		}
+
+		ki, err := models.ParseCacheDumpLine(line, slab)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, ki)
+	}
+	if err := scanner.Err(); err != nil {
+		return keys, fmt.Errorf("failed to read stats cachedump: %w", err)
+	}
+	return keys, nil
+}
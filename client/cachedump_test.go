@@ -0,0 +1,135 @@
+package client_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/memtui/client"
+)
+
+// mockCacheDumpServer responds to "stats items" with item counts for a
+// handful of slab classes, and to "stats cachedump <slab> <limit>" with the
+// keys configured for that slab.
+type mockCacheDumpServer struct {
+	listener   net.Listener
+	closed     bool
+	keysBySlab map[int][]string
+}
+
+func newMockCacheDumpServer(t *testing.T, keysBySlab map[int][]string) *mockCacheDumpServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start mock server: %v", err)
+	}
+
+	server := &mockCacheDumpServer{listener: listener, keysBySlab: keysBySlab}
+	go server.serve()
+	return server
+}
+
+func (s *mockCacheDumpServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.closed {
+				return
+			}
+			continue
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *mockCacheDumpServer) handleConn(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+
+	for scanner.Scan() {
+		cmd := scanner.Text()
+		switch {
+		case cmd == "stats items":
+			for slab, keys := range s.keysBySlab {
+				fmt.Fprintf(conn, "STAT items:%d:number %d\r\n", slab, len(keys))
+			}
+			fmt.Fprintf(conn, "STAT items:99:number 0\r\n")
+			fmt.Fprintf(conn, "END\r\n")
+		case strings.HasPrefix(cmd, "stats cachedump "):
+			var slab, limit int
+			fmt.Sscanf(cmd, "stats cachedump %d %d", &slab, &limit)
+			for _, key := range s.keysBySlab[slab] {
+				fmt.Fprintf(conn, "ITEM %s [10 b; 0 s]\r\n", key)
+			}
+			fmt.Fprintf(conn, "END\r\n")
+		default:
+			fmt.Fprintf(conn, "ERROR\r\n")
+		}
+	}
+}
+
+func (s *mockCacheDumpServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *mockCacheDumpServer) Close() {
+	s.closed = true
+	s.listener.Close()
+}
+
+func TestCacheDumpEnumerator_MergesKeysAcrossSlabs(t *testing.T) {
+	server := newMockCacheDumpServer(t, map[int][]string{
+		1: {"user:1", "user:2"},
+		3: {"session:abc"},
+	})
+	defer server.Close()
+
+	enum := client.NewCacheDumpEnumerator(server.Addr()).WithTimeout(5 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	keys, err := enum.EnumerateAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 keys merged across slabs, got %d: %+v", len(keys), keys)
+	}
+
+	gotBySlab := map[int][]string{}
+	for _, ki := range keys {
+		gotBySlab[ki.SlabClass] = append(gotBySlab[ki.SlabClass], ki.Key)
+	}
+	if len(gotBySlab[1]) != 2 {
+		t.Errorf("expected 2 keys from slab 1, got %v", gotBySlab[1])
+	}
+	if len(gotBySlab[3]) != 1 || gotBySlab[3][0] != "session:abc" {
+		t.Errorf("expected 1 key from slab 3, got %v", gotBySlab[3])
+	}
+}
+
+func TestCacheDumpEnumerator_SkipsEmptySlabs(t *testing.T) {
+	server := newMockCacheDumpServer(t, map[int][]string{
+		1: {},
+		2: {"onlykey"},
+	})
+	defer server.Close()
+
+	enum := client.NewCacheDumpEnumerator(server.Addr()).WithTimeout(5 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	keys, err := enum.EnumerateAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0].Key != "onlykey" {
+		t.Fatalf("expected only the non-empty slab's key, got %+v", keys)
+	}
+}
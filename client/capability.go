@@ -37,6 +37,12 @@ func NewCapabilityDetector() *CapabilityDetector {
 	}
 }
 
+// WithTimeout sets the timeout for capability detection
+func (d *CapabilityDetector) WithTimeout(timeout time.Duration) *CapabilityDetector {
+	d.timeout = timeout
+	return d
+}
+
 // Detect connects to the server and detects its capabilities
 func (d *CapabilityDetector) Detect(addr string) (*Capability, error) {
 	conn, err := net.DialTimeout("tcp", addr, d.timeout)
@@ -7,6 +7,7 @@ import (
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/nnnkkk7/memtui/client"
 )
@@ -166,6 +167,40 @@ func TestCapabilityDetector_Verify_UnsupportedVersion(t *testing.T) {
 	}
 }
 
+func TestCapabilityDetector_WithTimeout(t *testing.T) {
+	// A server that accepts the connection but never responds should fail
+	// once the short configured timeout elapses, confirming WithTimeout is
+	// actually applied rather than the 5s default.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start listener: %v", err)
+	}
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// Never respond, forcing the detector to hit its deadline.
+		select {}
+	}()
+
+	detector := client.NewCapabilityDetector().WithTimeout(100 * time.Millisecond)
+
+	start := time.Now()
+	_, err = detector.Detect(listener.Addr().String())
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected error for unresponsive server")
+	}
+	if elapsed > 2*time.Second {
+		t.Errorf("expected detection to fail quickly with short timeout, took %v", elapsed)
+	}
+}
+
 func TestParseVersion(t *testing.T) {
 	tests := []struct {
 		version string
@@ -165,10 +165,13 @@ func (c *Client) CompareAndSwap(item *CASItem) error {
 			return NewCASConflictError(item.Key)
 		}
 		if errors.Is(err, memcache.ErrNotStored) {
-			// This can happen if the item was deleted or expired
-			return NewCASConflictError(item.Key)
+			// The item was deleted or evicted between GetWithCAS and this
+			// call, rather than modified - returned as-is (rather than
+			// wrapped in CASConflictError) so IsNotStored lets callers
+			// distinguish this from an actual conflict (IsExists).
+			return err
 		}
-		return err
+		return ParseProtocolError(err)
 	}
 
 	return nil
@@ -177,12 +180,12 @@ func (c *Client) CompareAndSwap(item *CASItem) error {
 // SetWithExpiration is a convenience method to set a key with expiration.
 // This is useful for setting up test data before CAS operations.
 func (c *Client) SetWithExpiration(key string, value []byte, flags uint32, expiration int32) error {
-	return c.mc.Set(&memcache.Item{
+	return ParseProtocolError(c.mc.Set(&memcache.Item{
 		Key:        key,
 		Value:      value,
 		Flags:      flags,
 		Expiration: expiration,
-	})
+	}))
 }
 
 // IsCacheMiss checks if the error is a cache miss error.
@@ -195,3 +198,20 @@ func IsCASConflict(err error) bool {
 	var casErr *CASConflictError
 	return errors.As(err, &casErr)
 }
+
+// IsNotStored checks if the error indicates a conditional write was
+// rejected because its condition wasn't met - e.g. the item was deleted or
+// evicted between GetWithCAS and CompareAndSwap. Distinct from IsExists:
+// this means the item is gone, not that it was concurrently modified.
+func IsNotStored(err error) bool {
+	return errors.Is(err, memcache.ErrNotStored)
+}
+
+// IsExists checks if the error is the server's EXISTS response to a failed
+// compare-and-swap, meaning the item still exists but was modified since it
+// was read. CompareAndSwap reports this as a *CASConflictError; use
+// IsCASConflict for that wrapped form, or IsExists to check the underlying
+// condition directly.
+func IsExists(err error) bool {
+	return errors.Is(err, memcache.ErrCASConflict)
+}
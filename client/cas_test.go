@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/bradfitz/gomemcache/memcache"
 	"github.com/nnnkkk7/memtui/client"
 )
 
@@ -88,6 +89,46 @@ func TestIsCacheMiss(t *testing.T) {
 	}
 }
 
+// TestIsNotStored tests the IsNotStored helper function
+func TestIsNotStored(t *testing.T) {
+	if !client.IsNotStored(memcache.ErrNotStored) {
+		t.Error("expected IsNotStored to return true for memcache.ErrNotStored")
+	}
+
+	otherErr := errors.New("some other error")
+	if client.IsNotStored(otherErr) {
+		t.Error("expected IsNotStored to return false for non-not-stored errors")
+	}
+
+	if client.IsNotStored(client.NewCASConflictError("test-key")) {
+		t.Error("expected IsNotStored to return false for a CAS conflict error")
+	}
+
+	if client.IsNotStored(nil) {
+		t.Error("expected IsNotStored to return false for nil")
+	}
+}
+
+// TestIsExists tests the IsExists helper function
+func TestIsExists(t *testing.T) {
+	if !client.IsExists(memcache.ErrCASConflict) {
+		t.Error("expected IsExists to return true for memcache.ErrCASConflict")
+	}
+
+	otherErr := errors.New("some other error")
+	if client.IsExists(otherErr) {
+		t.Error("expected IsExists to return false for non-exists errors")
+	}
+
+	if client.IsExists(memcache.ErrNotStored) {
+		t.Error("expected IsExists to return false for ErrNotStored")
+	}
+
+	if client.IsExists(nil) {
+		t.Error("expected IsExists to return false for nil")
+	}
+}
+
 // TestClient_GetWithCAS_NoServer tests GetWithCAS behavior when server is unavailable
 func TestClient_GetWithCAS_NoServer(t *testing.T) {
 	// Use a port that's unlikely to have a server
@@ -401,3 +442,67 @@ func TestClient_GetWithCAS_KeyNotFound_Integration(t *testing.T) {
 		t.Logf("got error (may be server unavailable): %v", err)
 	}
 }
+
+// TestClient_GetAndTouch_NoServer tests GetAndTouch behavior when server is unavailable
+func TestClient_GetAndTouch_NoServer(t *testing.T) {
+	c, err := client.New("localhost:59998")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	defer c.Close()
+
+	_, err = c.GetAndTouch("test-key", 60)
+	if err == nil {
+		t.Error("expected error when server is not available")
+	}
+}
+
+func TestClient_GetAndTouch_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	c, err := client.New("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	defer c.Close()
+
+	testKey := "gat-test-key"
+	testValue := []byte("gat-test-value")
+
+	err = c.SetWithExpiration(testKey, testValue, 0, 5)
+	if err != nil {
+		t.Skipf("skipping: Memcached server not available: %v", err)
+	}
+	defer c.Delete(testKey)
+
+	item, err := c.GetAndTouch(testKey, 600)
+	if err != nil {
+		t.Fatalf("GetAndTouch failed: %v", err)
+	}
+
+	if string(item.Value) != string(testValue) {
+		t.Errorf("expected value '%s', got '%s'", string(testValue), string(item.Value))
+	}
+	if item.Expiration != 600 {
+		t.Errorf("expected refreshed TTL 600, got %d", item.Expiration)
+	}
+}
+
+func TestClient_GetAndTouch_KeyNotFound_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	c, err := client.New("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	defer c.Close()
+
+	_, err = c.GetAndTouch("definitely-not-existing-key-xyz-123", 60)
+	if err == nil {
+		t.Error("expected cache miss error for non-existent key")
+	}
+}
@@ -12,9 +12,10 @@ import (
 
 // Client wraps gomemcache client with additional functionality
 type Client struct {
-	mc      *memcache.Client
-	addr    string
-	timeout time.Duration
+	mc       *memcache.Client
+	addr     string
+	timeout  time.Duration
+	protocol Protocol
 }
 
 // Option configures the client
@@ -34,6 +35,16 @@ func WithMaxIdleConns(n int) Option {
 	}
 }
 
+// WithProtocol sets the wire protocol the client reports itself as using.
+// It does not change how the client actually talks to the server: only
+// ProtocolText is implemented, so this only affects FeatureAvailable checks
+// and what Protocol() reports.
+func WithProtocol(p Protocol) Option {
+	return func(c *Client) {
+		c.protocol = p
+	}
+}
+
 // New creates a new Memcached client
 func New(addr string, opts ...Option) (*Client, error) {
 	// Validate address format
@@ -44,9 +55,10 @@ func New(addr string, opts ...Option) (*Client, error) {
 	mc := memcache.New(addr)
 
 	c := &Client{
-		mc:      mc,
-		addr:    addr,
-		timeout: 3 * time.Second, // default timeout
+		mc:       mc,
+		addr:     addr,
+		timeout:  3 * time.Second, // default timeout
+		protocol: ProtocolText,
 	}
 
 	for _, opt := range opts {
@@ -58,6 +70,37 @@ func New(addr string, opts ...Option) (*Client, error) {
 	return c, nil
 }
 
+// NewWithConfiguredProtocol creates a new Memcached client using the
+// protocol selector from config.ServerConfig.Protocol ("text", "binary", or
+// "auto"/""). If "binary" is requested, the client falls back to the text
+// protocol (the only one this client implements) and the returned error is
+// ErrBinaryProtocolUnsupported; the client is still usable.
+func NewWithConfiguredProtocol(addr, configuredProtocol string, opts ...Option) (*Client, error) {
+	protocol, protoErr := ResolveProtocol(configuredProtocol)
+	if protoErr != nil && !errors.Is(protoErr, ErrBinaryProtocolUnsupported) {
+		return nil, protoErr
+	}
+
+	c, err := New(addr, append(opts, WithProtocol(protocol))...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, protoErr
+}
+
+// Protocol returns the wire protocol this client is using.
+func (c *Client) Protocol() Protocol {
+	return c.protocol
+}
+
+// FeatureAvailable reports whether the named feature is available given
+// this client's active protocol, along with a hint to show the user when
+// it isn't. See FeatureAvailable (package-level) for the feature list.
+func (c *Client) FeatureAvailable(feature string) (bool, string) {
+	return FeatureAvailable(c.protocol, feature)
+}
+
 // isValidAddress checks if the address is in host:port format
 func isValidAddress(addr string) bool {
 	host, port, err := net.SplitHostPort(addr)
@@ -103,12 +146,31 @@ func (c *Client) Get(key string) (*memcache.Item, error) {
 
 // Set stores an item
 func (c *Client) Set(item *memcache.Item) error {
-	return c.mc.Set(item)
+	return ParseProtocolError(c.mc.Set(item))
 }
 
 // Delete removes an item by key
 func (c *Client) Delete(key string) error {
-	return c.mc.Delete(key)
+	return ParseProtocolError(c.mc.Delete(key))
+}
+
+// GetAndTouch retrieves an item by key and resets its expiration to the
+// given TTL in a single round trip ("gat"). This is useful for cache-warming
+// workflows that want to read a value and extend its lifetime at once.
+func (c *Client) GetAndTouch(key string, ttl int32) (*CASItem, error) {
+	item, err := c.mc.GetAndTouch(key, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CASItem{
+		Key:        item.Key,
+		Value:      item.Value,
+		Flags:      item.Flags,
+		Expiration: ttl,
+		CAS:        extractCASValue(item),
+		mcItem:     item,
+	}, nil
 }
 
 // Stats returns server statistics
@@ -14,6 +14,10 @@ type MemcachedClient interface {
 	// for optimistic locking
 	GetWithCAS(key string) (*CASItem, error)
 
+	// GetAndTouch retrieves an item by key and resets its expiration to the
+	// given TTL in a single round trip ("gat")
+	GetAndTouch(key string, ttl int32) (*CASItem, error)
+
 	// Set stores an item
 	Set(item *memcache.Item) error
 
@@ -5,7 +5,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/nnnkkk7/memtui/models"
@@ -14,10 +16,29 @@ import (
 // memcachedEnd is the terminator for Memcached responses
 const memcachedEnd = "END"
 
+// metadumpErrorPrefix is the raw Memcached response to an unrecognized
+// command, e.g. because a proxy or stripped-down build doesn't implement
+// "lru_crawler metadump" even though it reports a version that normally
+// would (see IsMetadumpUnsupportedErr).
+const metadumpErrorPrefix = "server error: ERROR"
+
+// IsMetadumpUnsupportedErr reports whether err came from the server
+// rejecting "lru_crawler metadump" as an unrecognized command, as opposed to
+// a connection/timeout failure. This can happen even on a version that
+// IsVersionSupported considers fine, e.g. behind a proxy that doesn't
+// implement lru_crawler.
+func IsMetadumpUnsupportedErr(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), metadumpErrorPrefix)
+}
+
 // KeyEnumerator enumerates keys from Memcached using lru_crawler metadump
 type KeyEnumerator struct {
-	addr    string
-	timeout time.Duration
+	addr          string
+	timeout       time.Duration
+	limit         int
+	prefix        string
+	truncated     bool
+	retainRawLine bool
 }
 
 // NewKeyEnumerator creates a new key enumerator
@@ -34,6 +55,38 @@ func (e *KeyEnumerator) WithTimeout(d time.Duration) *KeyEnumerator {
 	return e
 }
 
+// WithLimit caps the number of keys returned by enumeration. A value less
+// than or equal to 0 means unlimited. If the cache holds more matching keys
+// than the limit, enumeration stops early and Truncated reports true.
+func (e *KeyEnumerator) WithLimit(n int) *KeyEnumerator {
+	e.limit = n
+	return e
+}
+
+// WithPrefix restricts enumeration to keys starting with prefix. An empty
+// prefix matches every key. Filtering happens client-side, since
+// lru_crawler metadump has no server-side prefix option.
+func (e *KeyEnumerator) WithPrefix(prefix string) *KeyEnumerator {
+	e.prefix = prefix
+	return e
+}
+
+// WithRetainRawLine controls whether each returned KeyInfo keeps the raw
+// metadump line it was parsed from, for diagnosing parsing problems. It's
+// off by default to avoid retaining the raw text of every key in memory.
+func (e *KeyEnumerator) WithRetainRawLine(retain bool) *KeyEnumerator {
+	e.retainRawLine = retain
+	return e
+}
+
+// Truncated reports whether the limit set by WithLimit cut enumeration
+// short of the full (optionally prefix-filtered) key set. Only meaningful
+// after enumeration has completed, e.g. after EnumerateAll returns or after
+// the channels returned by EnumerateStream are closed.
+func (e *KeyEnumerator) Truncated() bool {
+	return e.truncated
+}
+
 // EnumerateAll collects all keys and returns them as a slice
 func (e *KeyEnumerator) EnumerateAll(ctx context.Context) ([]models.KeyInfo, error) {
 	keyChan, errChan := e.EnumerateStream(ctx)
@@ -76,6 +129,125 @@ func (e *KeyEnumerator) EnumerateStream(ctx context.Context) (<-chan models.KeyI
 	return keyChan, errChan
 }
 
+// MultiNodeEnumerator enumerates keys from multiple nodes, merging the
+// results and tagging each returned KeyInfo with the address of the node it
+// came from.
+type MultiNodeEnumerator struct {
+	addrs       []string
+	concurrency int
+	configure   func(*KeyEnumerator)
+	onProgress  func(addr string, completed, total int, keyCount int, err error)
+}
+
+// NewMultiNodeEnumerator creates a new multi-node enumerator for addrs.
+func NewMultiNodeEnumerator(addrs []string) *MultiNodeEnumerator {
+	return &MultiNodeEnumerator{addrs: addrs}
+}
+
+// WithConcurrency bounds how many nodes are enumerated at once. A value less
+// than or equal to 0 means unbounded, i.e. every node is enumerated
+// concurrently (default: 0).
+func (e *MultiNodeEnumerator) WithConcurrency(n int) *MultiNodeEnumerator {
+	e.concurrency = n
+	return e
+}
+
+// WithConfigure applies fn to every per-node KeyEnumerator before it runs,
+// e.g. to set a shared timeout, limit, or prefix.
+func (e *MultiNodeEnumerator) WithConfigure(fn func(*KeyEnumerator)) *MultiNodeEnumerator {
+	e.configure = fn
+	return e
+}
+
+// WithProgress registers a callback invoked as each node finishes
+// enumerating (successfully or not), reporting how many of the total nodes
+// have completed so far alongside the node's own result.
+func (e *MultiNodeEnumerator) WithProgress(fn func(addr string, completed, total int, keyCount int, err error)) *MultiNodeEnumerator {
+	e.onProgress = fn
+	return e
+}
+
+// EnumerateAll enumerates every node, bounded by WithConcurrency, and
+// returns the merged keys sorted by node and then key for deterministic
+// results regardless of completion order. A node that fails to enumerate
+// doesn't fail the whole call: its address is appended to failedNodes and
+// enumeration continues with the remaining nodes, so callers get a partial
+// result instead of nothing when one node in a multi-node deployment is
+// unreachable.
+func (e *MultiNodeEnumerator) EnumerateAll(ctx context.Context) (keys []models.KeyInfo, failedNodes []string) {
+	if len(e.addrs) == 0 {
+		return nil, nil
+	}
+
+	concurrency := e.concurrency
+	if concurrency <= 0 || concurrency > len(e.addrs) {
+		concurrency = len(e.addrs)
+	}
+
+	type nodeResult struct {
+		addr string
+		keys []models.KeyInfo
+		err  error
+	}
+
+	addrChan := make(chan string)
+	resultChan := make(chan nodeResult, len(e.addrs))
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for addr := range addrChan {
+				node := NewKeyEnumerator(addr)
+				if e.configure != nil {
+					e.configure(node)
+				}
+
+				nodeKeys, err := node.EnumerateAll(ctx)
+				for i := range nodeKeys {
+					nodeKeys[i].Node = addr
+				}
+				resultChan <- nodeResult{addr: addr, keys: nodeKeys, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(addrChan)
+		for _, addr := range e.addrs {
+			addrChan <- addr
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(resultChan)
+	}()
+
+	completed := 0
+	for res := range resultChan {
+		completed++
+		keys = append(keys, res.keys...)
+		if res.err != nil {
+			failedNodes = append(failedNodes, res.addr)
+		}
+		if e.onProgress != nil {
+			e.onProgress(res.addr, completed, len(e.addrs), len(res.keys), res.err)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Node != keys[j].Node {
+			return keys[i].Node < keys[j].Node
+		}
+		return keys[i].Key < keys[j].Key
+	})
+	sort.Strings(failedNodes)
+
+	return keys, failedNodes
+}
+
 // enumerate performs the actual enumeration
 func (e *KeyEnumerator) enumerate(ctx context.Context, keyChan chan<- models.KeyInfo) error {
 	conn, err := net.DialTimeout("tcp", e.addr, e.timeout)
@@ -99,6 +271,7 @@ func (e *KeyEnumerator) enumerate(ctx context.Context, keyChan chan<- models.Key
 
 	// Read and parse response
 	scanner := bufio.NewScanner(conn)
+	sent := 0
 	for scanner.Scan() {
 		// Check for cancellation
 		select {
@@ -124,9 +297,23 @@ func (e *KeyEnumerator) enumerate(ctx context.Context, keyChan chan<- models.Key
 			// Skip invalid lines
 			continue
 		}
+		if e.retainRawLine {
+			ki.RawLine = line
+		}
+
+		if e.prefix != "" && !strings.HasPrefix(ki.Key, e.prefix) {
+			continue
+		}
+
+		if e.limit > 0 && sent >= e.limit {
+			// A matching key remains beyond the limit - the result is partial.
+			e.truncated = true
+			break
+		}
 
 		select {
 		case keyChan <- ki:
+			sent++
 		case <-ctx.Done():
 			return ctx.Err()
 		}
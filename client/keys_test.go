@@ -5,7 +5,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,7 +22,7 @@ type mockMetadumpServer struct {
 	closed   bool
 }
 
-func newMockMetadumpServer(t *testing.T, keys []string) *mockMetadumpServer {
+func newMockMetadumpServer(t testing.TB, keys []string) *mockMetadumpServer {
 	t.Helper()
 
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
@@ -38,7 +40,7 @@ func newMockMetadumpServer(t *testing.T, keys []string) *mockMetadumpServer {
 	return server
 }
 
-func (s *mockMetadumpServer) serve(t *testing.T) {
+func (s *mockMetadumpServer) serve(t testing.TB) {
 	for {
 		if s.closed {
 			return
@@ -222,6 +224,362 @@ func TestKeyEnumerator_LargeKeySet(t *testing.T) {
 	}
 }
 
+func TestKeyEnumerator_WithLimit_Truncates(t *testing.T) {
+	keys := make([]string, 100)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key:%d", i)
+	}
+	server := newMockMetadumpServer(t, keys)
+	defer server.Close()
+
+	enum := client.NewKeyEnumerator(server.Addr()).WithLimit(10)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := enum.EnumerateAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 10 {
+		t.Errorf("expected 10 keys, got %d", len(result))
+	}
+	if !enum.Truncated() {
+		t.Error("expected Truncated() to be true when more keys remain past the limit")
+	}
+}
+
+func TestKeyEnumerator_WithLimit_NotTruncatedWhenExact(t *testing.T) {
+	expectedKeys := []string{"key1", "key2", "key3"}
+	server := newMockMetadumpServer(t, expectedKeys)
+	defer server.Close()
+
+	enum := client.NewKeyEnumerator(server.Addr()).WithLimit(len(expectedKeys))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := enum.EnumerateAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != len(expectedKeys) {
+		t.Errorf("expected %d keys, got %d", len(expectedKeys), len(result))
+	}
+	if enum.Truncated() {
+		t.Error("expected Truncated() to be false when the limit matches the full key count")
+	}
+}
+
+func TestKeyEnumerator_WithPrefix_Filters(t *testing.T) {
+	keys := []string{"session:1", "session:2", "cache:1", "user:1"}
+	server := newMockMetadumpServer(t, keys)
+	defer server.Close()
+
+	enum := client.NewKeyEnumerator(server.Addr()).WithPrefix("session:")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := enum.EnumerateAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Errorf("expected 2 keys matching prefix, got %d", len(result))
+	}
+	for _, ki := range result {
+		if !strings.HasPrefix(ki.Key, "session:") {
+			t.Errorf("expected key with prefix 'session:', got '%s'", ki.Key)
+		}
+	}
+}
+
+func TestKeyEnumerator_WithPrefixAndLimit(t *testing.T) {
+	keys := []string{"session:1", "session:2", "session:3", "cache:1"}
+	server := newMockMetadumpServer(t, keys)
+	defer server.Close()
+
+	enum := client.NewKeyEnumerator(server.Addr()).WithPrefix("session:").WithLimit(2)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := enum.EnumerateAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Errorf("expected 2 keys, got %d", len(result))
+	}
+	if !enum.Truncated() {
+		t.Error("expected Truncated() to be true when a third matching key remains")
+	}
+}
+
+func TestKeyEnumerator_WithRetainRawLine_RetainsRawLine(t *testing.T) {
+	keys := []string{"user:1"}
+	server := newMockMetadumpServer(t, keys)
+	defer server.Close()
+
+	enum := client.NewKeyEnumerator(server.Addr()).WithRetainRawLine(true)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := enum.EnumerateAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 key, got %d", len(result))
+	}
+	if result[0].RawLine == "" {
+		t.Error("expected RawLine to be retained")
+	}
+	if !strings.HasPrefix(result[0].RawLine, "key=user:1") {
+		t.Errorf("expected RawLine to contain the raw metadump line, got %q", result[0].RawLine)
+	}
+}
+
+func TestKeyEnumerator_WithoutRetainRawLine_OmitsRawLine(t *testing.T) {
+	keys := []string{"user:1"}
+	server := newMockMetadumpServer(t, keys)
+	defer server.Close()
+
+	enum := client.NewKeyEnumerator(server.Addr())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := enum.EnumerateAll(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 key, got %d", len(result))
+	}
+	if result[0].RawLine != "" {
+		t.Errorf("expected RawLine to be empty when retention isn't enabled, got %q", result[0].RawLine)
+	}
+}
+
+func TestEnumerateMultiNode_CollectsPartialResultsWhenOneNodeFails(t *testing.T) {
+	good1 := newMockMetadumpServer(t, []string{"a:1", "a:2"})
+	defer good1.Close()
+	good2 := newMockMetadumpServer(t, []string{"b:1"})
+	defer good2.Close()
+
+	// An address nothing is listening on, to simulate an unreachable node.
+	unreachableListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve an address: %v", err)
+	}
+	unreachableAddr := unreachableListener.Addr().String()
+	unreachableListener.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	keys, failedNodes := client.NewMultiNodeEnumerator([]string{good1.Addr(), unreachableAddr, good2.Addr()}).
+		WithConfigure(func(e *client.KeyEnumerator) {
+			e.WithTimeout(2 * time.Second)
+		}).
+		EnumerateAll(ctx)
+
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 keys from the reachable nodes, got %d", len(keys))
+	}
+	if len(failedNodes) != 1 || failedNodes[0] != unreachableAddr {
+		t.Errorf("expected failedNodes to contain only %q, got %v", unreachableAddr, failedNodes)
+	}
+
+	nodesByKey := make(map[string]string)
+	for _, ki := range keys {
+		nodesByKey[ki.Key] = ki.Node
+	}
+	if nodesByKey["a:1"] != good1.Addr() || nodesByKey["b:1"] != good2.Addr() {
+		t.Errorf("expected keys tagged with their originating node, got %v", nodesByKey)
+	}
+}
+
+func TestEnumerateMultiNode_AllReachable_NoFailedNodes(t *testing.T) {
+	server := newMockMetadumpServer(t, []string{"x:1"})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	keys, failedNodes := client.NewMultiNodeEnumerator([]string{server.Addr()}).EnumerateAll(ctx)
+
+	if len(keys) != 1 {
+		t.Fatalf("expected 1 key, got %d", len(keys))
+	}
+	if len(failedNodes) != 0 {
+		t.Errorf("expected no failed nodes, got %v", failedNodes)
+	}
+}
+
+func TestMultiNodeEnumerator_MergesAllNodesDeterministically(t *testing.T) {
+	nodeA := newMockMetadumpServer(t, []string{"b:1", "a:1"})
+	defer nodeA.Close()
+	nodeB := newMockMetadumpServer(t, []string{"d:1", "c:1"})
+	defer nodeB.Close()
+	nodeC := newMockMetadumpServer(t, []string{"e:1"})
+	defer nodeC.Close()
+
+	addrs := []string{nodeA.Addr(), nodeB.Addr(), nodeC.Addr()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	keys, failedNodes := client.NewMultiNodeEnumerator(addrs).WithConcurrency(2).EnumerateAll(ctx)
+
+	if len(failedNodes) != 0 {
+		t.Fatalf("expected no failed nodes, got %v", failedNodes)
+	}
+	if len(keys) != 5 {
+		t.Fatalf("expected 5 merged keys, got %d: %v", len(keys), keys)
+	}
+
+	for i := 1; i < len(keys); i++ {
+		prev, cur := keys[i-1], keys[i]
+		if prev.Node > cur.Node || (prev.Node == cur.Node && prev.Key > cur.Key) {
+			t.Fatalf("expected keys sorted by node then key, got %v before %v", prev, cur)
+		}
+	}
+}
+
+// newSlowMetadumpServer behaves like newMockMetadumpServer, but holds each
+// connection open for a short delay before responding, so tests can observe
+// how many requests are in flight at once.
+func newSlowMetadumpServer(t *testing.T, delay time.Duration, active, maxActive *int32, mu *sync.Mutex) *mockMetadumpServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start mock server: %v", err)
+	}
+
+	server := &mockMetadumpServer{listener: listener}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				if !scanner.Scan() {
+					return
+				}
+
+				mu.Lock()
+				*active++
+				if *active > *maxActive {
+					*maxActive = *active
+				}
+				mu.Unlock()
+
+				time.Sleep(delay)
+
+				mu.Lock()
+				*active--
+				mu.Unlock()
+
+				fmt.Fprintf(conn, "END\r\n")
+			}()
+		}
+	}()
+
+	return server
+}
+
+func TestMultiNodeEnumerator_ConcurrencyBoundsParallelism(t *testing.T) {
+	var mu sync.Mutex
+	var active, maxActive int32
+
+	const nodeCount = 4
+	addrs := make([]string, nodeCount)
+	for i := 0; i < nodeCount; i++ {
+		server := newSlowMetadumpServer(t, 50*time.Millisecond, &active, &maxActive, &mu)
+		defer server.Close()
+		addrs[i] = server.Addr()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client.NewMultiNodeEnumerator(addrs).WithConcurrency(2).EnumerateAll(ctx)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if maxActive > 2 {
+		t.Errorf("expected at most 2 nodes enumerated concurrently, observed %d", maxActive)
+	}
+	if maxActive < 2 {
+		t.Errorf("expected enumeration to actually run concurrently, observed max %d", maxActive)
+	}
+}
+
+func TestMultiNodeEnumerator_ReportsProgress(t *testing.T) {
+	nodeA := newMockMetadumpServer(t, []string{"a:1"})
+	defer nodeA.Close()
+	nodeB := newMockMetadumpServer(t, []string{"b:1"})
+	defer nodeB.Close()
+
+	var mu sync.Mutex
+	var completedCounts []int
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client.NewMultiNodeEnumerator([]string{nodeA.Addr(), nodeB.Addr()}).
+		WithProgress(func(addr string, completed, total int, keyCount int, err error) {
+			mu.Lock()
+			completedCounts = append(completedCounts, completed)
+			mu.Unlock()
+			if total != 2 {
+				t.Errorf("expected total of 2, got %d", total)
+			}
+		}).
+		EnumerateAll(ctx)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(completedCounts) != 2 {
+		t.Fatalf("expected 2 progress callbacks, got %d", len(completedCounts))
+	}
+	sort.Ints(completedCounts)
+	if completedCounts[0] != 1 || completedCounts[1] != 2 {
+		t.Errorf("expected completed counts {1, 2}, got %v", completedCounts)
+	}
+}
+
+func BenchmarkMultiNodeEnumerator_EnumerateAll(b *testing.B) {
+	const nodeCount = 8
+	const keysPerNode = 200
+
+	addrs := make([]string, nodeCount)
+	for i := 0; i < nodeCount; i++ {
+		keys := make([]string, keysPerNode)
+		for j := 0; j < keysPerNode; j++ {
+			keys[j] = fmt.Sprintf("node%d:key%d", i, j)
+		}
+		server := newMockMetadumpServer(b, keys)
+		defer server.Close()
+		addrs[i] = server.Addr()
+	}
+
+	ctx := context.Background()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = client.NewMultiNodeEnumerator(addrs).WithConcurrency(4).EnumerateAll(ctx)
+	}
+}
+
 func TestKeyEnumerator_EmptyResult(t *testing.T) {
 	server := newMockMetadumpServer(t, []string{})
 	defer server.Close()
@@ -239,3 +597,99 @@ func TestKeyEnumerator_EmptyResult(t *testing.T) {
 		t.Errorf("expected 0 keys, got %d", len(keys))
 	}
 }
+
+// mockNoMetadumpServer mimics a proxy or stripped-down build that reports a
+// normal version but rejects "lru_crawler metadump" as an unrecognized
+// command.
+type mockNoMetadumpServer struct {
+	listener net.Listener
+	closed   bool
+}
+
+func newMockNoMetadumpServer(t *testing.T) *mockNoMetadumpServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start mock server: %v", err)
+	}
+
+	server := &mockNoMetadumpServer{listener: listener}
+	go server.serve()
+	return server
+}
+
+func (s *mockNoMetadumpServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.closed {
+				return
+			}
+			continue
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *mockNoMetadumpServer) handleConn(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+
+	for scanner.Scan() {
+		cmd := scanner.Text()
+		switch {
+		case cmd == "stats":
+			fmt.Fprintf(conn, "STAT version 1.6.22\r\n")
+			fmt.Fprintf(conn, "END\r\n")
+		default:
+			fmt.Fprintf(conn, "ERROR\r\n")
+		}
+	}
+}
+
+func (s *mockNoMetadumpServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *mockNoMetadumpServer) Close() {
+	s.closed = true
+	s.listener.Close()
+}
+
+func TestKeyEnumerator_EnumerateAll_MetadumpUnsupportedByProxy(t *testing.T) {
+	server := newMockNoMetadumpServer(t)
+	defer server.Close()
+
+	enum := client.NewKeyEnumerator(server.Addr())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := enum.EnumerateAll(ctx)
+	if err == nil {
+		t.Fatal("expected an error when the server rejects lru_crawler metadump")
+	}
+	if !client.IsMetadumpUnsupportedErr(err) {
+		t.Errorf("expected IsMetadumpUnsupportedErr to recognize %q", err)
+	}
+}
+
+func TestIsMetadumpUnsupportedErr(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"metadump rejected by server", fmt.Errorf("server error: ERROR"), true},
+		{"nil error", nil, false},
+		{"connection refused", fmt.Errorf("failed to connect: connection refused"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := client.IsMetadumpUnsupportedErr(tt.err); got != tt.want {
+				t.Errorf("IsMetadumpUnsupportedErr(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Protocol identifies which Memcached wire protocol a client uses.
+type Protocol string
+
+// Supported protocol selectors, matching config.ServerConfig.Protocol.
+const (
+	// ProtocolAuto probes the server and picks the best available protocol.
+	// It currently always resolves to ProtocolText (see ResolveProtocol).
+	ProtocolAuto Protocol = "auto"
+	// ProtocolText uses the classic line-based text protocol. This is the
+	// only protocol gomemcache (and therefore this client) implements.
+	ProtocolText Protocol = "text"
+	// ProtocolBinary uses the binary protocol, needed for features like
+	// SASL authentication and quiet ("Q") opcodes. Not yet supported.
+	ProtocolBinary Protocol = "binary"
+)
+
+// ErrBinaryProtocolUnsupported is returned when the binary protocol is
+// requested, since the underlying gomemcache client only implements the
+// text protocol. Callers fall back to ProtocolText.
+var ErrBinaryProtocolUnsupported = errors.New(
+	"binary protocol is not yet supported by this client; falling back to the text protocol")
+
+// ResolveProtocol validates a configured protocol string (as set via
+// config.ServerConfig.Protocol) and resolves it to the Protocol this client
+// will actually use. An empty string or "auto" resolves to ProtocolText,
+// since that is the only protocol currently implemented. "binary" also
+// resolves to ProtocolText, but returns ErrBinaryProtocolUnsupported so
+// callers can surface a hint to the user.
+func ResolveProtocol(configured string) (Protocol, error) {
+	switch Protocol(configured) {
+	case "", ProtocolAuto, ProtocolText:
+		return ProtocolText, nil
+	case ProtocolBinary:
+		return ProtocolText, ErrBinaryProtocolUnsupported
+	default:
+		return "", fmt.Errorf("unknown protocol %q: expected text, binary, or auto", configured)
+	}
+}
+
+// binaryOnlyFeatures lists client features that require the binary
+// protocol. This client does not implement the binary protocol, so these
+// are always unavailable, regardless of the configured protocol.
+var binaryOnlyFeatures = map[string]bool{
+	"sasl":      true,
+	"quiet_ops": true,
+}
+
+// FeatureAvailable reports whether the named feature works under protocol,
+// along with a hint to show the user when it doesn't. Unknown feature names
+// are assumed not to be binary-only and report available.
+func FeatureAvailable(protocol Protocol, feature string) (bool, string) {
+	if !binaryOnlyFeatures[feature] {
+		return true, ""
+	}
+
+	return false, fmt.Sprintf(
+		"%s requires the binary protocol, which this client does not yet implement (active protocol: %s)",
+		feature, protocol)
+}
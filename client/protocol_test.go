@@ -0,0 +1,109 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/client"
+)
+
+func TestResolveProtocol(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  client.Protocol
+		expectErr bool
+	}{
+		{"empty defaults to text", "", client.ProtocolText, false},
+		{"auto resolves to text", "auto", client.ProtocolText, false},
+		{"text stays text", "text", client.ProtocolText, false},
+		{"binary falls back to text with error", "binary", client.ProtocolText, true},
+		{"unknown is an error", "quic", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.ResolveProtocol(tt.input)
+			if tt.expectErr && err == nil {
+				t.Error("expected an error, got nil")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected protocol %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	_, err := client.ResolveProtocol("binary")
+	if !errors.Is(err, client.ErrBinaryProtocolUnsupported) {
+		t.Errorf("expected ErrBinaryProtocolUnsupported, got %v", err)
+	}
+}
+
+func TestFeatureAvailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol client.Protocol
+		feature  string
+		expected bool
+	}{
+		{"sasl unavailable under text", client.ProtocolText, "sasl", false},
+		{"sasl unavailable under binary too", client.ProtocolBinary, "sasl", false},
+		{"quiet ops unavailable under text", client.ProtocolText, "quiet_ops", false},
+		{"unknown feature is available", client.ProtocolText, "get", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			available, hint := client.FeatureAvailable(tt.protocol, tt.feature)
+			if available != tt.expected {
+				t.Errorf("expected available=%v, got %v", tt.expected, available)
+			}
+			if !available && hint == "" {
+				t.Error("expected a non-empty hint for an unavailable feature")
+			}
+		})
+	}
+}
+
+func TestNewWithConfiguredProtocol(t *testing.T) {
+	t.Run("text protocol constructs a usable client", func(t *testing.T) {
+		c, err := client.NewWithConfiguredProtocol("localhost:11211", "text")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if c.Protocol() != client.ProtocolText {
+			t.Errorf("expected ProtocolText, got %v", c.Protocol())
+		}
+	})
+
+	t.Run("binary protocol falls back to text and reports the error", func(t *testing.T) {
+		c, err := client.NewWithConfiguredProtocol("localhost:11211", "binary")
+		if !errors.Is(err, client.ErrBinaryProtocolUnsupported) {
+			t.Fatalf("expected ErrBinaryProtocolUnsupported, got %v", err)
+		}
+		if c == nil {
+			t.Fatal("expected a non-nil client despite the fallback")
+		}
+		if c.Protocol() != client.ProtocolText {
+			t.Errorf("expected client to fall back to ProtocolText, got %v", c.Protocol())
+		}
+
+		available, hint := c.FeatureAvailable("sasl")
+		if available {
+			t.Error("expected sasl to be unavailable")
+		}
+		if hint == "" {
+			t.Error("expected a hint explaining why sasl is unavailable")
+		}
+	})
+
+	t.Run("unknown protocol is an error", func(t *testing.T) {
+		_, err := client.NewWithConfiguredProtocol("localhost:11211", "quic")
+		if err == nil {
+			t.Error("expected an error for an unknown protocol")
+		}
+	})
+}
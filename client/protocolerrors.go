@@ -0,0 +1,87 @@
+package client
+
+import (
+	"errors"
+	"strings"
+)
+
+// Typed protocol errors recovered from Memcached's CLIENT_ERROR and
+// SERVER_ERROR text responses. gomemcache surfaces these as a generic
+// "unexpected response line" error with the raw protocol line embedded in
+// it; ParseProtocolError recovers the specific case so callers can use
+// errors.Is instead of matching on message text.
+var (
+	// ErrValueTooLarge is returned when the server rejects a value for
+	// exceeding its configured max item size
+	// (SERVER_ERROR object too large for cache).
+	ErrValueTooLarge = errors.New("value exceeds the server's maximum item size")
+	// ErrOutOfMemory is returned when the server can't store any more items
+	// (SERVER_ERROR out of memory storing object).
+	ErrOutOfMemory = errors.New("server is out of memory")
+	// ErrBadCommand is returned when the client sent a malformed command
+	// (CLIENT_ERROR bad command line format, or any other CLIENT_ERROR).
+	ErrBadCommand = errors.New("malformed command sent to server")
+	// ErrServerError is a catch-all for SERVER_ERROR responses that don't
+	// match a more specific case above.
+	ErrServerError = errors.New("server error")
+	// ErrAuthFailed is returned when the server rejects authentication
+	// credentials. Not yet produced by any operation here, since this
+	// client only speaks the ASCII text protocol and SASL auth requires
+	// the binary protocol (see ErrBinaryProtocolUnsupported in
+	// protocol.go); the sentinel and IsAuthError exist so callers can
+	// already branch on it once binary-protocol SASL support lands.
+	ErrAuthFailed = errors.New("authentication failed")
+)
+
+// protocolError wraps one of the sentinel errors above together with the
+// raw server response, so callers that want the server's exact wording can
+// still get it from Error() while matching the category with errors.Is.
+type protocolError struct {
+	sentinel error
+	raw      string
+}
+
+func (e *protocolError) Error() string {
+	return e.sentinel.Error() + ": " + e.raw
+}
+
+func (e *protocolError) Unwrap() error {
+	return e.sentinel
+}
+
+// ParseProtocolError inspects err for an embedded CLIENT_ERROR/SERVER_ERROR
+// protocol response and, if recognized, returns a typed error wrapping one
+// of the sentinels above. Errors that don't come from a protocol-level
+// response are returned unchanged.
+func ParseProtocolError(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+
+	switch {
+	case strings.Contains(msg, "SERVER_ERROR") && strings.Contains(msg, "too large"):
+		return &protocolError{sentinel: ErrValueTooLarge, raw: msg}
+	case strings.Contains(msg, "SERVER_ERROR") && strings.Contains(msg, "out of memory"):
+		return &protocolError{sentinel: ErrOutOfMemory, raw: msg}
+	case strings.Contains(msg, "CLIENT_ERROR"):
+		return &protocolError{sentinel: ErrBadCommand, raw: msg}
+	case strings.Contains(msg, "SERVER_ERROR"):
+		return &protocolError{sentinel: ErrServerError, raw: msg}
+	default:
+		return err
+	}
+}
+
+// IsValueTooLarge reports whether err indicates the server rejected a value
+// for exceeding its configured maximum item size. Runs err through
+// ParseProtocolError first, so it also recognizes the raw gomemcache error.
+func IsValueTooLarge(err error) bool {
+	return errors.Is(ParseProtocolError(err), ErrValueTooLarge)
+}
+
+// IsAuthError reports whether err indicates the server rejected
+// authentication credentials. See ErrAuthFailed.
+func IsAuthError(err error) bool {
+	return errors.Is(ParseProtocolError(err), ErrAuthFailed)
+}
@@ -0,0 +1,130 @@
+package client_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/nnnkkk7/memtui/client"
+)
+
+// newProtocolErrorServer starts a mock server that, on the first connection,
+// reads a "set" command's two lines (command + value) and replies with the
+// given raw protocol response, simulating a CLIENT_ERROR/SERVER_ERROR.
+func newProtocolErrorServer(t *testing.T, response string) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start mock server: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		if _, err := reader.ReadString('\n'); err != nil {
+			return
+		}
+		if _, err := reader.ReadString('\n'); err != nil {
+			return
+		}
+		fmt.Fprint(conn, response)
+	}()
+
+	return listener.Addr().String()
+}
+
+func TestClient_Set_ClientErrorMapsToTypedError(t *testing.T) {
+	addr := newProtocolErrorServer(t, "CLIENT_ERROR bad command line format\r\n")
+
+	c, err := client.New(addr)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = c.Set(&memcache.Item{Key: "foo", Value: []byte("bar")})
+	if !errors.Is(err, client.ErrBadCommand) {
+		t.Fatalf("expected errors.Is(err, client.ErrBadCommand), got %v", err)
+	}
+	if !strings.Contains(err.Error(), "CLIENT_ERROR") {
+		t.Errorf("expected the friendly error to retain the server's wording, got %q", err)
+	}
+}
+
+func TestClient_Set_ServerErrorTooLargeMapsToTypedError(t *testing.T) {
+	addr := newProtocolErrorServer(t, "SERVER_ERROR object too large for cache\r\n")
+
+	c, err := client.New(addr)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = c.Set(&memcache.Item{Key: "foo", Value: []byte("bar")})
+	if !errors.Is(err, client.ErrValueTooLarge) {
+		t.Fatalf("expected errors.Is(err, client.ErrValueTooLarge), got %v", err)
+	}
+}
+
+func TestClient_Set_UnrecognizedServerErrorMapsToGenericServerError(t *testing.T) {
+	addr := newProtocolErrorServer(t, "SERVER_ERROR some other failure\r\n")
+
+	c, err := client.New(addr)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = c.Set(&memcache.Item{Key: "foo", Value: []byte("bar")})
+	if !errors.Is(err, client.ErrServerError) {
+		t.Fatalf("expected errors.Is(err, client.ErrServerError), got %v", err)
+	}
+}
+
+func TestParseProtocolError_NonProtocolErrorIsUnchanged(t *testing.T) {
+	original := errors.New("connection reset by peer")
+	if got := client.ParseProtocolError(original); got != original {
+		t.Errorf("expected a non-protocol error to pass through unchanged, got %v", got)
+	}
+}
+
+func TestIsValueTooLarge(t *testing.T) {
+	addr := newProtocolErrorServer(t, "SERVER_ERROR object too large for cache\r\n")
+	c, err := client.New(addr)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	err = c.Set(&memcache.Item{Key: "foo", Value: []byte("bar")})
+	if !client.IsValueTooLarge(err) {
+		t.Error("expected IsValueTooLarge to return true for an oversized-value error")
+	}
+
+	if client.IsValueTooLarge(errors.New("some other error")) {
+		t.Error("expected IsValueTooLarge to return false for an unrelated error")
+	}
+	if client.IsValueTooLarge(nil) {
+		t.Error("expected IsValueTooLarge to return false for nil")
+	}
+}
+
+func TestIsAuthError(t *testing.T) {
+	if !client.IsAuthError(client.ErrAuthFailed) {
+		t.Error("expected IsAuthError to return true for client.ErrAuthFailed")
+	}
+
+	if client.IsAuthError(errors.New("some other error")) {
+		t.Error("expected IsAuthError to return false for an unrelated error")
+	}
+	if client.IsAuthError(nil) {
+		t.Error("expected IsAuthError to return false for nil")
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// StatsDumper captures a full stats snapshot (stats, stats items, stats
+// slabs) for writing to a file, e.g. to attach to a ticket when diagnosing
+// an issue. Each command is sent over its own connection, mirroring the
+// one-command-per-connection style used by CapabilityDetector and
+// CacheDumpEnumerator.
+type StatsDumper struct {
+	addr    string
+	timeout time.Duration
+}
+
+// NewStatsDumper creates a new StatsDumper for addr.
+func NewStatsDumper(addr string) *StatsDumper {
+	return &StatsDumper{
+		addr:    addr,
+		timeout: 5 * time.Second,
+	}
+}
+
+// WithTimeout sets the timeout for each connection made while dumping.
+func (d *StatsDumper) WithTimeout(timeout time.Duration) *StatsDumper {
+	d.timeout = timeout
+	return d
+}
+
+// statsDumpCommands are the raw commands captured in a dump, in order.
+var statsDumpCommands = []string{"stats", "stats items", "stats slabs"}
+
+// Dump runs stats, stats items, and stats slabs against the server and
+// returns their combined raw output as a single text report, each section
+// introduced by a header naming the command it came from.
+func (d *StatsDumper) Dump(ctx context.Context) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "memtui stats dump for %s, captured %s\n", d.addr, time.Now().Format(time.RFC3339))
+
+	for _, cmd := range statsDumpCommands {
+		lines, err := d.run(ctx, cmd)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", cmd, err)
+		}
+
+		fmt.Fprintf(&b, "\n=== %s ===\n", cmd)
+		for _, line := range lines {
+			b.WriteString(line)
+			b.WriteByte('\n')
+		}
+	}
+
+	return b.String(), nil
+}
+
+// run sends cmd over a fresh connection and returns its response lines, up
+// to the terminating END line.
+func (d *StatsDumper) run(ctx context.Context, cmd string) ([]string, error) {
+	conn, err := net.DialTimeout("tcp", d.addr, d.timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	deadline := time.Now().Add(d.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	_ = conn.SetDeadline(deadline)
+
+	if _, err := fmt.Fprintf(conn, "%s\r\n", cmd); err != nil {
+		return nil, fmt.Errorf("failed to send %s: %w", cmd, err)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == memcachedEnd {
+			break
+		}
+		if strings.HasPrefix(line, "ERROR") {
+			return nil, fmt.Errorf("server returned: %s", line)
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,113 @@
+package client_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/memtui/client"
+)
+
+// mockStatsServer responds to "stats", "stats items", and "stats slabs"
+// with a fixed handful of STAT lines, and anything else with ERROR.
+type mockStatsServer struct {
+	listener net.Listener
+	closed   bool
+}
+
+func newMockStatsServer(t *testing.T) *mockStatsServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start mock server: %v", err)
+	}
+
+	server := &mockStatsServer{listener: listener}
+	go server.serve()
+	return server
+}
+
+func (s *mockStatsServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.closed {
+				return
+			}
+			continue
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *mockStatsServer) handleConn(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+
+	for scanner.Scan() {
+		cmd := scanner.Text()
+		switch cmd {
+		case "stats":
+			fmt.Fprintf(conn, "STAT pid 42\r\n")
+			fmt.Fprintf(conn, "STAT version 1.6.21\r\n")
+			fmt.Fprintf(conn, "END\r\n")
+		case "stats items":
+			fmt.Fprintf(conn, "STAT items:1:number 3\r\n")
+			fmt.Fprintf(conn, "END\r\n")
+		case "stats slabs":
+			fmt.Fprintf(conn, "STAT 1:chunk_size 96\r\n")
+			fmt.Fprintf(conn, "END\r\n")
+		default:
+			fmt.Fprintf(conn, "ERROR\r\n")
+		}
+	}
+}
+
+func (s *mockStatsServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *mockStatsServer) Close() {
+	s.closed = true
+	s.listener.Close()
+}
+
+func TestStatsDumper_Dump_IncludesAllThreeSections(t *testing.T) {
+	server := newMockStatsServer(t)
+	defer server.Close()
+
+	dumper := client.NewStatsDumper(server.Addr()).WithTimeout(5 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	report, err := dumper.Dump(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"=== stats ===", "STAT pid 42",
+		"=== stats items ===", "STAT items:1:number 3",
+		"=== stats slabs ===", "STAT 1:chunk_size 96",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+}
+
+func TestStatsDumper_Dump_ConnectionErrorIsReported(t *testing.T) {
+	// An address nothing is listening on.
+	dumper := client.NewStatsDumper("127.0.0.1:1").WithTimeout(500 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	if _, err := dumper.Dump(ctx); err == nil {
+		t.Fatal("expected an error when the server is unreachable")
+	}
+}
@@ -2,13 +2,19 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 
+	"github.com/bradfitz/gomemcache/memcache"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/nnnkkk7/memtui/app"
+	"github.com/nnnkkk7/memtui/client"
 	"github.com/nnnkkk7/memtui/config"
+	"github.com/nnnkkk7/memtui/logging"
+	"github.com/nnnkkk7/memtui/viewer"
 )
 
 // version is set by goreleaser via ldflags
@@ -22,9 +28,23 @@ func main() {
 }
 
 func run() error {
+	// Non-interactive subcommands for scripting
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "set":
+			return runSet(os.Args[2:])
+		case "delete":
+			return runDelete(os.Args[2:])
+		}
+	}
+
 	// CLI flags
 	addr := flag.String("addr", "", "Memcached server address (overrides config)")
 	showVersion := flag.Bool("version", false, "Show version")
+	getKey := flag.String("get", "", "Fetch the given key, print its formatted value to stdout, and exit (no TUI)")
+	format := flag.String("format", "auto", "Format for -get: json|hex|text|auto")
+	debug := flag.Bool("debug", false, "Enable structured debug logging to "+logging.Path())
+	noAltScreen := flag.Bool("no-alt-screen", false, "Keep TUI output in the terminal's normal scrollback instead of the alternate screen buffer")
 	flag.Parse()
 
 	if *showVersion {
@@ -44,9 +64,37 @@ func run() error {
 		serverAddr = *addr
 	}
 
+	if *getKey != "" {
+		return runGet(serverAddr, *getKey, *format)
+	}
+
 	// Create and run the TUI with config
 	m := app.NewModelWithConfig(serverAddr, cfg)
-	p := tea.NewProgram(m, tea.WithAltScreen())
+
+	// Apply the matching server's production tag and protocol selection
+	if servers, err := config.LoadServers(); err == nil {
+		for _, s := range servers.Servers {
+			if s.Address != serverAddr {
+				continue
+			}
+			if s.IsProduction() {
+				m.SetProductionWarning(true)
+			}
+			m.SetProtocol(s.Protocol)
+			break
+		}
+	}
+
+	if *debug || cfg.Logging.Debug {
+		logger, err := logging.New("")
+		if err != nil {
+			return fmt.Errorf("failed to enable debug logging: %w", err)
+		}
+		defer logger.Close()
+		m.SetLogger(logger)
+	}
+
+	p := tea.NewProgram(m, programOptions(*noAltScreen || cfg.UI.NoAltScreen)...)
 
 	if _, err := p.Run(); err != nil {
 		return fmt.Errorf("failed to run program: %w", err)
@@ -54,3 +102,166 @@ func run() error {
 
 	return nil
 }
+
+// programOptions builds the Bubble Tea program options for run(), gated by
+// whether the alternate screen buffer should be used. Disabling it (via the
+// --no-alt-screen flag or the UI.NoAltScreen config option) leaves memtui's
+// output in the terminal's normal scrollback after exit.
+func programOptions(noAltScreen bool) []tea.ProgramOption {
+	opts := []tea.ProgramOption{tea.WithMouseCellMotion()}
+	if !noAltScreen {
+		opts = append(opts, tea.WithAltScreen())
+	}
+	return opts
+}
+
+// runGet implements the -get flag: it fetches a single key and prints its
+// formatted value to stdout without starting the Bubble Tea program. This is
+// the non-interactive path used for scripting/pipelines.
+func runGet(addr, key, format string) error {
+	formatter, err := formatterFor(format)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(addr)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	item, err := c.Get(key)
+	if err != nil {
+		if errors.Is(err, memcache.ErrCacheMiss) {
+			return fmt.Errorf("key not found: %s", key)
+		}
+		return fmt.Errorf("failed to get key %q: %w", key, err)
+	}
+
+	out, err := formatter.Format(item.Value)
+	if err != nil {
+		return fmt.Errorf("failed to format value: %w", err)
+	}
+
+	fmt.Println(out)
+	return nil
+}
+
+// resolveAddr applies the usual config-then-flag-override precedence to
+// determine the Memcached server address for a non-interactive subcommand.
+func resolveAddr(override string) (string, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return "", fmt.Errorf("failed to load config: %w", err)
+	}
+
+	addr := cfg.Connection.DefaultAddress
+	if override != "" {
+		addr = override
+	}
+	return addr, nil
+}
+
+// runSet implements the "memtui set <key> [value]" subcommand, reusing
+// app.NewKeyCmd outside the TUI. If no value is given on the command line
+// (or it is "-"), the value is read from stdin; -file reads it from a file
+// instead, for large values.
+func runSet(args []string) error {
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+	addr := fs.String("addr", "", "Memcached server address (overrides config)")
+	file := fs.String("file", "", "Read the value from this file instead of the command line")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rest := fs.Args()
+	if len(rest) < 1 {
+		return errors.New("usage: memtui set <key> [value] [-file path]")
+	}
+	key := rest[0]
+
+	var value []byte
+	var err error
+	switch {
+	case *file != "":
+		value, err = os.ReadFile(*file)
+	case len(rest) >= 2 && rest[1] != "-":
+		value = []byte(rest[1])
+	default:
+		value, err = io.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read value: %w", err)
+	}
+
+	serverAddr, err := resolveAddr(*addr)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(serverAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer c.Close()
+
+	msg := app.NewKeyCmd(c, app.NewKeyRequest{Key: key, Value: string(value)})()
+	result := app.HandleNewKeyResult(msg)
+	if result.Error != "" {
+		return fmt.Errorf("failed to set key %q: %s", key, result.Error)
+	}
+
+	fmt.Printf("OK, set %q\n", key)
+	return nil
+}
+
+// runDelete implements the "memtui delete <key>" subcommand, reusing
+// app.DeleteKeyCmd outside the TUI.
+func runDelete(args []string) error {
+	fs := flag.NewFlagSet("delete", flag.ExitOnError)
+	addr := fs.String("addr", "", "Memcached server address (overrides config)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rest := fs.Args()
+	if len(rest) != 1 {
+		return errors.New("usage: memtui delete <key>")
+	}
+	key := rest[0]
+
+	serverAddr, err := resolveAddr(*addr)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(serverAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer c.Close()
+
+	msg := app.DeleteKeyCmd(c, key)()
+	result := app.HandleDeleteResult(msg)
+	if result.Error != "" {
+		return fmt.Errorf("failed to delete key %q: %s", key, result.Error)
+	}
+
+	fmt.Printf("OK, deleted %q\n", key)
+	return nil
+}
+
+// formatterFor resolves the -format flag to a viewer.Formatter.
+func formatterFor(format string) (viewer.Formatter, error) {
+	switch format {
+	case "json":
+		return viewer.NewJSONFormatter(), nil
+	case "hex":
+		return viewer.NewHexFormatter(), nil
+	case "text":
+		return viewer.NewTextFormatter(), nil
+	case "auto":
+		return viewer.NewAutoFormatter(), nil
+	default:
+		return nil, fmt.Errorf("unknown format %q: expected json, hex, text, or auto", format)
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/memtui/client"
+)
+
+func TestFormatterFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		wantErr bool
+	}{
+		{"json", "json", false},
+		{"hex", "hex", false},
+		{"text", "text", false},
+		{"auto", "auto", false},
+		{"unknown", "yaml", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := formatterFor(tt.format)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected an error for unknown format")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if f == nil {
+				t.Error("expected a non-nil formatter")
+			}
+		})
+	}
+}
+
+func TestProgramOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		noAltScreen bool
+		wantOptions int // tea.WithMouseCellMotion(), plus tea.WithAltScreen() unless disabled
+	}{
+		{"default uses alt screen", false, 2},
+		{"no-alt-screen disables it", true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := len(programOptions(tt.noAltScreen)); got != tt.wantOptions {
+				t.Errorf("expected %d program options, got %d", tt.wantOptions, got)
+			}
+		})
+	}
+}
+
+// captureStdout runs fn with os.Stdout redirected and returns what it wrote.
+func captureStdout(t *testing.T, fn func() error) (string, error) {
+	t.Helper()
+
+	orig := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+
+	fnErr := fn()
+
+	w.Close()
+	os.Stdout = orig
+
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	return buf.String(), fnErr
+}
+
+// Integration tests below require a running Memcached server at
+// localhost:11211. They are skipped in -short mode.
+
+func TestRunGet_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	c, err := client.New("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	defer c.Close()
+
+	testKey := "memtui-get-flag-test-key"
+	testValue := []byte(`{"hello":"world"}`)
+	if err := c.SetWithExpiration(testKey, testValue, 0, 60); err != nil {
+		t.Skipf("skipping: Memcached server not available: %v", err)
+	}
+	defer c.Delete(testKey)
+
+	out, err := captureStdout(t, func() error {
+		return runGet("localhost:11211", testKey, "json")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, `"hello": "world"`) {
+		t.Errorf("expected formatted JSON output, got: %q", out)
+	}
+}
+
+func TestRunSetAndDelete_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	c, err := client.New("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	defer c.Close()
+
+	testKey := "memtui-set-delete-subcommand-test-key"
+	if err := c.Delete(testKey); err != nil && !client.IsCacheMiss(err) {
+		t.Skipf("skipping: Memcached server not available: %v", err)
+	}
+	defer c.Delete(testKey)
+
+	if _, err := captureStdout(t, func() error {
+		return runSet([]string{testKey, "hello-world"})
+	}); err != nil {
+		t.Fatalf("runSet returned an error: %v", err)
+	}
+
+	item, err := c.Get(testKey)
+	if err != nil {
+		t.Fatalf("expected key to be set, Get failed: %v", err)
+	}
+	if string(item.Value) != "hello-world" {
+		t.Errorf("expected value %q, got %q", "hello-world", item.Value)
+	}
+
+	if _, err := captureStdout(t, func() error {
+		return runDelete([]string{testKey})
+	}); err != nil {
+		t.Fatalf("runDelete returned an error: %v", err)
+	}
+
+	if _, err := c.Get(testKey); !client.IsCacheMiss(err) {
+		t.Errorf("expected key to be deleted, got err=%v", err)
+	}
+}
+
+func TestRunDelete_MissingKey_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	c, err := client.New("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	defer c.Close()
+	if err := c.Ping(context.Background()); err != nil {
+		t.Skipf("skipping: Memcached server not available: %v", err)
+	}
+
+	_, err = captureStdout(t, func() error {
+		return runDelete([]string{"memtui-definitely-missing-key-for-delete-test"})
+	})
+	if err == nil {
+		t.Error("expected an error deleting a key that doesn't exist")
+	}
+}
+
+func TestRunGet_CacheMiss_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	c, err := client.New("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	defer c.Close()
+	if _, err := c.Get("__memtui-get-flag-missing-key__"); err == nil {
+		t.Skip("skipping: key unexpectedly present")
+	}
+
+	_, err = captureStdout(t, func() error {
+		return runGet("localhost:11211", "memtui-get-flag-definitely-missing-key-"+time.Now().String(), "auto")
+	})
+	if err == nil {
+		t.Error("expected an error for a cache miss")
+	}
+}
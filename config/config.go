@@ -25,7 +25,21 @@ type Config struct {
 	Timeouts    TimeoutConfig     `yaml:"timeouts"`
 	Layout      LayoutConfig      `yaml:"layout"`
 	UI          UIConfig          `yaml:"ui"`
+	Limits      LimitsConfig      `yaml:"limits"`
+	Viewer      ViewerConfig      `yaml:"viewer"`
 	Keybindings KeybindingsConfig `yaml:"keybindings,omitempty"`
+	Logging     LoggingConfig     `yaml:"logging,omitempty"`
+	// Schemas maps key glob patterns to JSON Schema files that matching
+	// values must conform to before the editor will save them (default: none)
+	Schemas []SchemaRule `yaml:"schemas,omitempty"`
+}
+
+// SchemaRule associates a key glob pattern (same dialect as the key list's
+// glob filter mode) with a JSON Schema file that values written to matching
+// keys must validate against before being saved.
+type SchemaRule struct {
+	Pattern string `yaml:"pattern"`
+	Path    string `yaml:"path"`
 }
 
 // ConnectionConfig holds connection-related settings
@@ -39,6 +53,8 @@ type TimeoutConfig struct {
 	Connection     time.Duration `yaml:"connection"`      // Client connection timeout (default: 3s)
 	KeyEnumeration time.Duration `yaml:"key_enumeration"` // Key listing timeout (default: 30s)
 	Capability     time.Duration `yaml:"capability"`      // Server capability detection timeout (default: 5s)
+	Watch          time.Duration `yaml:"watch"`           // Poll interval for watch mode (default: 2s)
+	Tail           time.Duration `yaml:"tail"`            // Poll interval for tail mode's re-enumeration (default: 5s)
 }
 
 // LayoutConfig holds UI layout settings
@@ -47,11 +63,111 @@ type LayoutConfig struct {
 	ContentPadding      int `yaml:"content_padding"`       // Padding for content area in lines (default: 4)
 }
 
+// LimitsConfig holds size limits enforced before sending data to the server,
+// plus scoping limits for key enumeration on large caches
+type LimitsConfig struct {
+	MaxItemSize        int    `yaml:"max_item_size"`        // Maximum value size in bytes (default: 1048576, i.e. 1MB)
+	MaxKeys            int    `yaml:"max_keys"`             // Maximum keys to load via metadump, 0 = unlimited (default: 0)
+	KeyPrefix          string `yaml:"key_prefix"`           // Only enumerate keys starting with this prefix, empty = no filter (default: "")
+	SelectAllThreshold int    `yaml:"select_all_threshold"` // Ctrl+A selections above this count require confirmation; <= 0 disables the guard (default: 500)
+	DefaultTTL         int32  `yaml:"default_ttl"`          // TTL in seconds pre-filled when creating a new key, 0 = no expiration (default: 0)
+	// ProtectedPatterns lists glob patterns (same dialect as the key list's
+	// glob filter mode) for keys considered critical. Deleting or
+	// overwriting a matching key requires typing its name to confirm, on
+	// top of the normal confirmation (default: none)
+	ProtectedPatterns []string `yaml:"protected_patterns,omitempty"`
+	// EnumerationBackend selects how keys are listed: "auto" prefers
+	// lru_crawler metadump and falls back to the slower, deprecated "stats
+	// cachedump" when the server rejects metadump; "metadump" and
+	// "cachedump" force one or the other (default: "auto")
+	EnumerationBackend string `yaml:"enumeration_backend"`
+	// MultiNodeConcurrency bounds how many nodes are enumerated at once in
+	// multi-node mode, 0 = unbounded, i.e. every node enumerated
+	// concurrently (default: 4)
+	MultiNodeConcurrency int `yaml:"multi_node_concurrency"`
+}
+
 // UIConfig holds UI-related settings
 type UIConfig struct {
 	Theme           string `yaml:"theme"`
 	KeyDelimiter    string `yaml:"key_delimiter"`
 	DefaultViewMode string `yaml:"default_view_mode"`
+	// KeyListColumns selects which optional metadata columns the key list
+	// renders alongside each key's name: any of "size", "ttl", "slab",
+	// "node", "last_access". Empty (the default) shows no columns; see
+	// keylist.ParseColumn. Can also be toggled at runtime from the command
+	// palette.
+	KeyListColumns []string `yaml:"key_list_columns,omitempty"`
+	// NoAltScreen disables the alternate screen buffer, leaving memtui's
+	// output in the terminal's normal scrollback after exit. Overridden by
+	// the --no-alt-screen flag (default: false).
+	NoAltScreen bool `yaml:"no_alt_screen,omitempty"`
+	// FlashOnResult briefly flashes the status bar (success/error color) when
+	// a create, delete, or save operation completes, for users who want a
+	// stronger visual confirmation than the status text alone (default: false).
+	FlashOnResult bool `yaml:"flash_on_result,omitempty"`
+	// KeyTemplate selects the placeholder text used by the "copy key path
+	// template" action's built-in heuristics (default: "id" and "uuid")
+	KeyTemplate KeyTemplateConfig `yaml:"key_template,omitempty"`
+	// IdleTimeout disconnects the session after this long without a
+	// keypress, showing a reconnect screen until the next key is pressed.
+	// Useful on shared workstations left connected to production. 0
+	// disables the feature (default: 0, i.e. off)
+	IdleTimeout time.Duration `yaml:"idle_timeout,omitempty"`
+}
+
+// KeyTemplateConfig selects placeholder text for the "copy key path
+// template" action's two built-in heuristics: numeric segments and
+// UUID-looking segments. See models.KeyTemplate.
+type KeyTemplateConfig struct {
+	NumericPlaceholder string `yaml:"numeric_placeholder"` // default: "id"
+	UUIDPlaceholder    string `yaml:"uuid_placeholder"`    // default: "uuid"
+}
+
+// ViewerConfig holds persisted value viewer preferences
+type ViewerConfig struct {
+	Wrap        bool `yaml:"wrap"`         // Soft-wrap long lines instead of truncating them (default: false)
+	LineNumbers bool `yaml:"line_numbers"` // Show line numbers alongside content (default: false)
+	HexWidth    int  `yaml:"hex_width"`    // Bytes shown per line in hex view (default: 16)
+	HexRuler    bool `yaml:"hex_ruler"`    // Show a column index header above hex dumps (default: false)
+	// KeyRefTemplate derives a candidate key name from a JSON string token
+	// under the cursor for the "go to matching key" binding, with "{}"
+	// replaced by the token, e.g. "session:{}". Empty disables the feature
+	// (default: "")
+	KeyRefTemplate string `yaml:"key_ref_template"`
+	// JSONIndent selects the indentation used when pretty-printing JSON in
+	// the viewer and the editor's format action: "2", "4", or "tab"
+	// (default: "2")
+	JSONIndent string `yaml:"json_indent"`
+	// SortJSONKeys sorts JSON object keys alphabetically when pretty-printing,
+	// recursively through nested objects. Array order is always preserved
+	// (default: false)
+	SortJSONKeys bool `yaml:"sort_json_keys"`
+	// MaxDisplayBytes caps how much of an oversized value is formatted and
+	// rendered at once; the full value is still held for copy/save/edit. Set
+	// to 0 to disable the cap (default: 2097152, i.e. 2MB)
+	MaxDisplayBytes int `yaml:"max_display_bytes"`
+	// PageScrollMode selects how much the viewer scrolls on PgUp/PgDn:
+	// "full" (viewport height), "half" (half the viewport height), or
+	// "lines" (a fixed line count set by PageScrollLines) (default: "full")
+	PageScrollMode string `yaml:"page_scroll_mode"`
+	// PageScrollLines is the number of lines PgUp/PgDn scroll when
+	// PageScrollMode is "lines" (default: 10)
+	PageScrollLines int `yaml:"page_scroll_lines"`
+	// AutoformatOnEdit pretty-prints a value as the editor opens, using the
+	// same indentation/sort settings as JSONIndent/SortJSONKeys, when the
+	// detected type is JSON. The buffer isn't marked dirty until the user
+	// actually changes it (default: false)
+	AutoformatOnEdit bool `yaml:"autoformat_on_edit"`
+	// ChecksumAlgorithm selects a checksum to display alongside the byte
+	// length in the viewer header, computed over the full value even when
+	// display is truncated: "", "crc32", or "md5" (default: "", i.e. off)
+	ChecksumAlgorithm string `yaml:"checksum_algorithm"`
+}
+
+// LoggingConfig holds settings for optional structured debug logging
+type LoggingConfig struct {
+	Debug bool `yaml:"debug"` // Write structured debug log entries to a file under the config dir (default: false)
 }
 
 // KeybindingsConfig holds custom keybinding settings
@@ -81,6 +197,61 @@ var validViewModes = map[string]bool{
 	"text": true,
 }
 
+// Valid key list column options
+var validKeyListColumns = map[string]bool{
+	"size":        true,
+	"ttl":         true,
+	"slab":        true,
+	"node":        true,
+	"last_access": true,
+}
+
+// Valid enumeration backend options
+var validEnumerationBackends = map[string]bool{
+	"auto":      true,
+	"metadump":  true,
+	"cachedump": true,
+}
+
+// DefaultMaxDisplayBytes is the default ViewerConfig.MaxDisplayBytes: how
+// much of an oversized value is formatted and rendered by default.
+const DefaultMaxDisplayBytes = 2 * 1024 * 1024
+
+// Valid JSON indentation options
+var validJSONIndents = map[string]bool{
+	"2":   true,
+	"4":   true,
+	"tab": true,
+}
+
+// JSONIndentString converts a ViewerConfig.JSONIndent setting ("2", "4", or
+// "tab") to the literal indent string passed to viewer.NewJSONFormatterWithIndent,
+// defaulting to two spaces for an unrecognized value.
+func JSONIndentString(indent string) string {
+	switch indent {
+	case "4":
+		return "    "
+	case "tab":
+		return "\t"
+	default:
+		return "  "
+	}
+}
+
+// Valid page scroll modes
+var validPageScrollModes = map[string]bool{
+	"full":  true,
+	"half":  true,
+	"lines": true,
+}
+
+// Valid checksum algorithm options ("" disables the checksum display)
+var validChecksumAlgorithms = map[string]bool{
+	"":      true,
+	"crc32": true,
+	"md5":   true,
+}
+
 // DefaultConfig returns a Config with sensible default values
 func DefaultConfig() *Config {
 	return &Config{
@@ -92,15 +263,44 @@ func DefaultConfig() *Config {
 			Connection:     3 * time.Second,
 			KeyEnumeration: 30 * time.Second,
 			Capability:     5 * time.Second,
+			Watch:          2 * time.Second,
+			Tail:           5 * time.Second,
 		},
 		Layout: LayoutConfig{
 			KeyListWidthPercent: 30,
 			ContentPadding:      4,
 		},
+		Limits: LimitsConfig{
+			MaxItemSize:          1024 * 1024,
+			MaxKeys:              0,
+			KeyPrefix:            "",
+			SelectAllThreshold:   500,
+			DefaultTTL:           0,
+			ProtectedPatterns:    nil,
+			EnumerationBackend:   "auto",
+			MultiNodeConcurrency: 4,
+		},
 		UI: UIConfig{
 			Theme:           "dark",
 			KeyDelimiter:    ":",
 			DefaultViewMode: "auto",
+			KeyTemplate: KeyTemplateConfig{
+				NumericPlaceholder: "id",
+				UUIDPlaceholder:    "uuid",
+			},
+		},
+		Viewer: ViewerConfig{
+			Wrap:              false,
+			LineNumbers:       false,
+			HexWidth:          16,
+			HexRuler:          false,
+			KeyRefTemplate:    "",
+			JSONIndent:        "2",
+			MaxDisplayBytes:   DefaultMaxDisplayBytes,
+			PageScrollMode:    "full",
+			PageScrollLines:   10,
+			AutoformatOnEdit:  false,
+			ChecksumAlgorithm: "",
 		},
 		Keybindings: KeybindingsConfig{
 			CommandPalette: "ctrl+p",
@@ -113,6 +313,9 @@ func DefaultConfig() *Config {
 			Quit:           "q",
 			SwitchPane:     "tab",
 		},
+		Logging: LoggingConfig{
+			Debug: false,
+		},
 	}
 }
 
@@ -134,6 +337,21 @@ func ConfigPath() string {
 	return filepath.Join(ConfigDir(), ConfigFileName)
 }
 
+// configPresence tracks whether a handful of settings whose zero value is
+// itself meaningful ("<= 0 disables X") were actually present in the parsed
+// YAML. mergeConfig can't tell "the file set this to 0" apart from "the file
+// didn't mention this" by comparing fileCfg's value to the Go zero value
+// alone, so these are parsed separately as pointers instead.
+type configPresence struct {
+	Limits struct {
+		SelectAllThreshold   *int `yaml:"select_all_threshold"`
+		MultiNodeConcurrency *int `yaml:"multi_node_concurrency"`
+	} `yaml:"limits"`
+	Viewer struct {
+		MaxDisplayBytes *int `yaml:"max_display_bytes"`
+	} `yaml:"viewer"`
+}
+
 // Load reads the configuration from the config file.
 // If the file doesn't exist, it returns the default configuration.
 // Partial configurations are merged with defaults.
@@ -156,15 +374,26 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
+	var presence configPresence
+	if err := yaml.Unmarshal(data, &presence); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
 	// Merge file config with defaults
-	mergeConfig(cfg, &fileCfg)
+	mergeConfig(cfg, &fileCfg, &presence)
+
+	expanded, err := expandEnvVars(cfg.Connection.DefaultAddress)
+	if err != nil {
+		return nil, fmt.Errorf("connection.default_address: %w", err)
+	}
+	cfg.Connection.DefaultAddress = expanded
 
 	return cfg, nil
 }
 
 // mergeConfig merges the file configuration into the default configuration
 // Only non-zero values from fileCfg override the defaults
-func mergeConfig(defaults *Config, fileCfg *Config) {
+func mergeConfig(defaults *Config, fileCfg *Config, presence *configPresence) {
 	// Merge connection settings
 	if fileCfg.Connection.DefaultAddress != "" {
 		defaults.Connection.DefaultAddress = fileCfg.Connection.DefaultAddress
@@ -183,6 +412,12 @@ func mergeConfig(defaults *Config, fileCfg *Config) {
 	if fileCfg.Timeouts.Capability != 0 {
 		defaults.Timeouts.Capability = fileCfg.Timeouts.Capability
 	}
+	if fileCfg.Timeouts.Watch != 0 {
+		defaults.Timeouts.Watch = fileCfg.Timeouts.Watch
+	}
+	if fileCfg.Timeouts.Tail != 0 {
+		defaults.Timeouts.Tail = fileCfg.Timeouts.Tail
+	}
 
 	// Merge layout settings
 	if fileCfg.Layout.KeyListWidthPercent != 0 {
@@ -192,6 +427,36 @@ func mergeConfig(defaults *Config, fileCfg *Config) {
 		defaults.Layout.ContentPadding = fileCfg.Layout.ContentPadding
 	}
 
+	// Merge limits settings
+	if fileCfg.Limits.MaxItemSize != 0 {
+		defaults.Limits.MaxItemSize = fileCfg.Limits.MaxItemSize
+	}
+	if fileCfg.Limits.MaxKeys != 0 {
+		defaults.Limits.MaxKeys = fileCfg.Limits.MaxKeys
+	}
+	if fileCfg.Limits.KeyPrefix != "" {
+		defaults.Limits.KeyPrefix = fileCfg.Limits.KeyPrefix
+	}
+	if presence.Limits.SelectAllThreshold != nil {
+		defaults.Limits.SelectAllThreshold = *presence.Limits.SelectAllThreshold
+	}
+	if fileCfg.Limits.DefaultTTL != 0 {
+		defaults.Limits.DefaultTTL = fileCfg.Limits.DefaultTTL
+	}
+	if len(fileCfg.Limits.ProtectedPatterns) > 0 {
+		defaults.Limits.ProtectedPatterns = fileCfg.Limits.ProtectedPatterns
+	}
+	if fileCfg.Limits.EnumerationBackend != "" {
+		defaults.Limits.EnumerationBackend = fileCfg.Limits.EnumerationBackend
+	}
+	if presence.Limits.MultiNodeConcurrency != nil {
+		defaults.Limits.MultiNodeConcurrency = *presence.Limits.MultiNodeConcurrency
+	}
+
+	if len(fileCfg.Schemas) > 0 {
+		defaults.Schemas = fileCfg.Schemas
+	}
+
 	// Merge UI settings
 	if fileCfg.UI.Theme != "" {
 		defaults.UI.Theme = fileCfg.UI.Theme
@@ -202,6 +467,51 @@ func mergeConfig(defaults *Config, fileCfg *Config) {
 	if fileCfg.UI.DefaultViewMode != "" {
 		defaults.UI.DefaultViewMode = fileCfg.UI.DefaultViewMode
 	}
+	if fileCfg.UI.KeyListColumns != nil {
+		defaults.UI.KeyListColumns = fileCfg.UI.KeyListColumns
+	}
+	defaults.UI.NoAltScreen = fileCfg.UI.NoAltScreen
+	defaults.UI.FlashOnResult = fileCfg.UI.FlashOnResult
+	if fileCfg.UI.KeyTemplate.NumericPlaceholder != "" {
+		defaults.UI.KeyTemplate.NumericPlaceholder = fileCfg.UI.KeyTemplate.NumericPlaceholder
+	}
+	if fileCfg.UI.KeyTemplate.UUIDPlaceholder != "" {
+		defaults.UI.KeyTemplate.UUIDPlaceholder = fileCfg.UI.KeyTemplate.UUIDPlaceholder
+	}
+	if fileCfg.UI.IdleTimeout != 0 {
+		defaults.UI.IdleTimeout = fileCfg.UI.IdleTimeout
+	}
+
+	// Merge viewer settings. Wrap, LineNumbers, HexRuler, and SortJSONKeys
+	// default to false, so unlike the string/int fields above there's no
+	// unset sentinel to check against - the file's value (whether explicitly
+	// set or left at the zero value) wins.
+	defaults.Viewer.Wrap = fileCfg.Viewer.Wrap
+	defaults.Viewer.LineNumbers = fileCfg.Viewer.LineNumbers
+	defaults.Viewer.HexRuler = fileCfg.Viewer.HexRuler
+	if fileCfg.Viewer.HexWidth != 0 {
+		defaults.Viewer.HexWidth = fileCfg.Viewer.HexWidth
+	}
+	if fileCfg.Viewer.KeyRefTemplate != "" {
+		defaults.Viewer.KeyRefTemplate = fileCfg.Viewer.KeyRefTemplate
+	}
+	if fileCfg.Viewer.JSONIndent != "" {
+		defaults.Viewer.JSONIndent = fileCfg.Viewer.JSONIndent
+	}
+	defaults.Viewer.SortJSONKeys = fileCfg.Viewer.SortJSONKeys
+	if presence.Viewer.MaxDisplayBytes != nil {
+		defaults.Viewer.MaxDisplayBytes = *presence.Viewer.MaxDisplayBytes
+	}
+	if fileCfg.Viewer.PageScrollMode != "" {
+		defaults.Viewer.PageScrollMode = fileCfg.Viewer.PageScrollMode
+	}
+	if fileCfg.Viewer.PageScrollLines != 0 {
+		defaults.Viewer.PageScrollLines = fileCfg.Viewer.PageScrollLines
+	}
+	defaults.Viewer.AutoformatOnEdit = fileCfg.Viewer.AutoformatOnEdit
+	if fileCfg.Viewer.ChecksumAlgorithm != "" {
+		defaults.Viewer.ChecksumAlgorithm = fileCfg.Viewer.ChecksumAlgorithm
+	}
 
 	// Merge keybindings settings
 	if fileCfg.Keybindings.CommandPalette != "" {
@@ -231,6 +541,10 @@ func mergeConfig(defaults *Config, fileCfg *Config) {
 	if fileCfg.Keybindings.SwitchPane != "" {
 		defaults.Keybindings.SwitchPane = fileCfg.Keybindings.SwitchPane
 	}
+
+	// Merge logging settings. Debug defaults to false, so like Viewer.Wrap
+	// above there's no unset sentinel - the file's value always wins.
+	defaults.Logging.Debug = fileCfg.Logging.Debug
 }
 
 // Save writes the configuration to the config file.
@@ -274,6 +588,12 @@ func (c *Config) Validate() error {
 	if c.Timeouts.Capability <= 0 {
 		return errors.New("timeouts.capability must be positive")
 	}
+	if c.Timeouts.Watch <= 0 {
+		return errors.New("timeouts.watch must be positive")
+	}
+	if c.Timeouts.Tail <= 0 {
+		return errors.New("timeouts.tail must be positive")
+	}
 
 	// Validate layout settings
 	if c.Layout.KeyListWidthPercent < 10 || c.Layout.KeyListWidthPercent > 90 {
@@ -283,6 +603,20 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("layout.content_padding must be between 0 and 20 (got: %d)", c.Layout.ContentPadding)
 	}
 
+	// Validate limits settings
+	if c.Limits.MaxItemSize <= 0 {
+		return errors.New("limits.max_item_size must be positive")
+	}
+	if c.Limits.MaxKeys < 0 {
+		return errors.New("limits.max_keys cannot be negative")
+	}
+	if !validEnumerationBackends[c.Limits.EnumerationBackend] {
+		return fmt.Errorf("limits.enumeration_backend must be one of: auto, metadump, cachedump (got: %s)", c.Limits.EnumerationBackend)
+	}
+	if c.Limits.MultiNodeConcurrency < 0 {
+		return errors.New("limits.multi_node_concurrency cannot be negative")
+	}
+
 	// Validate UI settings
 	if !validThemes[c.UI.Theme] {
 		return fmt.Errorf("ui.theme must be one of: dark, light (got: %s)", c.UI.Theme)
@@ -290,6 +624,34 @@ func (c *Config) Validate() error {
 	if !validViewModes[c.UI.DefaultViewMode] {
 		return fmt.Errorf("ui.default_view_mode must be one of: auto, json, hex, text (got: %s)", c.UI.DefaultViewMode)
 	}
+	for _, col := range c.UI.KeyListColumns {
+		if !validKeyListColumns[col] {
+			return fmt.Errorf("ui.key_list_columns must contain only: size, ttl, slab, node, last_access (got: %s)", col)
+		}
+	}
+	if c.UI.IdleTimeout < 0 {
+		return errors.New("ui.idle_timeout cannot be negative")
+	}
+
+	// Validate viewer settings
+	if c.Viewer.HexWidth < 1 || c.Viewer.HexWidth > 64 {
+		return fmt.Errorf("viewer.hex_width must be between 1 and 64 (got: %d)", c.Viewer.HexWidth)
+	}
+	if !validJSONIndents[c.Viewer.JSONIndent] {
+		return fmt.Errorf("viewer.json_indent must be one of: 2, 4, tab (got: %s)", c.Viewer.JSONIndent)
+	}
+	if c.Viewer.MaxDisplayBytes < 0 {
+		return errors.New("viewer.max_display_bytes cannot be negative")
+	}
+	if !validPageScrollModes[c.Viewer.PageScrollMode] {
+		return fmt.Errorf("viewer.page_scroll_mode must be one of: full, half, lines (got: %s)", c.Viewer.PageScrollMode)
+	}
+	if c.Viewer.PageScrollLines < 1 {
+		return fmt.Errorf("viewer.page_scroll_lines must be at least 1 (got: %d)", c.Viewer.PageScrollLines)
+	}
+	if !validChecksumAlgorithms[c.Viewer.ChecksumAlgorithm] {
+		return fmt.Errorf("viewer.checksum_algorithm must be one of: \"\", crc32, md5 (got: %s)", c.Viewer.ChecksumAlgorithm)
+	}
 
 	return nil
 }
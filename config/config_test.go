@@ -3,6 +3,7 @@ package config_test
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,6 +31,12 @@ func TestConfig_Defaults(t *testing.T) {
 	if cfg.Timeouts.Capability != 5*time.Second {
 		t.Errorf("expected default timeouts.capability 5s, got '%v'", cfg.Timeouts.Capability)
 	}
+	if cfg.Timeouts.Watch != 2*time.Second {
+		t.Errorf("expected default timeouts.watch 2s, got '%v'", cfg.Timeouts.Watch)
+	}
+	if cfg.Timeouts.Tail != 5*time.Second {
+		t.Errorf("expected default timeouts.tail 5s, got '%v'", cfg.Timeouts.Tail)
+	}
 
 	// Test layout defaults
 	if cfg.Layout.KeyListWidthPercent != 30 {
@@ -39,6 +46,23 @@ func TestConfig_Defaults(t *testing.T) {
 		t.Errorf("expected default layout.content_padding 4, got '%d'", cfg.Layout.ContentPadding)
 	}
 
+	// Test limits defaults
+	if cfg.Limits.MaxItemSize != 1024*1024 {
+		t.Errorf("expected default limits.max_item_size 1048576, got '%d'", cfg.Limits.MaxItemSize)
+	}
+	if cfg.Limits.MaxKeys != 0 {
+		t.Errorf("expected default limits.max_keys 0 (unlimited), got '%d'", cfg.Limits.MaxKeys)
+	}
+	if cfg.Limits.KeyPrefix != "" {
+		t.Errorf("expected default limits.key_prefix '', got '%s'", cfg.Limits.KeyPrefix)
+	}
+	if cfg.Limits.SelectAllThreshold != 500 {
+		t.Errorf("expected default limits.select_all_threshold 500, got '%d'", cfg.Limits.SelectAllThreshold)
+	}
+	if cfg.Limits.DefaultTTL != 0 {
+		t.Errorf("expected default limits.default_ttl 0 (no expiration), got '%d'", cfg.Limits.DefaultTTL)
+	}
+
 	// Test UI defaults
 	if cfg.UI.Theme != "dark" {
 		t.Errorf("expected default theme 'dark', got '%s'", cfg.UI.Theme)
@@ -50,6 +74,26 @@ func TestConfig_Defaults(t *testing.T) {
 		t.Errorf("expected default view mode 'auto', got '%s'", cfg.UI.DefaultViewMode)
 	}
 
+	// Test viewer defaults
+	if cfg.Viewer.Wrap {
+		t.Error("expected default viewer.wrap false")
+	}
+	if cfg.Viewer.LineNumbers {
+		t.Error("expected default viewer.line_numbers false")
+	}
+	if cfg.Viewer.HexWidth != 16 {
+		t.Errorf("expected default viewer.hex_width 16, got '%d'", cfg.Viewer.HexWidth)
+	}
+	if cfg.Viewer.HexRuler {
+		t.Error("expected default viewer.hex_ruler false")
+	}
+	if cfg.Viewer.PageScrollMode != "full" {
+		t.Errorf("expected default viewer.page_scroll_mode 'full', got '%s'", cfg.Viewer.PageScrollMode)
+	}
+	if cfg.Viewer.PageScrollLines != 10 {
+		t.Errorf("expected default viewer.page_scroll_lines 10, got '%d'", cfg.Viewer.PageScrollLines)
+	}
+
 	// Test keybindings defaults
 	if cfg.Keybindings.CommandPalette != "ctrl+p" {
 		t.Errorf("expected default keybindings.command_palette 'ctrl+p', got '%s'", cfg.Keybindings.CommandPalette)
@@ -66,6 +110,11 @@ func TestConfig_Defaults(t *testing.T) {
 	if cfg.Keybindings.Quit != "q" {
 		t.Errorf("expected default keybindings.quit 'q', got '%s'", cfg.Keybindings.Quit)
 	}
+
+	// Test logging defaults
+	if cfg.Logging.Debug {
+		t.Error("expected default logging.debug false")
+	}
 }
 
 func TestConfig_Load_NoFile(t *testing.T) {
@@ -135,6 +184,60 @@ ui:
 	}
 }
 
+func TestConfig_Load_ExpandsEnvVarsInAddress(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+	t.Setenv("MEMCACHED_HOST", "memcached-0")
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `connection:
+  default_address: "${MEMCACHED_HOST}:11211"
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Connection.DefaultAddress != "memcached-0:11211" {
+		t.Errorf("expected expanded address 'memcached-0:11211', got '%s'", cfg.Connection.DefaultAddress)
+	}
+}
+
+func TestConfig_Load_MissingEnvVarFailsClearly(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `connection:
+  default_address: "${DOES_NOT_EXIST_MEMCACHED_VAR}:11211"
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	_, err := config.Load()
+	if err == nil {
+		t.Fatal("expected an error for a missing environment variable")
+	}
+	if !strings.Contains(err.Error(), "DOES_NOT_EXIST_MEMCACHED_VAR") {
+		t.Errorf("expected the error to name the missing variable, got: %v", err)
+	}
+}
+
 func TestConfig_Save(t *testing.T) {
 	tmpDir := t.TempDir()
 	t.Setenv("XDG_CONFIG_HOME", tmpDir)
@@ -249,6 +352,8 @@ func TestConfig_MergeWithDefaults(t *testing.T) {
   default_address: "customserver:11211"
 ui:
   theme: "light"
+limits:
+  max_item_size: 2097152
 `
 	configPath := filepath.Join(configDir, "config.yaml")
 	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
@@ -278,6 +383,458 @@ ui:
 	if cfg.UI.DefaultViewMode != "auto" {
 		t.Errorf("expected default view mode 'auto', got '%s'", cfg.UI.DefaultViewMode)
 	}
+
+	// Verify custom limits override the default
+	if cfg.Limits.MaxItemSize != 2097152 {
+		t.Errorf("expected custom max_item_size 2097152, got '%d'", cfg.Limits.MaxItemSize)
+	}
+
+	// Verify default viewer settings are applied since the file omits them
+	if cfg.Viewer.Wrap {
+		t.Error("expected default viewer.wrap false")
+	}
+	if cfg.Viewer.HexWidth != 16 {
+		t.Errorf("expected default viewer.hex_width 16, got '%d'", cfg.Viewer.HexWidth)
+	}
+}
+
+func TestConfig_MergeWithDefaults_ViewerPreferences(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `viewer:
+  wrap: true
+  line_numbers: true
+  hex_width: 8
+  hex_ruler: true
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cfg.Viewer.Wrap {
+		t.Error("expected viewer.wrap to be true")
+	}
+	if !cfg.Viewer.LineNumbers {
+		t.Error("expected viewer.line_numbers to be true")
+	}
+	if cfg.Viewer.HexWidth != 8 {
+		t.Errorf("expected viewer.hex_width 8, got '%d'", cfg.Viewer.HexWidth)
+	}
+	if !cfg.Viewer.HexRuler {
+		t.Error("expected viewer.hex_ruler to be true")
+	}
+}
+
+func TestConfig_MergeWithDefaults_AutoformatOnEdit(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `viewer:
+  autoformat_on_edit: true
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cfg.Viewer.AutoformatOnEdit {
+		t.Error("expected viewer.autoformat_on_edit to be true")
+	}
+}
+
+func TestConfig_MergeWithDefaults_PageScroll(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `viewer:
+  page_scroll_mode: half
+  page_scroll_lines: 20
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Viewer.PageScrollMode != "half" {
+		t.Errorf("expected viewer.page_scroll_mode 'half', got '%s'", cfg.Viewer.PageScrollMode)
+	}
+	if cfg.Viewer.PageScrollLines != 20 {
+		t.Errorf("expected viewer.page_scroll_lines 20, got '%d'", cfg.Viewer.PageScrollLines)
+	}
+}
+
+func TestConfig_MergeWithDefaults_MultiNodeConcurrency(t *testing.T) {
+	t.Run("non-zero value overrides the default", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+		configDir := filepath.Join(tmpDir, "memtui")
+		if err := os.MkdirAll(configDir, 0o755); err != nil {
+			t.Fatalf("failed to create config dir: %v", err)
+		}
+
+		yamlContent := `limits:
+  multi_node_concurrency: 8
+`
+		configPath := filepath.Join(configDir, "config.yaml")
+		if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Limits.MultiNodeConcurrency != 8 {
+			t.Errorf("expected limits.multi_node_concurrency 8, got %d", cfg.Limits.MultiNodeConcurrency)
+		}
+	})
+
+	t.Run("explicit zero means unbounded instead of falling back to the default", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+		configDir := filepath.Join(tmpDir, "memtui")
+		if err := os.MkdirAll(configDir, 0o755); err != nil {
+			t.Fatalf("failed to create config dir: %v", err)
+		}
+
+		yamlContent := `limits:
+  multi_node_concurrency: 0
+`
+		configPath := filepath.Join(configDir, "config.yaml")
+		if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Limits.MultiNodeConcurrency != 0 {
+			t.Errorf("expected limits.multi_node_concurrency 0 (unbounded), got %d", cfg.Limits.MultiNodeConcurrency)
+		}
+	})
+}
+
+func TestConfig_MergeWithDefaults_TailInterval(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `timeouts:
+  tail: 10s
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Timeouts.Tail != 10*time.Second {
+		t.Errorf("expected timeouts.tail 10s, got %v", cfg.Timeouts.Tail)
+	}
+}
+
+func TestConfig_Validate_NegativeMultiNodeConcurrency(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Limits.MultiNodeConcurrency = -1
+
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected error for negative limits.multi_node_concurrency")
+	}
+}
+
+func TestConfig_MergeWithDefaults_NoAltScreen(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `ui:
+  no_alt_screen: true
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cfg.UI.NoAltScreen {
+		t.Error("expected ui.no_alt_screen to be true")
+	}
+}
+
+func TestConfig_MergeWithDefaults_IdleTimeout(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `ui:
+  idle_timeout: 10m
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.UI.IdleTimeout != 10*time.Minute {
+		t.Errorf("expected ui.idle_timeout 10m, got %v", cfg.UI.IdleTimeout)
+	}
+}
+
+func TestConfig_Defaults_IdleTimeoutDisabled(t *testing.T) {
+	cfg := config.DefaultConfig()
+	if cfg.UI.IdleTimeout != 0 {
+		t.Errorf("expected ui.idle_timeout to default to 0 (disabled), got %v", cfg.UI.IdleTimeout)
+	}
+}
+
+func TestConfig_MergeWithDefaults_KeyLimits(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `limits:
+  max_keys: 5000
+  key_prefix: "session:"
+  default_ttl: 3600
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Limits.MaxKeys != 5000 {
+		t.Errorf("expected limits.max_keys 5000, got '%d'", cfg.Limits.MaxKeys)
+	}
+	if cfg.Limits.KeyPrefix != "session:" {
+		t.Errorf("expected limits.key_prefix 'session:', got '%s'", cfg.Limits.KeyPrefix)
+	}
+	if cfg.Limits.DefaultTTL != 3600 {
+		t.Errorf("expected limits.default_ttl 3600, got '%d'", cfg.Limits.DefaultTTL)
+	}
+}
+
+func TestConfig_MergeWithDefaults_SelectAllThreshold(t *testing.T) {
+	t.Run("non-zero value overrides the default", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+		configDir := filepath.Join(tmpDir, "memtui")
+		if err := os.MkdirAll(configDir, 0o755); err != nil {
+			t.Fatalf("failed to create config dir: %v", err)
+		}
+
+		yamlContent := `limits:
+  select_all_threshold: 50
+`
+		configPath := filepath.Join(configDir, "config.yaml")
+		if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Limits.SelectAllThreshold != 50 {
+			t.Errorf("expected limits.select_all_threshold 50, got %d", cfg.Limits.SelectAllThreshold)
+		}
+	})
+
+	t.Run("explicit zero disables the guard instead of falling back to the default", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+		configDir := filepath.Join(tmpDir, "memtui")
+		if err := os.MkdirAll(configDir, 0o755); err != nil {
+			t.Fatalf("failed to create config dir: %v", err)
+		}
+
+		yamlContent := `limits:
+  select_all_threshold: 0
+`
+		configPath := filepath.Join(configDir, "config.yaml")
+		if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Limits.SelectAllThreshold != 0 {
+			t.Errorf("expected limits.select_all_threshold 0 (guard disabled), got %d", cfg.Limits.SelectAllThreshold)
+		}
+	})
+}
+
+func TestConfig_MergeWithDefaults_MaxDisplayBytes(t *testing.T) {
+	t.Run("non-zero value overrides the default", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+		configDir := filepath.Join(tmpDir, "memtui")
+		if err := os.MkdirAll(configDir, 0o755); err != nil {
+			t.Fatalf("failed to create config dir: %v", err)
+		}
+
+		yamlContent := `viewer:
+  max_display_bytes: 4096
+`
+		configPath := filepath.Join(configDir, "config.yaml")
+		if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Viewer.MaxDisplayBytes != 4096 {
+			t.Errorf("expected viewer.max_display_bytes 4096, got %d", cfg.Viewer.MaxDisplayBytes)
+		}
+	})
+
+	t.Run("explicit zero disables the cap instead of falling back to the default", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+		configDir := filepath.Join(tmpDir, "memtui")
+		if err := os.MkdirAll(configDir, 0o755); err != nil {
+			t.Fatalf("failed to create config dir: %v", err)
+		}
+
+		yamlContent := `viewer:
+  max_display_bytes: 0
+`
+		configPath := filepath.Join(configDir, "config.yaml")
+		if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Viewer.MaxDisplayBytes != 0 {
+			t.Errorf("expected viewer.max_display_bytes 0 (cap disabled), got %d", cfg.Viewer.MaxDisplayBytes)
+		}
+	})
+}
+
+func TestConfig_MergeWithDefaults_ProtectedPatterns(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "memtui")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	yamlContent := `limits:
+  protected_patterns:
+    - "config:*"
+    - "session:admin:*"
+`
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"config:*", "session:admin:*"}
+	if len(cfg.Limits.ProtectedPatterns) != len(want) {
+		t.Fatalf("expected %v, got %v", want, cfg.Limits.ProtectedPatterns)
+	}
+	for i, pattern := range want {
+		if cfg.Limits.ProtectedPatterns[i] != pattern {
+			t.Errorf("expected pattern %q at index %d, got %q", pattern, i, cfg.Limits.ProtectedPatterns[i])
+		}
+	}
+}
+
+func TestConfig_Defaults_NoProtectedPatterns(t *testing.T) {
+	cfg := config.DefaultConfig()
+	if len(cfg.Limits.ProtectedPatterns) != 0 {
+		t.Errorf("expected no protected patterns by default, got %v", cfg.Limits.ProtectedPatterns)
+	}
 }
 
 func TestConfig_InvalidYAML(t *testing.T) {
@@ -327,6 +884,8 @@ func TestConfig_Validate(t *testing.T) {
 				Connection:     3 * time.Second,
 				KeyEnumeration: 30 * time.Second,
 				Capability:     5 * time.Second,
+				Watch:          2 * time.Second,
+				Tail:           5 * time.Second,
 			},
 			Layout: config.LayoutConfig{
 				KeyListWidthPercent: 30,
@@ -337,6 +896,16 @@ func TestConfig_Validate(t *testing.T) {
 				KeyDelimiter:    ":",
 				DefaultViewMode: "auto",
 			},
+			Limits: config.LimitsConfig{
+				MaxItemSize:        1024 * 1024,
+				EnumerationBackend: "auto",
+			},
+			Viewer: config.ViewerConfig{
+				HexWidth:        16,
+				JSONIndent:      "2",
+				PageScrollMode:  "full",
+				PageScrollLines: 10,
+			},
 		}
 	}
 
@@ -404,6 +973,186 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			wantErr: true,
 		},
+		{
+			name: "zero max item size",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Limits.MaxItemSize = 0
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "negative max item size",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Limits.MaxItemSize = -1
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "zero hex width",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.HexWidth = 0
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "hex width too large",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.HexWidth = 128
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "negative max keys",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Limits.MaxKeys = -1
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "valid key list columns",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.UI.KeyListColumns = []string{"size", "ttl", "last_access"}
+				return c
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "invalid key list column",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.UI.KeyListColumns = []string{"size", "bogus"}
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "invalid enumeration backend",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Limits.EnumerationBackend = "invalid"
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "zero watch timeout",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Timeouts.Watch = 0
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "negative watch timeout",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Timeouts.Watch = -1 * time.Second
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "zero tail timeout",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Timeouts.Tail = 0
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "valid json indent (tab)",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.JSONIndent = "tab"
+				return c
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "invalid json indent",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.JSONIndent = "invalid"
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "zero max display bytes (disables the cap)",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.MaxDisplayBytes = 0
+				return c
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "negative max display bytes",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.MaxDisplayBytes = -1
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "valid page scroll mode (half)",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.PageScrollMode = "half"
+				return c
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "invalid page scroll mode",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.PageScrollMode = "invalid"
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "zero page scroll lines",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.PageScrollLines = 0
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "negative page scroll lines",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.Viewer.PageScrollLines = -1
+				return c
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "negative idle timeout",
+			cfg: func() *config.Config {
+				c := validBase()
+				c.UI.IdleTimeout = -time.Second
+				return c
+			}(),
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -435,6 +1184,8 @@ func TestUIConfig_ViewModes(t *testing.T) {
 				Connection:     3 * time.Second,
 				KeyEnumeration: 30 * time.Second,
 				Capability:     5 * time.Second,
+				Watch:          2 * time.Second,
+				Tail:           5 * time.Second,
 			},
 			Layout: config.LayoutConfig{
 				KeyListWidthPercent: 30,
@@ -445,6 +1196,16 @@ func TestUIConfig_ViewModes(t *testing.T) {
 				KeyDelimiter:    ":",
 				DefaultViewMode: mode,
 			},
+			Limits: config.LimitsConfig{
+				MaxItemSize:        1024 * 1024,
+				EnumerationBackend: "auto",
+			},
+			Viewer: config.ViewerConfig{
+				HexWidth:        16,
+				JSONIndent:      "2",
+				PageScrollMode:  "full",
+				PageScrollLines: 10,
+			},
 		}
 		if err := cfg.Validate(); err != nil {
 			t.Errorf("expected valid view mode '%s', got error: %v", mode, err)
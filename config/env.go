@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// envVarPattern matches "${VAR}" references, e.g. in a server address like
+// "${MEMCACHED_ADDR}:11211".
+var envVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandEnvVars replaces every "${VAR}" reference in s with the value of
+// the named environment variable, so config files can defer host/port
+// (and, in the future, credentials) to the environment instead of hardcoding
+// them - useful in CI and containers. It returns an error naming the
+// variable if any referenced variable is unset, so a missing or misspelled
+// reference fails clearly instead of silently producing a blank host.
+func expandEnvVars(s string) (string, error) {
+	var missing error
+	expanded := envVarPattern.ReplaceAllStringFunc(s, func(match string) string {
+		name := envVarPattern.FindStringSubmatch(match)[1]
+		value, ok := os.LookupEnv(name)
+		if !ok && missing == nil {
+			missing = fmt.Errorf("environment variable %q is not set", name)
+		}
+		return value
+	})
+	if missing != nil {
+		return "", missing
+	}
+	return expanded, nil
+}
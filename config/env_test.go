@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestExpandEnvVars(t *testing.T) {
+	t.Run("expands a set variable", func(t *testing.T) {
+		os.Setenv("MEMTUI_TEST_ENV_EXPAND", "cache-1")
+		defer os.Unsetenv("MEMTUI_TEST_ENV_EXPAND")
+
+		got, err := expandEnvVars("${MEMTUI_TEST_ENV_EXPAND}:11211")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "cache-1:11211" {
+			t.Errorf("expected 'cache-1:11211', got %q", got)
+		}
+	})
+
+	t.Run("no references returns the string unchanged", func(t *testing.T) {
+		got, err := expandEnvVars("localhost:11211")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "localhost:11211" {
+			t.Errorf("expected 'localhost:11211', got %q", got)
+		}
+	})
+
+	t.Run("missing variable returns a clear error", func(t *testing.T) {
+		os.Unsetenv("MEMTUI_TEST_ENV_MISSING")
+
+		_, err := expandEnvVars("${MEMTUI_TEST_ENV_MISSING}:11211")
+		if err == nil {
+			t.Fatal("expected an error for a missing variable")
+		}
+		if !strings.Contains(err.Error(), "MEMTUI_TEST_ENV_MISSING") {
+			t.Errorf("expected the error to name the missing variable, got: %v", err)
+		}
+	})
+}
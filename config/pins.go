@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+)
+
+// PinsFileName is the name of the pinned-keys file
+const PinsFileName = "pins.yaml"
+
+// PinsConfig holds pinned keys, grouped by server address so switching
+// servers doesn't mix unrelated pin lists.
+type PinsConfig struct {
+	// Servers maps a server address to its pinned keys.
+	Servers map[string][]string `yaml:"servers"`
+}
+
+// PinsFilePath returns the full path to the pins file.
+func PinsFilePath() string {
+	return filepath.Join(ConfigDir(), PinsFileName)
+}
+
+// LoadPins reads the pins file. If it doesn't exist, it returns an empty
+// PinsConfig.
+func LoadPins() (*PinsConfig, error) {
+	path := PinsFilePath()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &PinsConfig{Servers: map[string][]string{}}, nil
+		}
+		return nil, fmt.Errorf("failed to read pins file: %w", err)
+	}
+
+	var cfg PinsConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse pins file: %w", err)
+	}
+	if cfg.Servers == nil {
+		cfg.Servers = map[string][]string{}
+	}
+
+	return &cfg, nil
+}
+
+// SavePins writes the pins file, creating the config directory if needed.
+func SavePins(cfg *PinsConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("cannot save nil pins config")
+	}
+
+	dir := ConfigDir()
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pins config: %w", err)
+	}
+
+	if err := os.WriteFile(PinsFilePath(), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write pins file: %w", err)
+	}
+
+	return nil
+}
+
+// TogglePin flips whether key is pinned for server, persists the result, and
+// returns the new pinned state.
+func TogglePin(server, key string) (bool, error) {
+	cfg, err := LoadPins()
+	if err != nil {
+		return false, err
+	}
+
+	keys := cfg.Servers[server]
+	idx := -1
+	for i, k := range keys {
+		if k == key {
+			idx = i
+			break
+		}
+	}
+
+	pinned := idx == -1
+	if pinned {
+		cfg.Servers[server] = append(keys, key)
+	} else {
+		cfg.Servers[server] = append(keys[:idx], keys[idx+1:]...)
+	}
+
+	if err := SavePins(cfg); err != nil {
+		return false, err
+	}
+	return pinned, nil
+}
+
+// PinnedKeysFor returns the pinned keys for server, or an empty slice if
+// none are recorded.
+func PinnedKeysFor(server string) ([]string, error) {
+	cfg, err := LoadPins()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Servers[server], nil
+}
+
+// SetPinnedKeysFor replaces server's pinned key list and persists the
+// result, e.g. to prune keys that no longer exist on the server.
+func SetPinnedKeysFor(server string, keys []string) error {
+	cfg, err := LoadPins()
+	if err != nil {
+		return err
+	}
+	cfg.Servers[server] = keys
+	return SavePins(cfg)
+}
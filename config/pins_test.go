@@ -0,0 +1,112 @@
+package config
+
+import "testing"
+
+func TestTogglePin(t *testing.T) {
+	t.Run("pinning a key adds it to the pinned set and persists it", func(t *testing.T) {
+		_, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		pinned, err := TogglePin("localhost:11211", "user:1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !pinned {
+			t.Errorf("expected TogglePin to report newly pinned, got false")
+		}
+
+		keys, err := PinnedKeysFor("localhost:11211")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(keys) != 1 || keys[0] != "user:1" {
+			t.Errorf("expected [user:1], got %v", keys)
+		}
+	})
+
+	t.Run("toggling a pinned key again unpins and persists it", func(t *testing.T) {
+		_, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		TogglePin("localhost:11211", "user:1")
+		pinned, err := TogglePin("localhost:11211", "user:1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pinned {
+			t.Errorf("expected TogglePin to report unpinned, got true")
+		}
+
+		keys, err := PinnedKeysFor("localhost:11211")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(keys) != 0 {
+			t.Errorf("expected no pinned keys, got %v", keys)
+		}
+	})
+
+	t.Run("different servers have independent pin lists", func(t *testing.T) {
+		_, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		TogglePin("host-a:11211", "a-key")
+		TogglePin("host-b:11211", "b-key")
+
+		keysA, _ := PinnedKeysFor("host-a:11211")
+		keysB, _ := PinnedKeysFor("host-b:11211")
+
+		if len(keysA) != 1 || keysA[0] != "a-key" {
+			t.Errorf("expected [a-key], got %v", keysA)
+		}
+		if len(keysB) != 1 || keysB[0] != "b-key" {
+			t.Errorf("expected [b-key], got %v", keysB)
+		}
+	})
+}
+
+func TestPinnedKeysFor_UnknownServer(t *testing.T) {
+	_, cleanup := setupTestDir(t)
+	defer cleanup()
+
+	keys, err := PinnedKeysFor("does-not-exist:11211")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("expected no pinned keys, got %v", keys)
+	}
+}
+
+func TestLoadPins_MissingFileReturnsEmptyConfig(t *testing.T) {
+	_, cleanup := setupTestDir(t)
+	defer cleanup()
+
+	cfg, err := LoadPins()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Servers == nil || len(cfg.Servers) != 0 {
+		t.Errorf("expected an empty servers map, got %v", cfg.Servers)
+	}
+}
+
+func TestSetPinnedKeysFor_Prune(t *testing.T) {
+	_, cleanup := setupTestDir(t)
+	defer cleanup()
+
+	TogglePin("localhost:11211", "user:1")
+	TogglePin("localhost:11211", "user:2")
+
+	if err := SetPinnedKeysFor("localhost:11211", []string{"user:1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	keys, err := PinnedKeysFor("localhost:11211")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != "user:1" {
+		t.Errorf("expected [user:1] after pruning user:2, got %v", keys)
+	}
+}
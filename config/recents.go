@@ -0,0 +1,119 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-yaml"
+)
+
+const (
+	// RecentsFileName is the name of the recently-viewed-keys file
+	RecentsFileName = "recents.yaml"
+
+	// MaxRecentKeys caps how many recently-viewed keys are kept per server,
+	// so the file (and the quick-switcher list) doesn't grow unbounded.
+	MaxRecentKeys = 50
+)
+
+// RecentsConfig holds recently-viewed keys, grouped by server address so
+// switching servers doesn't mix unrelated recency lists.
+type RecentsConfig struct {
+	// Servers maps a server address to its recently-viewed keys, most
+	// recent first.
+	Servers map[string][]string `yaml:"servers"`
+}
+
+// RecentsFilePath returns the full path to the recents file.
+func RecentsFilePath() string {
+	return filepath.Join(ConfigDir(), RecentsFileName)
+}
+
+// LoadRecents reads the recents file. If it doesn't exist, it returns an
+// empty RecentsConfig.
+func LoadRecents() (*RecentsConfig, error) {
+	path := RecentsFilePath()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &RecentsConfig{Servers: map[string][]string{}}, nil
+		}
+		return nil, fmt.Errorf("failed to read recents file: %w", err)
+	}
+
+	var cfg RecentsConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse recents file: %w", err)
+	}
+	if cfg.Servers == nil {
+		cfg.Servers = map[string][]string{}
+	}
+
+	return &cfg, nil
+}
+
+// SaveRecents writes the recents file, creating the config directory if
+// needed.
+func SaveRecents(cfg *RecentsConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("cannot save nil recents config")
+	}
+
+	dir := ConfigDir()
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal recents config: %w", err)
+	}
+
+	if err := os.WriteFile(RecentsFilePath(), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write recents file: %w", err)
+	}
+
+	return nil
+}
+
+// RecordRecentKey moves key to the front of server's recency list
+// (inserting it if new), trims the list to MaxRecentKeys, and persists the
+// result.
+func RecordRecentKey(server, key string) error {
+	cfg, err := LoadRecents()
+	if err != nil {
+		return err
+	}
+
+	cfg.Servers[server] = prependRecentKey(cfg.Servers[server], key)
+
+	return SaveRecents(cfg)
+}
+
+// prependRecentKey returns keys with key moved to the front, removing any
+// earlier occurrence, and capped at MaxRecentKeys.
+func prependRecentKey(keys []string, key string) []string {
+	result := make([]string, 0, len(keys)+1)
+	result = append(result, key)
+	for _, k := range keys {
+		if k != key {
+			result = append(result, k)
+		}
+	}
+	if len(result) > MaxRecentKeys {
+		result = result[:MaxRecentKeys]
+	}
+	return result
+}
+
+// RecentKeysFor returns the recently-viewed keys for server, most recent
+// first, or an empty slice if none are recorded.
+func RecentKeysFor(server string) ([]string, error) {
+	cfg, err := LoadRecents()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Servers[server], nil
+}
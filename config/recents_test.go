@@ -0,0 +1,122 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRecordRecentKey(t *testing.T) {
+	t.Run("new server starts with a single entry", func(t *testing.T) {
+		_, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		if err := RecordRecentKey("localhost:11211", "user:1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		keys, err := RecentKeysFor("localhost:11211")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(keys, []string{"user:1"}) {
+			t.Errorf("expected [user:1], got %v", keys)
+		}
+	})
+
+	t.Run("most recently viewed key comes first", func(t *testing.T) {
+		_, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		RecordRecentKey("localhost:11211", "user:1")
+		RecordRecentKey("localhost:11211", "user:2")
+		RecordRecentKey("localhost:11211", "user:3")
+
+		keys, err := RecentKeysFor("localhost:11211")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := []string{"user:3", "user:2", "user:1"}
+		if !reflect.DeepEqual(keys, expected) {
+			t.Errorf("expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("re-viewing a key moves it to the front without duplicating it", func(t *testing.T) {
+		_, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		RecordRecentKey("localhost:11211", "user:1")
+		RecordRecentKey("localhost:11211", "user:2")
+		RecordRecentKey("localhost:11211", "user:1")
+
+		keys, err := RecentKeysFor("localhost:11211")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := []string{"user:1", "user:2"}
+		if !reflect.DeepEqual(keys, expected) {
+			t.Errorf("expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("list is capped at MaxRecentKeys", func(t *testing.T) {
+		_, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		for i := 0; i < MaxRecentKeys+10; i++ {
+			RecordRecentKey("localhost:11211", string(rune('a'+i%26))+string(rune(i)))
+		}
+
+		keys, err := RecentKeysFor("localhost:11211")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(keys) != MaxRecentKeys {
+			t.Errorf("expected %d keys, got %d", MaxRecentKeys, len(keys))
+		}
+	})
+
+	t.Run("different servers have independent recency lists", func(t *testing.T) {
+		_, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		RecordRecentKey("host-a:11211", "a-key")
+		RecordRecentKey("host-b:11211", "b-key")
+
+		keysA, _ := RecentKeysFor("host-a:11211")
+		keysB, _ := RecentKeysFor("host-b:11211")
+
+		if !reflect.DeepEqual(keysA, []string{"a-key"}) {
+			t.Errorf("expected [a-key], got %v", keysA)
+		}
+		if !reflect.DeepEqual(keysB, []string{"b-key"}) {
+			t.Errorf("expected [b-key], got %v", keysB)
+		}
+	})
+}
+
+func TestRecentKeysFor_UnknownServer(t *testing.T) {
+	_, cleanup := setupTestDir(t)
+	defer cleanup()
+
+	keys, err := RecentKeysFor("does-not-exist:11211")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("expected no recent keys, got %v", keys)
+	}
+}
+
+func TestLoadRecents_MissingFileReturnsEmptyConfig(t *testing.T) {
+	_, cleanup := setupTestDir(t)
+	defer cleanup()
+
+	cfg, err := LoadRecents()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Servers == nil || len(cfg.Servers) != 0 {
+		t.Errorf("expected an empty servers map, got %v", cfg.Servers)
+	}
+}
@@ -19,9 +19,34 @@ const (
 
 // ServerConfig represents a single Memcached server configuration
 type ServerConfig struct {
-	Name    string `yaml:"name"`    // Human-readable server name
-	Address string `yaml:"address"` // Server address in host:port format
-	Default bool   `yaml:"default"` // Whether this is the default server
+	Name     string   `yaml:"name"`               // Human-readable server name
+	Address  string   `yaml:"address"`            // Server address in host:port format
+	Default  bool     `yaml:"default"`            // Whether this is the default server
+	Tags     []string `yaml:"tags,omitempty"`     // Free-form labels, e.g. "production", "staging"
+	Protocol string   `yaml:"protocol,omitempty"` // Wire protocol: "text", "binary", or "auto" (default "auto")
+}
+
+// ValidProtocols are the accepted values for ServerConfig.Protocol. An empty
+// value is treated as "auto".
+var ValidProtocols = map[string]bool{
+	"":       true,
+	"auto":   true,
+	"text":   true,
+	"binary": true,
+}
+
+// productionTag is the tag that marks a server as production for the
+// purposes of IsProduction.
+const productionTag = "production"
+
+// IsProduction reports whether the server is tagged "production".
+func (s ServerConfig) IsProduction() bool {
+	for _, tag := range s.Tags {
+		if tag == productionTag {
+			return true
+		}
+	}
+	return false
 }
 
 // ServersConfig holds all server configurations
@@ -48,6 +73,10 @@ func (s *ServerConfig) Validate() error {
 		return errors.New("invalid address format: expected host:port")
 	}
 
+	if !ValidProtocols[s.Protocol] {
+		return fmt.Errorf("invalid protocol %q: expected text, binary, or auto", s.Protocol)
+	}
+
 	return nil
 }
 
@@ -89,6 +118,14 @@ func LoadServers() (*ServersConfig, error) {
 		return nil, fmt.Errorf("failed to parse servers config file: %w", err)
 	}
 
+	for i := range cfg.Servers {
+		expanded, err := expandEnvVars(cfg.Servers[i].Address)
+		if err != nil {
+			return nil, fmt.Errorf("server %q: address: %w", cfg.Servers[i].Name, err)
+		}
+		cfg.Servers[i].Address = expanded
+	}
+
 	return &cfg, nil
 }
 
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -26,6 +27,28 @@ func TestServerConfig(t *testing.T) {
 	})
 }
 
+func TestServerConfig_IsProduction(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []string
+		expected bool
+	}{
+		{"no tags", nil, false},
+		{"unrelated tags", []string{"staging", "west"}, false},
+		{"production tag", []string{"production"}, true},
+		{"production among other tags", []string{"west", "production"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := ServerConfig{Name: "srv", Address: "localhost:11211", Tags: tt.tags}
+			if got := cfg.IsProduction(); got != tt.expected {
+				t.Errorf("expected IsProduction() = %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
 func TestServersConfig(t *testing.T) {
 	t.Run("ServersConfig struct fields", func(t *testing.T) {
 		cfg := ServersConfig{
@@ -163,6 +186,65 @@ last_used: test-server
 		}
 	})
 
+	t.Run("expands env vars in address", func(t *testing.T) {
+		tmpDir, cleanup := setupTestDir(t)
+		defer cleanup()
+		os.Setenv("MEMTUI_TEST_MEMCACHED_ADDR", "memcached-0:11211")
+		defer os.Unsetenv("MEMTUI_TEST_MEMCACHED_ADDR")
+
+		configDir := filepath.Join(tmpDir, AppName)
+		if err := os.MkdirAll(configDir, 0o755); err != nil {
+			t.Fatalf("failed to create config dir: %v", err)
+		}
+
+		content := `servers:
+  - name: test-server
+    address: "${MEMTUI_TEST_MEMCACHED_ADDR}"
+    default: true
+`
+		path := filepath.Join(configDir, "servers.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+
+		cfg, err := LoadServers()
+		if err != nil {
+			t.Fatalf("LoadServers failed: %v", err)
+		}
+
+		if cfg.Servers[0].Address != "memcached-0:11211" {
+			t.Errorf("expected expanded address 'memcached-0:11211', got %q", cfg.Servers[0].Address)
+		}
+	})
+
+	t.Run("missing env var fails clearly", func(t *testing.T) {
+		tmpDir, cleanup := setupTestDir(t)
+		defer cleanup()
+
+		configDir := filepath.Join(tmpDir, AppName)
+		if err := os.MkdirAll(configDir, 0o755); err != nil {
+			t.Fatalf("failed to create config dir: %v", err)
+		}
+
+		content := `servers:
+  - name: test-server
+    address: "${MEMTUI_TEST_DOES_NOT_EXIST}"
+    default: true
+`
+		path := filepath.Join(configDir, "servers.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+
+		_, err := LoadServers()
+		if err == nil {
+			t.Fatal("expected an error for a missing environment variable")
+		}
+		if !strings.Contains(err.Error(), "MEMTUI_TEST_DOES_NOT_EXIST") {
+			t.Errorf("expected the error to name the missing variable, got: %v", err)
+		}
+	})
+
 	t.Run("returns error on invalid yaml", func(t *testing.T) {
 		tmpDir, cleanup := setupTestDir(t)
 		defer cleanup()
@@ -688,6 +770,33 @@ func TestGetLastUsedServer(t *testing.T) {
 	})
 }
 
+func TestServerConfigValidate_Protocol(t *testing.T) {
+	tests := []struct {
+		name      string
+		protocol  string
+		expectErr bool
+	}{
+		{"empty defaults to auto", "", false},
+		{"auto", "auto", false},
+		{"text", "text", false},
+		{"binary", "binary", false},
+		{"unknown protocol", "quic", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := ServerConfig{Name: "test", Address: "localhost:11211", Protocol: tt.protocol}
+			err := cfg.Validate()
+			if tt.expectErr && err == nil {
+				t.Error("expected an error, got nil")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
 func TestServerConfigValidate(t *testing.T) {
 	t.Run("valid config", func(t *testing.T) {
 		cfg := ServerConfig{
@@ -0,0 +1,150 @@
+// Package logging provides optional structured debug logging for
+// troubleshooting memtui sessions. When enabled, entries are appended as
+// single-line JSON records to a log file under the config directory, so
+// they can be tailed or parsed without interfering with the TUI's
+// rendering on stdout/stderr.
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/nnnkkk7/memtui/config"
+)
+
+const (
+	// FileName is the name of the debug log file under the config directory.
+	FileName = "memtui.log"
+	// DefaultMaxSize is the size in bytes at which the log file is rotated.
+	DefaultMaxSize = 10 * 1024 * 1024 // 10MB
+)
+
+// Entry is a single structured log record.
+type Entry struct {
+	Time     time.Time     `json:"time"`
+	Event    string        `json:"event"`              // e.g. "connect", "enumerate", "command", "error"
+	Server   string        `json:"server,omitempty"`   // memcached server address
+	Detail   string        `json:"detail,omitempty"`   // human-readable context (command name, key count, ...)
+	Duration time.Duration `json:"duration,omitempty"` // operation timing, if applicable
+	Err      string        `json:"error,omitempty"`    // non-empty if the operation failed
+}
+
+// Logger appends Entry records to a rotating file.
+type Logger struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	file    *os.File
+}
+
+// Path returns the default log file path under the config directory.
+func Path() string {
+	return filepath.Join(config.ConfigDir(), FileName)
+}
+
+// New opens (creating if necessary) the debug log file at path, rotating
+// it first if it already exceeds DefaultMaxSize. Pass an empty path to use
+// the default location returned by Path.
+func New(path string) (*Logger, error) {
+	if path == "" {
+		path = Path()
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	l := &Logger{path: path, maxSize: DefaultMaxSize}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *Logger) open() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	l.file = f
+	return nil
+}
+
+// rotate renames the current log file aside and opens a fresh one, if the
+// current file has grown past maxSize. Must be called with mu held.
+func (l *Logger) rotate() {
+	info, err := l.file.Stat()
+	if err != nil || info.Size() < l.maxSize {
+		return
+	}
+	_ = l.file.Close()
+	_ = os.Rename(l.path, l.path+".1")
+	_ = l.open()
+}
+
+// Log appends entry to the log file, stamping its time if unset.
+func (l *Logger) Log(entry Entry) {
+	if l == nil {
+		return
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rotate()
+	_, _ = l.file.Write(data)
+}
+
+// Connect records a connection attempt and its outcome.
+func (l *Logger) Connect(server string, d time.Duration, err error) {
+	l.Log(Entry{Event: "connect", Server: server, Duration: d, Err: errString(err)})
+}
+
+// Enumerate records a key enumeration pass and its outcome.
+func (l *Logger) Enumerate(server string, keyCount int, d time.Duration, err error) {
+	l.Log(Entry{Event: "enumerate", Server: server, Detail: fmt.Sprintf("%d keys", keyCount), Duration: d, Err: errString(err)})
+}
+
+// Command records a command executed through the command palette or a
+// keybinding, e.g. "delete key", "new key".
+func (l *Logger) Command(server, name string, d time.Duration, err error) {
+	l.Log(Entry{Event: "command", Server: server, Detail: name, Duration: d, Err: errString(err)})
+}
+
+// Error records a standalone error not tied to connect/enumerate/command,
+// e.g. one surfaced to the user via the status bar.
+func (l *Logger) Error(server, context string, err error) {
+	l.Log(Entry{Event: "error", Server: server, Detail: context, Err: errString(err)})
+}
+
+// Audit records a mutating operation (key created, edited, or deleted) for
+// the in-session activity trail (see app.Model.AuditLog).
+func (l *Logger) Audit(server, action, key string) {
+	l.Log(Entry{Event: "audit", Server: server, Detail: fmt.Sprintf("%s %s", action, key)})
+}
+
+// Close closes the underlying log file.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	return l.file.Close()
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,137 @@
+package logging_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nnnkkk7/memtui/logging"
+)
+
+func readEntries(t *testing.T, path string) []logging.Entry {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open log file: %v", err)
+	}
+	defer f.Close()
+
+	var entries []logging.Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry logging.Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("failed to parse log line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestNew_CreatesLogFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "memtui", "memtui.log")
+
+	logger, err := logging.New(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected log file to exist: %v", err)
+	}
+}
+
+func TestLogger_Connect(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "memtui.log")
+
+	logger, err := logging.New(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	logger.Connect("localhost:11211", 5*time.Millisecond, nil)
+
+	entries := readEntries(t, path)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	if entries[0].Event != "connect" {
+		t.Errorf("expected event 'connect', got '%s'", entries[0].Event)
+	}
+	if entries[0].Server != "localhost:11211" {
+		t.Errorf("expected server 'localhost:11211', got '%s'", entries[0].Server)
+	}
+	if entries[0].Err != "" {
+		t.Errorf("expected no error, got '%s'", entries[0].Err)
+	}
+	if entries[0].Time.IsZero() {
+		t.Error("expected a non-zero timestamp")
+	}
+}
+
+func TestLogger_ConnectError(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "memtui.log")
+
+	logger, err := logging.New(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	logger.Connect("localhost:11211", 0, errors.New("connection refused"))
+
+	entries := readEntries(t, path)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	if entries[0].Err != "connection refused" {
+		t.Errorf("expected error 'connection refused', got '%s'", entries[0].Err)
+	}
+}
+
+func TestLogger_EnumerateAndCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "memtui.log")
+
+	logger, err := logging.New(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer logger.Close()
+
+	logger.Enumerate("localhost:11211", 42, time.Second, nil)
+	logger.Command("localhost:11211", "Delete key", 0, nil)
+
+	entries := readEntries(t, path)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Event != "enumerate" || entries[0].Detail != "42 keys" {
+		t.Errorf("unexpected enumerate entry: %+v", entries[0])
+	}
+	if entries[1].Event != "command" || entries[1].Detail != "Delete key" {
+		t.Errorf("unexpected command entry: %+v", entries[1])
+	}
+}
+
+func TestLogger_NilLoggerIsNoOp(t *testing.T) {
+	var logger *logging.Logger
+	// Should not panic on a nil receiver.
+	logger.Connect("localhost:11211", 0, nil)
+	logger.Enumerate("localhost:11211", 0, 0, nil)
+	logger.Command("localhost:11211", "noop", 0, nil)
+	logger.Error("localhost:11211", "context", errors.New("boom"))
+	if err := logger.Close(); err != nil {
+		t.Errorf("expected nil error from Close on nil logger, got %v", err)
+	}
+}
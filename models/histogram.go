@@ -0,0 +1,65 @@
+package models
+
+import "strconv"
+
+// sizeBucketBounds defines the upper bound (exclusive) of each size bucket
+// used by ComputeSizeHistogram, in bytes. The final bucket has no upper
+// bound. Chosen to roughly double at each step so both small metadata keys
+// and large cached blobs land in a meaningful bucket.
+var sizeBucketBounds = []int{64, 256, 1024, 4096, 16384, 65536, 262144, 1048576}
+
+// Bucket is a single bar in a value-size histogram: the half-open byte range
+// [Min, Max) it covers (Max == -1 means unbounded) and how many keys fell
+// into it.
+type Bucket struct {
+	Label string
+	Min   int
+	Max   int // -1 means unbounded
+	Count int
+}
+
+// ComputeSizeHistogram buckets keys by KeyInfo.Size into a fixed set of
+// byte-size ranges, returned in ascending order. Every bucket is present in
+// the result even when its count is zero, so callers can render a complete
+// chart without special-casing empty ranges.
+func ComputeSizeHistogram(keys []KeyInfo) []Bucket {
+	buckets := make([]Bucket, 0, len(sizeBucketBounds)+1)
+	min := 0
+	for _, max := range sizeBucketBounds {
+		buckets = append(buckets, Bucket{Label: formatByteRange(min, max), Min: min, Max: max})
+		min = max
+	}
+	buckets = append(buckets, Bucket{Label: formatByteRange(min, -1), Min: min, Max: -1})
+
+	for _, k := range keys {
+		for i := range buckets {
+			if buckets[i].Max == -1 || k.Size < buckets[i].Max {
+				buckets[i].Count++
+				break
+			}
+		}
+	}
+	return buckets
+}
+
+// formatByteRange renders a bucket's [min, max) range as a human-readable
+// label, e.g. "0-64B", "1KB-4KB", "1MB+".
+func formatByteRange(min, max int) string {
+	if max == -1 {
+		return formatBytes(min) + "+"
+	}
+	return formatBytes(min) + "-" + formatBytes(max)
+}
+
+// formatBytes renders a byte count using the largest unit that divides it
+// evenly, for compact histogram labels.
+func formatBytes(n int) string {
+	switch {
+	case n >= 1048576 && n%1048576 == 0:
+		return strconv.Itoa(n/1048576) + "MB"
+	case n >= 1024 && n%1024 == 0:
+		return strconv.Itoa(n/1024) + "KB"
+	default:
+		return strconv.Itoa(n) + "B"
+	}
+}
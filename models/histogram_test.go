@@ -0,0 +1,71 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/nnnkkk7/memtui/models"
+)
+
+func TestComputeSizeHistogram_BucketBoundariesAndCounts(t *testing.T) {
+	keys := []models.KeyInfo{
+		{Key: "a", Size: 0},      // 0-64B
+		{Key: "b", Size: 63},     // 0-64B
+		{Key: "c", Size: 64},     // 64B-256B (upper bound of previous bucket is exclusive)
+		{Key: "d", Size: 1023},   // 256B-1KB
+		{Key: "e", Size: 1024},   // 1KB-4KB
+		{Key: "f", Size: 16384},  // 16KB-64KB
+		{Key: "g", Size: 999999}, // 256KB-1MB
+		{Key: "h", Size: 1048576},
+		{Key: "i", Size: 5000000},
+	}
+
+	buckets := models.ComputeSizeHistogram(keys)
+
+	counts := make(map[string]int, len(buckets))
+	for _, b := range buckets {
+		counts[b.Label] = b.Count
+	}
+
+	want := map[string]int{
+		"0B-64B":    2,
+		"64B-256B":  1,
+		"256B-1KB":  1,
+		"1KB-4KB":   1,
+		"16KB-64KB": 1,
+		"256KB-1MB": 1,
+		"1MB+":      2,
+	}
+	for label, wantCount := range want {
+		if got := counts[label]; got != wantCount {
+			t.Errorf("bucket %q: expected count %d, got %d", label, wantCount, got)
+		}
+	}
+
+	total := 0
+	for _, b := range buckets {
+		total += b.Count
+	}
+	if total != len(keys) {
+		t.Errorf("expected total bucketed count %d, got %d", len(keys), total)
+	}
+}
+
+func TestComputeSizeHistogram_EmptyBucketsPresent(t *testing.T) {
+	buckets := models.ComputeSizeHistogram(nil)
+	if len(buckets) == 0 {
+		t.Fatal("expected a fixed set of buckets even with no keys")
+	}
+	for _, b := range buckets {
+		if b.Count != 0 {
+			t.Errorf("expected bucket %q to be empty, got count %d", b.Label, b.Count)
+		}
+	}
+}
+
+func TestComputeSizeHistogram_UnboundedLastBucket(t *testing.T) {
+	buckets := models.ComputeSizeHistogram(nil)
+	last := buckets[len(buckets)-1]
+	if last.Max != -1 {
+		t.Errorf("expected the last bucket to be unbounded (Max == -1), got %d", last.Max)
+	}
+}
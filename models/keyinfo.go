@@ -2,21 +2,29 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // KeyInfo represents metadata for a Memcached key from lru_crawler metadump
 type KeyInfo struct {
-	Key        string // Key name
-	Expiration int64  // Unix timestamp (0 = permanent)
-	LastAccess int64  // Last access timestamp
-	CAS        uint64 // CAS value
-	Fetch      bool   // Whether fetch has been performed
-	SlabClass  int    // Slab class ID
-	Size       int    // Size in bytes
+	Key           string // Key name
+	Expiration    int64  // Unix timestamp (0 = permanent)
+	LastAccess    int64  // Last access timestamp
+	HasLastAccess bool   // Whether the server reported a last-access time ("la")
+	CAS           uint64 // CAS value
+	Fetch         bool   // Whether fetch has been performed
+	SlabClass     int    // Slab class ID
+	Size          int    // Size in bytes
+	Node          string // Address of the node this key was enumerated from (multi-node mode only, empty otherwise)
+	FetchCount    int64  // Number of times the key has been fetched, if reported
+	HasFetchCount bool   // Whether the server reported a fetch count ("fetched" or "hits")
+	RawLine       string // The raw metadump line this KeyInfo was parsed from, if retention was requested (empty otherwise)
 }
 
 // ErrInvalidMetadumpLine is returned when a metadump line cannot be parsed
@@ -24,6 +32,8 @@ var ErrInvalidMetadumpLine = errors.New("invalid metadump line")
 
 // ParseMetadumpLine parses a single line from lru_crawler metadump output
 // Format: key=<key> exp=<exp> la=<la> cas=<cas> fetch=<yes|no> cls=<cls> size=<size>
+// Newer memcached versions may also report a "fetched" (or "hits") field
+// with the total number of times the key has been fetched.
 func ParseMetadumpLine(line string) (KeyInfo, error) {
 	// Handle trailing \r from Memcached protocol
 	line = strings.TrimSuffix(line, "\r")
@@ -59,6 +69,7 @@ func ParseMetadumpLine(line string) (KeyInfo, error) {
 		case "la":
 			if v, err := strconv.ParseInt(value, 10, 64); err == nil {
 				ki.LastAccess = v
+				ki.HasLastAccess = true
 			}
 		case "cas":
 			if v, err := strconv.ParseUint(value, 10, 64); err == nil {
@@ -74,6 +85,11 @@ func ParseMetadumpLine(line string) (KeyInfo, error) {
 			if v, err := strconv.Atoi(value); err == nil {
 				ki.Size = v
 			}
+		case "fetched", "hits":
+			if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+				ki.FetchCount = v
+				ki.HasFetchCount = true
+			}
 		}
 	}
 
@@ -84,6 +100,86 @@ func ParseMetadumpLine(line string) (KeyInfo, error) {
 	return ki, nil
 }
 
+// ErrInvalidCacheDumpLine is returned when a "stats cachedump" line cannot
+// be parsed
+var ErrInvalidCacheDumpLine = errors.New("invalid cachedump line")
+
+// cacheDumpItemRe matches a "stats cachedump" item line, e.g.
+// "ITEM foo [3 b; 1690000000 s]".
+var cacheDumpItemRe = regexp.MustCompile(`^ITEM (\S+) \[(\d+) b; (-?\d+) s\]$`)
+
+// ParseCacheDumpLine parses a single line from "stats cachedump <slab>
+// <limit>" output, e.g. "ITEM foo [3 b; 1690000000 s]". Unlike metadump,
+// cachedump reports only size and an expiration timestamp, so CAS,
+// last-access, and fetch-count are left unset. slabClass is the slab the
+// line was dumped from, since cachedump output doesn't repeat it per line.
+func ParseCacheDumpLine(line string, slabClass int) (KeyInfo, error) {
+	line = strings.TrimSuffix(line, "\r")
+	line = strings.TrimSpace(line)
+
+	m := cacheDumpItemRe.FindStringSubmatch(line)
+	if m == nil {
+		return KeyInfo{}, ErrInvalidCacheDumpLine
+	}
+
+	size, _ := strconv.Atoi(m[2])
+	exp, _ := strconv.ParseInt(m[3], 10, 64)
+	if exp < 0 {
+		exp = 0
+	}
+
+	return KeyInfo{
+		Key:        m[1],
+		Size:       size,
+		Expiration: exp,
+		SlabClass:  slabClass,
+	}, nil
+}
+
+// LastAccessDisplay returns a human-readable last-access time, or "unknown"
+// if the server's metadump output didn't include an "la" field.
+func (ki KeyInfo) LastAccessDisplay() string {
+	if !ki.HasLastAccess {
+		return "unknown"
+	}
+	return time.Unix(ki.LastAccess, 0).Format("2006-01-02 15:04:05")
+}
+
+// FetchCountDisplay returns the key's fetch count as a string, or "n/a" if
+// the server's metadump output didn't include a "fetched"/"hits" field.
+func (ki KeyInfo) FetchCountDisplay() string {
+	if !ki.HasFetchCount {
+		return "n/a"
+	}
+	return strconv.FormatInt(ki.FetchCount, 10)
+}
+
+// TTLDisplay returns a human-readable time-to-live for the key relative to
+// now: "never" for a permanent key (Expiration == 0), "expired" if its
+// expiration has already passed, otherwise the remaining time rounded down
+// to the largest applicable unit (e.g. "5m", "2h", "3d").
+func (ki KeyInfo) TTLDisplay(now int64) string {
+	if ki.Expiration == 0 {
+		return "never"
+	}
+
+	remaining := ki.Expiration - now
+	if remaining <= 0 {
+		return "expired"
+	}
+
+	switch {
+	case remaining >= 86400:
+		return fmt.Sprintf("%dd", remaining/86400)
+	case remaining >= 3600:
+		return fmt.Sprintf("%dh", remaining/3600)
+	case remaining >= 60:
+		return fmt.Sprintf("%dm", remaining/60)
+	default:
+		return fmt.Sprintf("%ds", remaining)
+	}
+}
+
 // IsExpiredAt checks if the key is expired at the given timestamp
 // Returns false for permanent keys (Expiration == 0)
 func (ki KeyInfo) IsExpiredAt(now int64) bool {
@@ -102,6 +198,12 @@ const (
 	SortByKey SortOrder = iota
 	// SortBySize sorts by size (ascending)
 	SortBySize
+	// SortByLastAccess sorts by last-access time (ascending); keys without a
+	// reported last-access time sort first
+	SortByLastAccess
+	// SortByFetchCount sorts by fetch count (descending, hottest first);
+	// keys without a reported fetch count sort last
+	SortByFetchCount
 )
 
 // SortKeyInfos returns a sorted copy of the KeyInfo slice
@@ -118,6 +220,20 @@ func SortKeyInfos(keys []KeyInfo, order SortOrder) []KeyInfo {
 		sort.Slice(result, func(i, j int) bool {
 			return result[i].Size < result[j].Size
 		})
+	case SortByLastAccess:
+		sort.Slice(result, func(i, j int) bool {
+			if result[i].HasLastAccess != result[j].HasLastAccess {
+				return !result[i].HasLastAccess
+			}
+			return result[i].LastAccess < result[j].LastAccess
+		})
+	case SortByFetchCount:
+		sort.Slice(result, func(i, j int) bool {
+			if result[i].HasFetchCount != result[j].HasFetchCount {
+				return result[i].HasFetchCount
+			}
+			return result[i].FetchCount > result[j].FetchCount
+		})
 	}
 
 	return result
@@ -134,6 +134,9 @@ func TestParseMetadumpLine_AllFields(t *testing.T) {
 	if ki.LastAccess != 1704060000 {
 		t.Errorf("LastAccess: expected 1704060000, got %d", ki.LastAccess)
 	}
+	if !ki.HasLastAccess {
+		t.Error("HasLastAccess: expected true, got false")
+	}
 	if ki.CAS != 99999 {
 		t.Errorf("CAS: expected 99999, got %d", ki.CAS)
 	}
@@ -148,6 +151,74 @@ func TestParseMetadumpLine_AllFields(t *testing.T) {
 	}
 }
 
+func TestParseMetadumpLine_NoLastAccess(t *testing.T) {
+	input := "key=session:abc exp=1704067200 cas=99999 fetch=yes cls=3 size=1024"
+	ki, err := models.ParseMetadumpLine(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ki.HasLastAccess {
+		t.Error("HasLastAccess: expected false when 'la' field is absent, got true")
+	}
+	if ki.LastAccessDisplay() != "unknown" {
+		t.Errorf("LastAccessDisplay: expected 'unknown', got '%s'", ki.LastAccessDisplay())
+	}
+}
+
+func TestParseMetadumpLine_FetchCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantHas bool
+		wantVal int64
+	}{
+		{
+			name:    "fetched field",
+			input:   "key=user:1 exp=0 cas=1 fetch=yes cls=1 size=10 fetched=42",
+			wantHas: true,
+			wantVal: 42,
+		},
+		{
+			name:    "hits field",
+			input:   "key=user:1 exp=0 cas=1 fetch=yes cls=1 size=10 hits=7",
+			wantHas: true,
+			wantVal: 7,
+		},
+		{
+			name:    "no fetch count reported",
+			input:   "key=user:1 exp=0 cas=1 fetch=yes cls=1 size=10",
+			wantHas: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ki, err := models.ParseMetadumpLine(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ki.HasFetchCount != tt.wantHas {
+				t.Errorf("HasFetchCount: expected %v, got %v", tt.wantHas, ki.HasFetchCount)
+			}
+			if tt.wantHas && ki.FetchCount != tt.wantVal {
+				t.Errorf("FetchCount: expected %d, got %d", tt.wantVal, ki.FetchCount)
+			}
+			if !tt.wantHas && ki.FetchCountDisplay() != "n/a" {
+				t.Errorf("FetchCountDisplay: expected 'n/a', got '%s'", ki.FetchCountDisplay())
+			}
+		})
+	}
+}
+
+func TestKeyInfo_LastAccessDisplay(t *testing.T) {
+	ki := models.KeyInfo{LastAccess: 1704060000, HasLastAccess: true}
+	got := ki.LastAccessDisplay()
+	if got == "unknown" || got == "" {
+		t.Errorf("expected a formatted timestamp, got '%s'", got)
+	}
+}
+
 func TestKeyInfo_IsExpired(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -185,6 +256,32 @@ func TestKeyInfo_IsExpired(t *testing.T) {
 	}
 }
 
+func TestKeyInfo_TTLDisplay(t *testing.T) {
+	const now int64 = 1704067200
+
+	tests := []struct {
+		name       string
+		expiration int64
+		want       string
+	}{
+		{name: "permanent", expiration: 0, want: "never"},
+		{name: "already expired", expiration: now - 10, want: "expired"},
+		{name: "seconds remaining", expiration: now + 30, want: "30s"},
+		{name: "minutes remaining", expiration: now + 300, want: "5m"},
+		{name: "hours remaining", expiration: now + 7200, want: "2h"},
+		{name: "days remaining", expiration: now + 172800, want: "2d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ki := models.KeyInfo{Expiration: tt.expiration}
+			if got := ki.TTLDisplay(now); got != tt.want {
+				t.Errorf("TTLDisplay(%d) = %q, want %q", now, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSortKeyInfos(t *testing.T) {
 	keys := []models.KeyInfo{
 		{Key: "zebra", Size: 100},
@@ -205,6 +302,32 @@ func TestSortKeyInfos(t *testing.T) {
 	}
 }
 
+func TestSortKeyInfos_ByLastAccess(t *testing.T) {
+	keys := []models.KeyInfo{
+		{Key: "recent", LastAccess: 300, HasLastAccess: true},
+		{Key: "stale", LastAccess: 100, HasLastAccess: true},
+		{Key: "unreported", LastAccess: 200, HasLastAccess: false},
+	}
+
+	sorted := models.SortKeyInfos(keys, models.SortByLastAccess)
+	if sorted[0].Key != "unreported" || sorted[1].Key != "stale" || sorted[2].Key != "recent" {
+		t.Errorf("SortByLastAccess failed: got %v", sorted)
+	}
+}
+
+func TestSortKeyInfos_ByFetchCount(t *testing.T) {
+	keys := []models.KeyInfo{
+		{Key: "cold", FetchCount: 2, HasFetchCount: true},
+		{Key: "hot", FetchCount: 500, HasFetchCount: true},
+		{Key: "unreported", HasFetchCount: false},
+	}
+
+	sorted := models.SortKeyInfos(keys, models.SortByFetchCount)
+	if sorted[0].Key != "hot" || sorted[1].Key != "cold" || sorted[2].Key != "unreported" {
+		t.Errorf("SortByFetchCount failed: got %v", sorted)
+	}
+}
+
 func TestFilterKeyInfos(t *testing.T) {
 	keys := []models.KeyInfo{
 		{Key: "user:123"},
@@ -231,3 +354,85 @@ func TestFilterKeyInfos(t *testing.T) {
 		t.Errorf("expected 4 results, got %d", len(filtered))
 	}
 }
+
+func TestParseCacheDumpLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		slabClass int
+		wantKey   string
+		wantSize  int
+		wantExp   int64
+		wantErr   bool
+	}{
+		{
+			name:      "normal item",
+			input:     "ITEM user:1 [100 b; 1704067200 s]",
+			slabClass: 3,
+			wantKey:   "user:1",
+			wantSize:  100,
+			wantExp:   1704067200,
+			wantErr:   false,
+		},
+		{
+			name:      "line with trailing CR",
+			input:     "ITEM user:2 [50 b; 0 s]\r",
+			slabClass: 1,
+			wantKey:   "user:2",
+			wantSize:  50,
+			wantExp:   0,
+			wantErr:   false,
+		},
+		{
+			name:      "never-expiring item reported as -1",
+			input:     "ITEM session:abc [10 b; -1 s]",
+			slabClass: 2,
+			wantKey:   "session:abc",
+			wantSize:  10,
+			wantExp:   0,
+			wantErr:   false,
+		},
+		{
+			name:    "END marker",
+			input:   "END",
+			wantErr: true,
+		},
+		{
+			name:    "empty line",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "malformed line",
+			input:   "ITEM user:1 100 b",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ki, err := models.ParseCacheDumpLine(tt.input, tt.slabClass)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ki.Key != tt.wantKey {
+				t.Errorf("expected key %q, got %q", tt.wantKey, ki.Key)
+			}
+			if ki.Size != tt.wantSize {
+				t.Errorf("expected size %d, got %d", tt.wantSize, ki.Size)
+			}
+			if ki.Expiration != tt.wantExp {
+				t.Errorf("expected expiration %d, got %d", tt.wantExp, ki.Expiration)
+			}
+			if ki.SlabClass != tt.slabClass {
+				t.Errorf("expected slab class %d, got %d", tt.slabClass, ki.SlabClass)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	numericKeySegment = regexp.MustCompile(`^[0-9]+$`)
+	uuidKeySegment    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// KeyTemplate splits key on delimiter and replaces numeric and UUID-looking
+// segments with placeholders, turning e.g. "user:1001:profile" into
+// "user:{id}:profile". numericPlaceholder and uuidPlaceholder select the
+// placeholder text for each heuristic. Segments matching neither heuristic,
+// and keys without delimiter, are returned unchanged.
+func KeyTemplate(key, delimiter, numericPlaceholder, uuidPlaceholder string) string {
+	if delimiter == "" || !strings.Contains(key, delimiter) {
+		return key
+	}
+
+	parts := strings.Split(key, delimiter)
+	for i, part := range parts {
+		switch {
+		case numericKeySegment.MatchString(part):
+			parts[i] = "{" + numericPlaceholder + "}"
+		case uuidKeySegment.MatchString(part):
+			parts[i] = "{" + uuidPlaceholder + "}"
+		}
+	}
+	return strings.Join(parts, delimiter)
+}
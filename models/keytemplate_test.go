@@ -0,0 +1,64 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/nnnkkk7/memtui/models"
+)
+
+func TestKeyTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		expected string
+	}{
+		{
+			name:     "numeric segment becomes placeholder",
+			key:      "user:1001:profile",
+			expected: "user:{id}:profile",
+		},
+		{
+			name:     "uuid segment becomes placeholder",
+			key:      "session:550e8400-e29b-41d4-a716-446655440000:data",
+			expected: "session:{uuid}:data",
+		},
+		{
+			name:     "non-variable segments are preserved",
+			key:      "config:feature-flags",
+			expected: "config:feature-flags",
+		},
+		{
+			name:     "multiple numeric segments each get replaced",
+			key:      "org:42:user:1001",
+			expected: "org:{id}:user:{id}",
+		},
+		{
+			name:     "no delimiter in key returns it unchanged",
+			key:      "standalone",
+			expected: "standalone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := models.KeyTemplate(tt.key, ":", "id", "uuid"); got != tt.expected {
+				t.Errorf("KeyTemplate(%q) = %q, want %q", tt.key, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestKeyTemplate_CustomPlaceholders(t *testing.T) {
+	got := models.KeyTemplate("user:1001:profile", ":", "n", "u")
+	want := "user:{n}:profile"
+	if got != want {
+		t.Errorf("KeyTemplate() = %q, want %q", got, want)
+	}
+}
+
+func TestKeyTemplate_EmptyDelimiter(t *testing.T) {
+	key := "user:1001:profile"
+	if got := models.KeyTemplate(key, "", "id", "uuid"); got != key {
+		t.Errorf("KeyTemplate() with empty delimiter = %q, want unchanged %q", got, key)
+	}
+}
@@ -0,0 +1,66 @@
+package models
+
+import "github.com/mattn/go-runewidth"
+
+// TruncateWidth shortens s, from the end, to at most maxWidth terminal
+// display columns, accounting for wide (CJK, emoji) runes rather than byte
+// or rune count, and appends "..." if anything was cut. Truncation never
+// splits a rune (or a multi-rune grapheme cluster), so wide characters
+// can't be chopped into mojibake. Returns s unchanged if it already fits.
+func TruncateWidth(s string, maxWidth int) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if runewidth.StringWidth(s) <= maxWidth {
+		return s
+	}
+	if maxWidth <= 3 {
+		return runewidth.Truncate(s, maxWidth, "")
+	}
+	return runewidth.Truncate(s, maxWidth, "...")
+}
+
+// TruncateMiddleWidth shortens s to at most maxWidth terminal display
+// columns by replacing its middle with "...", preserving the more
+// identifying start and end of the string (e.g. common prefixes and
+// distinguishing suffixes of a key name). Display width, not rune count, is
+// used for the budget, so wide (CJK) characters don't overflow the target
+// width. Returns s unchanged if it already fits.
+func TruncateMiddleWidth(s string, maxWidth int) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if runewidth.StringWidth(s) <= maxWidth {
+		return s
+	}
+	if maxWidth <= 3 {
+		return runewidth.Truncate(s, maxWidth, "")
+	}
+
+	runes := []rune(s)
+	budget := maxWidth - 3 // reserve room for "..."
+	leftBudget := (budget + 1) / 2
+	rightBudget := budget - leftBudget
+
+	leftEnd, leftWidth := 0, 0
+	for leftEnd < len(runes) {
+		w := runewidth.RuneWidth(runes[leftEnd])
+		if leftWidth+w > leftBudget {
+			break
+		}
+		leftWidth += w
+		leftEnd++
+	}
+
+	rightStart, rightWidth := len(runes), 0
+	for rightStart > leftEnd {
+		w := runewidth.RuneWidth(runes[rightStart-1])
+		if rightWidth+w > rightBudget {
+			break
+		}
+		rightWidth += w
+		rightStart--
+	}
+
+	return string(runes[:leftEnd]) + "..." + string(runes[rightStart:])
+}
@@ -0,0 +1,70 @@
+package models_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/nnnkkk7/memtui/models"
+)
+
+func TestTruncateWidth_CJKStringDoesNotSplitRunesAndFitsWidth(t *testing.T) {
+	s := strings.Repeat("漢", 20) // each rune is 2 columns wide
+
+	got := models.TruncateWidth(s, 10)
+
+	if !utf8.ValidString(got) {
+		t.Fatalf("truncated string is not valid UTF-8 (a rune was split): %q", got)
+	}
+	if w := runewidth.StringWidth(got); w > 10 {
+		t.Errorf("expected display width <= 10, got %d (%q)", w, got)
+	}
+	if !strings.HasSuffix(got, "...") {
+		t.Errorf("expected an ellipsis suffix, got %q", got)
+	}
+}
+
+func TestTruncateWidth_ShortStringUnchanged(t *testing.T) {
+	if got := models.TruncateWidth("hello", 10); got != "hello" {
+		t.Errorf("expected unchanged string, got %q", got)
+	}
+}
+
+func TestTruncateMiddleWidth_CJKStringDoesNotSplitRunesAndFitsWidth(t *testing.T) {
+	s := strings.Repeat("漢", 30)
+
+	got := models.TruncateMiddleWidth(s, 11)
+
+	if !utf8.ValidString(got) {
+		t.Fatalf("truncated string is not valid UTF-8 (a rune was split): %q", got)
+	}
+	if w := runewidth.StringWidth(got); w > 11 {
+		t.Errorf("expected display width <= 11, got %d (%q)", w, got)
+	}
+	if !strings.Contains(got, "...") {
+		t.Errorf("expected an ellipsis in the middle, got %q", got)
+	}
+}
+
+func TestTruncateMiddleWidth_PreservesStartAndEnd(t *testing.T) {
+	s := "prefix:user:1234567890:suffix"
+
+	got := models.TruncateMiddleWidth(s, 20)
+
+	if !strings.HasPrefix(got, "prefix") {
+		t.Errorf("expected the result to keep the identifying prefix, got %q", got)
+	}
+	if !strings.HasSuffix(got, "suffix") {
+		t.Errorf("expected the result to keep the identifying suffix, got %q", got)
+	}
+	if w := runewidth.StringWidth(got); w > 20 {
+		t.Errorf("expected display width <= 20, got %d (%q)", w, got)
+	}
+}
+
+func TestTruncateMiddleWidth_ShortStringUnchanged(t *testing.T) {
+	if got := models.TruncateMiddleWidth("hello", 10); got != "hello" {
+		t.Errorf("expected unchanged string, got %q", got)
+	}
+}
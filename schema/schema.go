@@ -0,0 +1,130 @@
+// Package schema provides a minimal JSON Schema validator used to check
+// cached JSON values against a configured schema before the editor saves
+// them. It supports the subset of keywords needed for that: type, required,
+// properties, enum, minimum, and maximum.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Schema is a parsed JSON Schema document.
+type Schema struct {
+	Type       string             `json:"type"`
+	Required   []string           `json:"required"`
+	Properties map[string]*Schema `json:"properties"`
+	Enum       []interface{}      `json:"enum"`
+	Minimum    *float64           `json:"minimum"`
+	Maximum    *float64           `json:"maximum"`
+}
+
+// Parse parses a JSON Schema document from data.
+func Parse(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+	return &s, nil
+}
+
+// ValidateAgainstSchema parses content as JSON and checks it against the
+// schema, returning one error per violation found. A nil result means
+// content conforms.
+func (s *Schema) ValidateAgainstSchema(content []byte) []error {
+	var value interface{}
+	if err := json.Unmarshal(content, &value); err != nil {
+		return []error{fmt.Errorf("invalid JSON: %w", err)}
+	}
+
+	var errs []error
+	s.validate(value, "$", &errs)
+	return errs
+}
+
+func (s *Schema) validate(value interface{}, path string, errs *[]error) {
+	if s.Type != "" && !matchesType(value, s.Type) {
+		*errs = append(*errs, fmt.Errorf("%s: expected type %q, got %s", path, s.Type, jsonTypeName(value)))
+		return
+	}
+
+	if len(s.Enum) > 0 && !inEnum(value, s.Enum) {
+		*errs = append(*errs, fmt.Errorf("%s: value is not one of the allowed values", path))
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				*errs = append(*errs, fmt.Errorf("%s: missing required property %q", path, name))
+			}
+		}
+		for name, propSchema := range s.Properties {
+			if propValue, ok := v[name]; ok {
+				propSchema.validate(propValue, path+"."+name, errs)
+			}
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			*errs = append(*errs, fmt.Errorf("%s: %v is less than minimum %v", path, v, *s.Minimum))
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			*errs = append(*errs, fmt.Errorf("%s: %v is greater than maximum %v", path, v, *s.Maximum))
+		}
+	}
+}
+
+func matchesType(value interface{}, typeName string) bool {
+	switch typeName {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == float64(int64(n))
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	default:
+		return true
+	}
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	default:
+		return "unknown"
+	}
+}
+
+func inEnum(value interface{}, enum []interface{}) bool {
+	for _, candidate := range enum {
+		if fmt.Sprint(candidate) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package schema_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/schema"
+)
+
+const userSchema = `{
+	"type": "object",
+	"required": ["id", "name"],
+	"properties": {
+		"id": {"type": "integer", "minimum": 0},
+		"name": {"type": "string"},
+		"role": {"type": "string", "enum": ["admin", "member"]}
+	}
+}`
+
+func TestSchema_ValidateAgainstSchema_ConformingJSONPasses(t *testing.T) {
+	s, err := schema.Parse([]byte(userSchema))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	errs := s.ValidateAgainstSchema([]byte(`{"id": 1, "name": "ada", "role": "admin"}`))
+	if len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+}
+
+func TestSchema_ValidateAgainstSchema_ReportsViolations(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "missing required property",
+			content: `{"id": 1}`,
+			want:    `missing required property "name"`,
+		},
+		{
+			name:    "wrong type",
+			content: `{"id": "not a number", "name": "ada"}`,
+			want:    `expected type "integer"`,
+		},
+		{
+			name:    "value below minimum",
+			content: `{"id": -1, "name": "ada"}`,
+			want:    "less than minimum",
+		},
+		{
+			name:    "value not in enum",
+			content: `{"id": 1, "name": "ada", "role": "superuser"}`,
+			want:    "not one of the allowed values",
+		},
+		{
+			name:    "invalid JSON",
+			content: `{not json`,
+			want:    "invalid JSON",
+		},
+	}
+
+	s, err := schema.Parse([]byte(userSchema))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := s.ValidateAgainstSchema([]byte(tt.content))
+			if len(errs) == 0 {
+				t.Fatal("expected at least one validation error")
+			}
+			joined := errs[0].Error()
+			for _, e := range errs[1:] {
+				joined += "; " + e.Error()
+			}
+			if !strings.Contains(joined, tt.want) {
+				t.Errorf("expected an error containing %q, got %v", tt.want, errs)
+			}
+		})
+	}
+}
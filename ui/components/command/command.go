@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
 )
 
 // Command represents a single command that can be executed from the palette.
@@ -122,12 +123,24 @@ func DefaultCommands() []Command {
 			Shortcut:    "r",
 			Action:      func() tea.Msg { return RefreshKeysMsg{} },
 		},
+		{
+			Name:        "Reconnect",
+			Description: "Disconnect and reconnect to the current server",
+			Shortcut:    "o",
+			Action:      func() tea.Msg { return ReconnectMsg{} },
+		},
 		{
 			Name:        "Delete key",
 			Description: "Delete the selected key",
 			Shortcut:    "d",
 			Action:      func() tea.Msg { return DeleteKeyMsg{} },
 		},
+		{
+			Name:        "Extend TTL (selected)",
+			Description: "Extend the TTL of every selected key by a given number of seconds",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ExtendTTLSelectedMsg{} },
+		},
 		{
 			Name:        "New key",
 			Description: "Create a new key-value pair",
@@ -152,6 +165,36 @@ func DefaultCommands() []Command {
 			Shortcut:    "",
 			Action:      func() tea.Msg { return ToggleThemeMsg{} },
 		},
+		{
+			Name:        "Toggle size column",
+			Description: "Show or hide the key list's size column",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ToggleColumnMsg{Column: keylist.ColumnSize} },
+		},
+		{
+			Name:        "Toggle TTL column",
+			Description: "Show or hide the key list's time-to-live column",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ToggleColumnMsg{Column: keylist.ColumnTTL} },
+		},
+		{
+			Name:        "Toggle slab column",
+			Description: "Show or hide the key list's slab class column",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ToggleColumnMsg{Column: keylist.ColumnSlab} },
+		},
+		{
+			Name:        "Toggle node column",
+			Description: "Show or hide the key list's source node column",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ToggleColumnMsg{Column: keylist.ColumnNode} },
+		},
+		{
+			Name:        "Toggle last-access column",
+			Description: "Show or hide the key list's last-access column",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ToggleColumnMsg{Column: keylist.ColumnLastAccess} },
+		},
 		{
 			Name:        "Show help",
 			Description: "Display keyboard shortcuts help",
@@ -164,24 +207,129 @@ func DefaultCommands() []Command {
 			Shortcut:    "q",
 			Action:      func() tea.Msg { return QuitMsg{} },
 		},
+		{
+			Name:        "Go to key",
+			Description: "Select a key directly by its exact name, expanding any collapsed folders",
+			Shortcut:    ":",
+			Action:      func() tea.Msg { return GotoKeyMsg{} },
+		},
 		{
 			Name:        "Filter keys",
 			Description: "Enter key filter/search mode",
 			Shortcut:    "/",
 			Action:      func() tea.Msg { return FilterKeysMsg{} },
 		},
+		{
+			Name:        "Reveal in tree",
+			Description: "Clear the active filter and locate the selected key in the full tree, expanding its ancestors",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return RevealInTreeMsg{} },
+		},
 		{
 			Name:        "Copy value",
 			Description: "Copy the selected value to clipboard",
 			Shortcut:    "c",
 			Action:      func() tea.Msg { return CopyValueMsg{} },
 		},
+		{
+			Name:        "Add server",
+			Description: "Add a new Memcached server to the configured server list",
+			Shortcut:    "S",
+			Action:      func() tea.Msg { return AddServerMsg{} },
+		},
+		{
+			Name:        "Copy formatted value",
+			Description: "Copy the viewer's current formatted content (hex, JSON, etc.) to clipboard",
+			Shortcut:    "C",
+			Action:      func() tea.Msg { return CopyFormattedValueMsg{} },
+		},
+		{
+			Name:        "Copy connection string",
+			Description: "Copy the current server's name and address to clipboard",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return CopyConnectionStringMsg{} },
+		},
+		{
+			Name:        "Copy key path template",
+			Description: "Copy the selected key's path with numeric/UUID segments replaced by placeholders",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return CopyKeyTemplateMsg{} },
+		},
+		{
+			Name:        "Snapshot value to new key",
+			Description: "Copy the selected value to a new, timestamped key",
+			Shortcut:    "Y",
+			Action:      func() tea.Msg { return SnapshotValueMsg{} },
+		},
+		{
+			Name:        "Diff selected keys",
+			Description: "Show a unified diff of two selected keys' values",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return DiffSelectedKeysMsg{} },
+		},
+		{
+			Name:        "Manage servers",
+			Description: "View configured servers and add, remove, or set the default",
+			Shortcut:    "M",
+			Action:      func() tea.Msg { return ManageServersMsg{} },
+		},
+		{
+			Name:        "Show size histogram",
+			Description: "Display a bar chart of value sizes across all enumerated keys",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ShowSizeHistogramMsg{} },
+		},
+		{
+			Name:        "Show activity",
+			Description: "View the audit trail of created, edited, and deleted keys this session",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ShowActivityMsg{} },
+		},
+		{
+			Name:        "Recently viewed keys",
+			Description: "Jump back to a recently viewed key with fuzzy filtering",
+			Shortcut:    "Ctrl+E",
+			Action:      func() tea.Msg { return QuickSwitchMsg{} },
+		},
+		{
+			Name:        "Show unsaved buffers",
+			Description: "List edits that were closed without saving",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ShowUnsavedBuffersMsg{} },
+		},
+		{
+			Name:        "Save all buffers",
+			Description: "Save every unsaved editor buffer",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return SaveAllBuffersMsg{} },
+		},
+		{
+			Name:        "Clear caches",
+			Description: "Empty the cached values held for value search, so the next access re-fetches from the server",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return ClearCachesMsg{} },
+		},
+		{
+			Name:        "Dump stats",
+			Description: "Write a snapshot of stats, stats items, and stats slabs to a file",
+			Shortcut:    "",
+			Action:      func() tea.Msg { return DumpStatsMsg{} },
+		},
+		{
+			Name:        "Cycle detected type",
+			Description: "Re-detect the current value, rotating to the next plausible interpretation when auto-detection guessed wrong",
+			Shortcut:    "N",
+			Action:      func() tea.Msg { return CycleDetectedTypeMsg{} },
+		},
 	}
 }
 
 // RefreshKeysMsg requests the key list to be refreshed.
 type RefreshKeysMsg struct{}
 
+// ReconnectMsg requests disconnecting and reconnecting to the current server.
+type ReconnectMsg struct{}
+
 // DeleteKeyMsg requests deletion of the selected key.
 type DeleteKeyMsg struct{}
 
@@ -197,6 +345,11 @@ type ShowStatsMsg struct{}
 // ToggleThemeMsg requests toggling between light and dark themes.
 type ToggleThemeMsg struct{}
 
+// ToggleColumnMsg requests toggling a key list metadata column on or off.
+type ToggleColumnMsg struct {
+	Column keylist.Column
+}
+
 // ShowHelpMsg requests display of the help screen.
 type ShowHelpMsg struct{}
 
@@ -206,9 +359,71 @@ type QuitMsg struct{}
 // FilterKeysMsg requests key filtering mode.
 type FilterKeysMsg struct{}
 
+// RevealInTreeMsg requests clearing the active filter and locating the
+// selected key in the full tree, expanding its ancestors.
+type RevealInTreeMsg struct{}
+
+// GotoKeyMsg requests the "go to key" prompt for selecting a key by its
+// exact name.
+type GotoKeyMsg struct{}
+
 // CopyValueMsg requests copying the current value to clipboard.
 type CopyValueMsg struct{}
 
+// CopyFormattedValueMsg requests copying the viewer's current formatted
+// content (the active view mode's rendering) to clipboard.
+type CopyFormattedValueMsg struct{}
+
+// CopyConnectionStringMsg requests copying the current server's name and
+// address to clipboard.
+type CopyConnectionStringMsg struct{}
+
+// CopyKeyTemplateMsg requests copying the selected key's path template
+// (see models.KeyTemplate) to clipboard.
+type CopyKeyTemplateMsg struct{}
+
+// DiffSelectedKeysMsg requests a unified diff of exactly two selected keys'
+// values.
+type DiffSelectedKeysMsg struct{}
+
+// SnapshotValueMsg requests the "copy value to new key" dialog.
+type SnapshotValueMsg struct{}
+
+// AddServerMsg requests starting the add-server dialog flow.
+type AddServerMsg struct{}
+
+// ManageServersMsg requests opening the server manager screen.
+type ManageServersMsg struct{}
+
+// ShowUnsavedBuffersMsg requests a list of unsaved editor buffers.
+type ShowUnsavedBuffersMsg struct{}
+
+// SaveAllBuffersMsg requests saving every unsaved editor buffer.
+type SaveAllBuffersMsg struct{}
+
+// ClearCachesMsg requests that in-memory value/preview caches be emptied.
+type ClearCachesMsg struct{}
+
+// DumpStatsMsg requests writing a stats snapshot to a file.
+type DumpStatsMsg struct{}
+
+// ExtendTTLSelectedMsg requests extending the TTL of every currently
+// selected key.
+type ExtendTTLSelectedMsg struct{}
+
+// ShowActivityMsg requests display of the in-session audit trail.
+type ShowActivityMsg struct{}
+
+// ShowSizeHistogramMsg requests display of the value-size histogram.
+type ShowSizeHistogramMsg struct{}
+
+// QuickSwitchMsg requests opening the recently-viewed-keys quick switcher.
+type QuickSwitchMsg struct{}
+
+// CycleDetectedTypeMsg requests re-detecting the currently viewed value,
+// rotating to the next plausible interpretation of it.
+type CycleDetectedTypeMsg struct{}
+
 // Visible returns whether the command palette is currently visible.
 func (p *CommandPalette) Visible() bool {
 	return p.visible
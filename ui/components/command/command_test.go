@@ -391,6 +391,10 @@ func TestDefaultCommands(t *testing.T) {
 		{"Quit", "q"},
 		{"Filter keys", "/"},
 		{"Copy value", "c"},
+		{"Copy formatted value", "C"},
+		{"Add server", "S"},
+		{"Manage servers", "M"},
+		{"Show activity", ""},
 	}
 
 	for _, expected := range expectedCommands {
@@ -145,15 +145,26 @@ func (d *ConfirmDialog) confirm(result bool) tea.Cmd {
 func (d *ConfirmDialog) View() string {
 	var b strings.Builder
 
+	overlayWidth := 50
+	if d.width > 0 && overlayWidth > d.width-4 {
+		overlayWidth = d.width - 4
+	}
+	// Account for the overlay's own horizontal padding (Padding(1, 2)) so
+	// long messages wrap within the visible box instead of overflowing it.
+	contentWidth := overlayWidth - 4
+	if contentWidth < 1 {
+		contentWidth = 1
+	}
+
 	// Title
 	b.WriteString(d.titleStyle.Render(d.title))
 	b.WriteString("\n")
 
-	// Message
-	b.WriteString(d.messageStyle.Render(d.message))
+	// Message, word-wrapped to the available content width
+	b.WriteString(d.messageStyle.Width(contentWidth).Render(d.message))
 	b.WriteString("\n\n")
 
-	// Buttons
+	// Buttons, re-centered within the content width
 	var yesButton, noButton string
 	if d.focusedYes {
 		yesButton = d.buttonFocusedStyle.Render("[ Yes ]")
@@ -162,7 +173,8 @@ func (d *ConfirmDialog) View() string {
 		yesButton = d.buttonStyle.Render("[ Yes ]")
 		noButton = d.buttonFocusedStyle.Render("[ No ]")
 	}
-	b.WriteString(yesButton + "  " + noButton)
+	buttons := yesButton + "  " + noButton
+	b.WriteString(lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center).Render(buttons))
 	b.WriteString("\n")
 
 	// Hint
@@ -170,14 +182,7 @@ func (d *ConfirmDialog) View() string {
 
 	content := b.String()
 
-	// Apply overlay style
-	if d.width > 0 {
-		overlayWidth := 50
-		if overlayWidth > d.width-4 {
-			overlayWidth = d.width - 4
-		}
-		d.overlayStyle = d.overlayStyle.Width(overlayWidth)
-	}
+	d.overlayStyle = d.overlayStyle.Width(overlayWidth)
 
 	return d.overlayStyle.Render(content)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/nnnkkk7/memtui/ui/components/dialog"
 )
 
@@ -356,3 +357,45 @@ func TestConfirmDialog_View_ContainsHint(t *testing.T) {
 		}
 	}
 }
+
+func TestConfirmDialog_LongMessage_WrapsAtSmallWidth(t *testing.T) {
+	longMsg := "Are you sure you want to permanently delete the key 'session:abcdef0123456789:very:long:key:name' from the cache? This cannot be undone."
+	d := dialog.New("Delete Key", longMsg)
+	d.SetSize(40, 20)
+
+	view := d.View()
+	lines := strings.Split(view, "\n")
+
+	longest := 0
+	for _, line := range lines {
+		if w := lipgloss.Width(line); w > longest {
+			longest = w
+		}
+	}
+	if longest > 40 {
+		t.Errorf("expected no line wider than the 40-column terminal, got width %d in view:\n%s", longest, view)
+	}
+
+	// The message text itself must still be fully present, just split
+	// across multiple lines.
+	for _, word := range []string{"permanently", "delete", "cannot", "undone"} {
+		if !strings.Contains(view, word) {
+			t.Errorf("expected wrapped message to still contain %q, got:\n%s", word, view)
+		}
+	}
+}
+
+func TestConfirmDialog_Buttons_StayWithinBoundsAtSmallWidth(t *testing.T) {
+	d := dialog.New("Title", "Short message")
+	d.SetSize(30, 20)
+
+	view := d.View()
+	for _, line := range strings.Split(view, "\n") {
+		if w := lipgloss.Width(line); w > 30 {
+			t.Errorf("expected no line wider than the 30-column terminal, got width %d: %q", w, line)
+		}
+	}
+	if !strings.Contains(view, "Yes") || !strings.Contains(view, "No") {
+		t.Error("expected both buttons to still render at a small width")
+	}
+}
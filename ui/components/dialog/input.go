@@ -27,6 +27,7 @@ type InputDialog struct {
 	validator       ValidatorFunc
 	context         interface{}
 	validationError string
+	liveValidate    bool
 	width           int
 	height          int
 
@@ -102,6 +103,14 @@ func (d *InputDialog) WithValidator(validator ValidatorFunc) *InputDialog {
 	return d
 }
 
+// WithLiveValidation enables running the validator on every keystroke, so
+// ValidationError() reflects the current (possibly incomplete) value
+// instead of only updating on submit.
+func (d *InputDialog) WithLiveValidation() *InputDialog {
+	d.liveValidate = true
+	return d
+}
+
 // WithContext sets context data that will be returned in the result message.
 func (d *InputDialog) WithContext(context interface{}) *InputDialog {
 	d.context = context
@@ -146,8 +155,17 @@ func (d *InputDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	d.textInput, cmd = d.textInput.Update(msg)
 
-	// Clear validation error when user types
-	d.validationError = ""
+	if d.liveValidate && d.validator != nil {
+		// Re-validate on every keystroke without blocking further typing
+		if err := d.validator(d.textInput.Value()); err != nil {
+			d.validationError = err.Error()
+		} else {
+			d.validationError = ""
+		}
+	} else {
+		// Clear validation error when user types
+		d.validationError = ""
+	}
 
 	return d, cmd
 }
@@ -188,8 +206,19 @@ func (d *InputDialog) cancel() tea.Cmd {
 func (d *InputDialog) View() string {
 	var b strings.Builder
 
+	overlayWidth := 50
+	if d.width > 0 && overlayWidth > d.width-4 {
+		overlayWidth = d.width - 4
+	}
+	// Account for the overlay's own horizontal padding (Padding(1, 2)) so a
+	// long title or validation error wraps within the visible box.
+	contentWidth := overlayWidth - 4
+	if contentWidth < 1 {
+		contentWidth = 1
+	}
+
 	// Title
-	b.WriteString(d.titleStyle.Render(d.title))
+	b.WriteString(d.titleStyle.Width(contentWidth).Render(d.title))
 	b.WriteString("\n\n")
 
 	// Text input
@@ -198,7 +227,7 @@ func (d *InputDialog) View() string {
 
 	// Validation error
 	if d.validationError != "" {
-		b.WriteString(d.errorStyle.Render(d.validationError))
+		b.WriteString(d.errorStyle.Width(contentWidth).Render(d.validationError))
 		b.WriteString("\n")
 	}
 
@@ -207,14 +236,7 @@ func (d *InputDialog) View() string {
 
 	content := b.String()
 
-	// Apply overlay style
-	if d.width > 0 {
-		overlayWidth := 50
-		if overlayWidth > d.width-4 {
-			overlayWidth = d.width - 4
-		}
-		d.overlayStyle = d.overlayStyle.Width(overlayWidth)
-	}
+	d.overlayStyle = d.overlayStyle.Width(overlayWidth)
 
 	return d.overlayStyle.Render(content)
 }
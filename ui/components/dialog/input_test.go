@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/nnnkkk7/memtui/ui/components/dialog"
 )
 
@@ -272,6 +273,34 @@ func TestInputDialog_Validator_TriggeredOnTyping(t *testing.T) {
 	// For now, we accept either behavior
 }
 
+func TestInputDialog_LiveValidation_UpdatesErrorWhileTyping(t *testing.T) {
+	validator := func(s string) error {
+		if strings.Contains(s, " ") {
+			return &ValidationError{Message: "cannot contain spaces"}
+		}
+		return nil
+	}
+	d := dialog.NewInput("Enter Key Name").WithValidator(validator).WithLiveValidation()
+	d.Init()
+
+	model, _ := d.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("user ")})
+	d = model.(*dialog.InputDialog)
+
+	if d.Value() != "user " {
+		t.Errorf("expected value to keep updating while typing, got %q", d.Value())
+	}
+	if d.ValidationError() == "" {
+		t.Error("expected a live validation error for a space in the key name")
+	}
+
+	// Fixing the input should clear the error without needing to submit
+	model, _ = d.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	d = model.(*dialog.InputDialog)
+	if d.ValidationError() != "" {
+		t.Errorf("expected validation error to clear once input becomes valid, got %q", d.ValidationError())
+	}
+}
+
 func TestInputDialog_View_ShowsValidationError(t *testing.T) {
 	validator := func(s string) error {
 		if s == "" {
@@ -363,3 +392,15 @@ type ValidationError struct {
 func (e *ValidationError) Error() string {
 	return e.Message
 }
+
+func TestInputDialog_LongTitle_WrapsAtSmallWidth(t *testing.T) {
+	d := dialog.NewInput("Enter the new value for this very long key name that will not fit")
+	d.SetSize(30, 20)
+
+	view := d.View()
+	for _, line := range strings.Split(view, "\n") {
+		if w := lipgloss.Width(line); w > 30 {
+			t.Errorf("expected no line wider than the 30-column terminal, got width %d: %q", w, line)
+		}
+	}
+}
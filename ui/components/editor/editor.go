@@ -6,11 +6,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/nnnkkk7/memtui/viewer"
 )
 
 // EditorMode represents the editor display/editing mode.
@@ -21,6 +24,9 @@ const (
 	ModeText EditorMode = iota
 	// ModeJSON is JSON editing mode with formatting support.
 	ModeJSON
+	// ModeForm renders a flat JSON object as labeled inputs, one per
+	// top-level field. See EnterFormMode.
+	ModeForm
 )
 
 // String returns the string representation of the editor mode.
@@ -30,6 +36,8 @@ func (m EditorMode) String() string {
 		return "Text"
 	case ModeJSON:
 		return "JSON"
+	case ModeForm:
+		return "Form"
 	default:
 		return "Unknown"
 	}
@@ -40,25 +48,46 @@ type EditorSaveMsg struct {
 	Key         string
 	Value       []byte
 	OriginalCAS uint64
+	Flags       uint32
 }
 
 // EditorCancelMsg is returned when the user cancels editing.
 type EditorCancelMsg struct{}
 
+// maxUndoEntries bounds the undo history so a long editing session can't
+// grow it without limit; the oldest entries are dropped first.
+const maxUndoEntries = 100
+
 // Editor is a component for editing Memcached values.
 type Editor struct {
 	key           string
 	originalValue []byte
 	cas           uint64
+	flags         uint32
 	textarea      textarea.Model
+	flagsInput    textinput.Model
+	editingFlags  bool
 	mode          EditorMode
 	dirty         bool
 	width         int
 	height        int
+	jsonIndent    string
+	sortJSONKeys  bool
+
+	// formFields holds the flat-JSON-object fields rendered as inputs while
+	// mode == ModeForm (see EnterFormMode); formFocus is the focused index.
+	formFields []*formField
+	formFocus  int
 
 	// Track initial content for dirty detection
 	initialContent string
 
+	// undoStack holds content snapshots taken before each edit that changed
+	// the textarea's value, most recent last. A single keystroke and a
+	// whole pasted block each push exactly one entry, since both arrive as
+	// a single message and are applied to the textarea in one Update call.
+	undoStack []string
+
 	// Styles
 	headerStyle   lipgloss.Style
 	metaStyle     lipgloss.Style
@@ -79,13 +108,20 @@ func New(key string, value []byte) *Editor {
 	ta.SetWidth(60)
 	ta.SetHeight(15)
 
+	fi := textinput.New()
+	fi.Placeholder = "flags (leave blank to keep current)"
+	fi.CharLimit = 10
+	fi.Width = 20
+
 	return &Editor{
 		key:            key,
 		originalValue:  value,
 		textarea:       ta,
+		flagsInput:     fi,
 		mode:           ModeText,
 		dirty:          false,
 		initialContent: string(value),
+		jsonIndent:     "  ",
 		headerStyle: lipgloss.NewStyle().
 			Bold(true).
 			Foreground(lipgloss.Color("#528BFF")),
@@ -129,6 +165,13 @@ func (e *Editor) SetCAS(cas uint64) {
 	e.cas = cas
 }
 
+// SetFlags sets the flags value loaded from the existing item. This becomes
+// the default used when saving if the flags field is left unchanged.
+func (e *Editor) SetFlags(flags uint32) {
+	e.flags = flags
+	e.flagsInput.SetValue(strconv.FormatUint(uint64(flags), 10))
+}
+
 // Mode returns the current editor mode.
 func (e *Editor) Mode() EditorMode {
 	return e.mode
@@ -164,12 +207,41 @@ func (e *Editor) SetSize(width, height int) {
 	e.textarea.SetHeight(taHeight)
 }
 
-// FormatJSON formats the current content as indented JSON.
+// SetJSONIndent changes the indentation string (e.g. "  ", "    ", or "\t")
+// used by FormatJSON.
+func (e *Editor) SetJSONIndent(indent string) {
+	e.jsonIndent = indent
+}
+
+// SetSortKeys enables or disables alphabetical sorting of JSON object keys
+// in FormatJSON. Array order is always preserved.
+func (e *Editor) SetSortKeys(sort bool) {
+	e.sortJSONKeys = sort
+}
+
+// FormatJSON formats the current content as indented JSON, sorting object
+// keys alphabetically if SetSortKeys(true) has been called.
 func (e *Editor) FormatJSON() error {
 	content := e.textarea.Value()
 
+	if e.sortJSONKeys {
+		var v interface{}
+		dec := json.NewDecoder(strings.NewReader(content))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+		formatted, err := json.MarshalIndent(v, "", e.jsonIndent)
+		if err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+		e.textarea.SetValue(string(formatted))
+		e.checkDirty()
+		return nil
+	}
+
 	var buf bytes.Buffer
-	err := json.Indent(&buf, []byte(content), "", "  ")
+	err := json.Indent(&buf, []byte(content), "", e.jsonIndent)
 	if err != nil {
 		return fmt.Errorf("invalid JSON: %w", err)
 	}
@@ -179,11 +251,105 @@ func (e *Editor) FormatJSON() error {
 	return nil
 }
 
+// Base64Encode base64-encodes the current buffer in place, pushing an undo
+// snapshot first.
+func (e *Editor) Base64Encode() {
+	before := e.textarea.Value()
+	e.pushUndo(before)
+	e.textarea.SetValue(string(viewer.Base64Encode([]byte(before))))
+	e.checkDirty()
+}
+
+// Base64Decode base64-decodes the current buffer in place, pushing an undo
+// snapshot first. Returns an error, leaving the buffer unchanged, if the
+// content isn't valid base64.
+func (e *Editor) Base64Decode() error {
+	before := e.textarea.Value()
+	decoded, err := viewer.Base64Decode([]byte(before))
+	if err != nil {
+		return fmt.Errorf("invalid base64: %w", err)
+	}
+	e.pushUndo(before)
+	e.textarea.SetValue(string(decoded))
+	e.checkDirty()
+	return nil
+}
+
+// GzipCompress gzip-compresses the current buffer in place, pushing an undo
+// snapshot first. The compressed bytes are stored base64-encoded, since the
+// textarea buffer is a Go string and arbitrary compressed bytes aren't
+// valid UTF-8.
+func (e *Editor) GzipCompress() error {
+	before := e.textarea.Value()
+	compressed, err := viewer.CompressGzip([]byte(before))
+	if err != nil {
+		return fmt.Errorf("failed to gzip: %w", err)
+	}
+	e.pushUndo(before)
+	e.textarea.SetValue(string(viewer.Base64Encode(compressed)))
+	e.checkDirty()
+	return nil
+}
+
+// GzipDecompress gunzips the current buffer in place, pushing an undo
+// snapshot first. The buffer is expected to hold base64-encoded gzip data,
+// as produced by GzipCompress. Returns an error, leaving the buffer
+// unchanged, if the content isn't valid base64 or isn't valid gzip data.
+func (e *Editor) GzipDecompress() error {
+	before := e.textarea.Value()
+	compressed, err := viewer.Base64Decode([]byte(before))
+	if err != nil {
+		return fmt.Errorf("invalid base64: %w", err)
+	}
+	decompressed, err := viewer.DecompressGzip(compressed)
+	if err != nil {
+		return fmt.Errorf("invalid gzip data: %w", err)
+	}
+	e.pushUndo(before)
+	e.textarea.SetValue(string(decompressed))
+	e.checkDirty()
+	return nil
+}
+
 // checkDirty updates the dirty flag based on current content.
 func (e *Editor) checkDirty() {
 	e.dirty = e.textarea.Value() != e.initialContent
 }
 
+// ResetDirtyBaseline treats the current content as the initial content,
+// clearing the dirty flag. Used after a silent auto-format on open so only
+// a subsequent user edit marks the buffer dirty.
+func (e *Editor) ResetDirtyBaseline() {
+	e.initialContent = e.textarea.Value()
+	e.dirty = false
+}
+
+// pushUndo records content as an undo snapshot, trimming the oldest entry
+// once maxUndoEntries is exceeded.
+func (e *Editor) pushUndo(content string) {
+	e.undoStack = append(e.undoStack, content)
+	if len(e.undoStack) > maxUndoEntries {
+		e.undoStack = e.undoStack[len(e.undoStack)-maxUndoEntries:]
+	}
+}
+
+// undo restores the most recent undo snapshot, if any.
+func (e *Editor) undo() {
+	if len(e.undoStack) == 0 {
+		return
+	}
+	last := e.undoStack[len(e.undoStack)-1]
+	e.undoStack = e.undoStack[:len(e.undoStack)-1]
+	e.textarea.SetValue(last)
+	e.checkDirty()
+}
+
+// UndoEntries returns the number of snapshots currently on the undo stack
+// (for testing).
+func (e *Editor) UndoEntries() int {
+	return len(e.undoStack)
+}
+
 // Init initializes the editor and returns the initial command.
 func (e *Editor) Init() tea.Cmd {
 	return textarea.Blink
@@ -193,6 +359,23 @@ func (e *Editor) Init() tea.Cmd {
 func (e *Editor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if e.editingFlags {
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch msg.Type {
+			case tea.KeyEsc, tea.KeyEnter:
+				// Leave flags editing and return focus to the textarea
+				e.editingFlags = false
+				e.flagsInput.Blur()
+				e.textarea.Focus()
+				return e, nil
+			}
+		}
+		var cmd tea.Cmd
+		e.flagsInput, cmd = e.flagsInput.Update(msg)
+		return e, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.Type {
@@ -208,15 +391,64 @@ func (e *Editor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				_ = e.FormatJSON()
 			}
 			return e, nil
+		case tea.KeyCtrlG:
+			// Edit the flags field
+			e.editingFlags = true
+			e.textarea.Blur()
+			e.flagsInput.Focus()
+			return e, textinput.Blink
+		case tea.KeyCtrlZ:
+			// Undo the last edit
+			e.undo()
+			return e, nil
+		case tea.KeyCtrlB:
+			// Base64-encode the buffer in place
+			e.Base64Encode()
+			return e, nil
+		case tea.KeyCtrlD:
+			// Base64-decode the buffer in place
+			_ = e.Base64Decode()
+			return e, nil
+		case tea.KeyCtrlX:
+			// Gzip-compress the buffer in place
+			_ = e.GzipCompress()
+			return e, nil
+		case tea.KeyCtrlY:
+			// Gunzip the buffer in place
+			_ = e.GzipDecompress()
+			return e, nil
+		case tea.KeyCtrlT:
+			// Toggle form mode for flat JSON objects
+			if e.mode == ModeForm {
+				e.ExitFormMode()
+			} else {
+				_ = e.EnterFormMode()
+			}
+			return e, nil
+		case tea.KeyTab, tea.KeyShiftTab:
+			if e.mode == ModeForm {
+				e.focusNextFormField(msg.Type == tea.KeyTab)
+				return e, nil
+			}
 		}
 	}
 
-	// Pass messages to textarea
+	if e.mode == ModeForm {
+		return e, e.updateFocusedFormField(msg)
+	}
+
+	// Pass messages to textarea, snapshotting the prior content so a
+	// single keystroke or an entire pasted block (both delivered as one
+	// message) each become exactly one undo entry
+	before := e.textarea.Value()
 	var cmd tea.Cmd
 	e.textarea, cmd = e.textarea.Update(msg)
 	if cmd != nil {
 		cmds = append(cmds, cmd)
 	}
+	if after := e.textarea.Value(); after != before {
+		e.pushUndo(before)
+	}
 
 	// Check if content changed
 	e.checkDirty()
@@ -224,13 +456,26 @@ func (e *Editor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return e, tea.Batch(cmds...)
 }
 
+// CurrentFlags returns the flags that would be used if the editor were
+// saved right now: the value typed into the flags field, or the originally
+// loaded flags if the field doesn't hold a valid number.
+func (e *Editor) CurrentFlags() uint32 {
+	if parsed, err := strconv.ParseUint(strings.TrimSpace(e.flagsInput.Value()), 10, 32); err == nil {
+		return uint32(parsed)
+	}
+	return e.flags
+}
+
 // save creates a command that returns EditorSaveMsg.
 func (e *Editor) save() tea.Cmd {
+	flags := e.CurrentFlags()
+
 	return func() tea.Msg {
 		return EditorSaveMsg{
 			Key:         e.key,
 			Value:       []byte(e.textarea.Value()),
 			OriginalCAS: e.cas,
+			Flags:       flags,
 		}
 	}
 }
@@ -263,10 +508,17 @@ func (e *Editor) View() string {
 
 	// Metadata line
 	contentSize := len(e.textarea.Value())
-	meta := fmt.Sprintf("Size: %d bytes | Mode: %s", contentSize, e.mode.String())
+	meta := fmt.Sprintf("Size: %d bytes | Mode: %s | Flags: %s", contentSize, e.mode.String(), e.flagsInput.Value())
 	b.WriteString(e.metaStyle.Render(meta))
 	b.WriteString("\n")
 
+	// Flags input, shown while editing
+	if e.editingFlags {
+		b.WriteString(e.metaStyle.Render("Flags: "))
+		b.WriteString(e.flagsInput.View())
+		b.WriteString("\n")
+	}
+
 	// Separator
 	sepWidth := e.width - 4
 	if sepWidth > 60 {
@@ -278,17 +530,30 @@ func (e *Editor) View() string {
 	b.WriteString(strings.Repeat("─", sepWidth))
 	b.WriteString("\n")
 
-	// Textarea
-	b.WriteString(e.textarea.View())
+	// Textarea, or labeled field inputs in form mode
+	if e.mode == ModeForm {
+		b.WriteString(e.formView())
+	} else {
+		b.WriteString(e.textarea.View())
+	}
 	b.WriteString("\n")
 
 	// Hints
 	hints := []string{
 		"Ctrl+S: Save",
 		"Esc: Cancel",
+		"Ctrl+G: Edit Flags",
+		"Ctrl+Z: Undo",
+		"Ctrl+B: Base64 Encode",
+		"Ctrl+D: Base64 Decode",
+		"Ctrl+X: Gzip",
+		"Ctrl+Y: Gunzip",
 	}
 	if e.mode == ModeJSON {
-		hints = append(hints, "Ctrl+F: Format JSON")
+		hints = append(hints, "Ctrl+F: Format JSON", "Ctrl+T: Form Mode")
+	}
+	if e.mode == ModeForm {
+		hints = append(hints, "Tab: Next Field", "Ctrl+T: Text Mode")
 	}
 	b.WriteString(e.hintStyle.Render(strings.Join(hints, " | ")))
 
@@ -1,6 +1,7 @@
 package editor_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -191,6 +192,72 @@ func TestEditor_SetCAS(t *testing.T) {
 	}
 }
 
+func TestEditor_SetFlags_PreservedWhenUnchanged(t *testing.T) {
+	e := editor.New("mykey", []byte("content"))
+	e.SetFlags(7)
+	e.Init()
+	e.SetSize(80, 24)
+
+	// Modify the value but leave flags untouched
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	e = model.(*editor.Editor)
+
+	_, cmd := e.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	msg := cmd()
+	saveMsg, ok := msg.(editor.EditorSaveMsg)
+	if !ok {
+		t.Fatalf("expected EditorSaveMsg, got %T", msg)
+	}
+
+	if saveMsg.Flags != 7 {
+		t.Errorf("expected loaded flags 7 to be preserved, got %d", saveMsg.Flags)
+	}
+}
+
+func TestEditor_EditFlags_ChangesSavedFlags(t *testing.T) {
+	e := editor.New("mykey", []byte("content"))
+	e.SetFlags(7)
+	e.Init()
+	e.SetSize(80, 24)
+
+	// Enter flags editing mode and replace the value
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	e = model.(*editor.Editor)
+
+	for i := 0; i < 4; i++ {
+		model, _ = e.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		e = model.(*editor.Editor)
+	}
+	model, _ = e.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("99")})
+	e = model.(*editor.Editor)
+
+	// Leave flags editing, then save
+	model, _ = e.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	e = model.(*editor.Editor)
+
+	_, cmd := e.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	msg := cmd()
+	saveMsg, ok := msg.(editor.EditorSaveMsg)
+	if !ok {
+		t.Fatalf("expected EditorSaveMsg, got %T", msg)
+	}
+
+	if saveMsg.Flags != 99 {
+		t.Errorf("expected edited flags 99, got %d", saveMsg.Flags)
+	}
+}
+
+func TestEditor_View_RendersFlags(t *testing.T) {
+	e := editor.New("mykey", []byte("content"))
+	e.SetFlags(42)
+	e.SetSize(80, 24)
+
+	view := e.View()
+	if !strings.Contains(view, "42") {
+		t.Errorf("view should contain loaded flags value, got: %s", view)
+	}
+}
+
 func TestEditor_FormatJSON(t *testing.T) {
 	jsonContent := []byte(`{"name":"test","value":123}`)
 	e := editor.New("mykey", jsonContent)
@@ -220,6 +287,94 @@ func TestEditor_FormatJSON_InvalidJSON(t *testing.T) {
 	}
 }
 
+func TestEditor_ResetDirtyBaseline(t *testing.T) {
+	jsonContent := []byte(`{"name":"test","value":123}`)
+	e := editor.New("mykey", jsonContent)
+	e.SetMode(editor.ModeJSON)
+
+	if err := e.FormatJSON(); err != nil {
+		t.Fatalf("unexpected error formatting JSON: %v", err)
+	}
+	if !e.IsDirty() {
+		t.Fatal("expected FormatJSON to mark the buffer dirty before resetting the baseline")
+	}
+
+	e.ResetDirtyBaseline()
+	if e.IsDirty() {
+		t.Error("expected ResetDirtyBaseline to clear the dirty flag")
+	}
+
+	e.SetContent([]byte("edited"))
+	if !e.IsDirty() {
+		t.Error("expected a subsequent edit to mark the buffer dirty again")
+	}
+}
+
+func TestEditor_SetJSONIndent(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"name":"test"}`))
+	e.SetMode(editor.ModeJSON)
+	e.SetJSONIndent("    ")
+
+	if err := e.FormatJSON(); err != nil {
+		t.Fatalf("unexpected error formatting JSON: %v", err)
+	}
+
+	if !strings.Contains(e.Value(), "    "+`"name"`) {
+		t.Errorf("expected value indented with four spaces, got: %q", e.Value())
+	}
+}
+
+// TestEditor_SetJSONIndent_TabIsExpandedByTextarea documents a limitation
+// inherited from the underlying textarea component: its input sanitizer
+// unconditionally expands literal tab characters to four spaces, so a "tab"
+// indent setting still reaches FormatJSON correctly but is visibly widened
+// once the textarea stores it. True tab output is covered at the formatter
+// level (see viewer.JSONFormatter's tests) and in the read-only viewer,
+// which doesn't go through a textarea.
+func TestEditor_SetJSONIndent_TabIsExpandedByTextarea(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"name":"test"}`))
+	e.SetMode(editor.ModeJSON)
+	e.SetJSONIndent("\t")
+
+	if err := e.FormatJSON(); err != nil {
+		t.Fatalf("unexpected error formatting JSON: %v", err)
+	}
+
+	if !strings.Contains(e.Value(), "    "+`"name"`) {
+		t.Errorf("expected tab indent to be widened to four spaces by the textarea, got: %q", e.Value())
+	}
+}
+
+func TestEditor_SetSortKeys(t *testing.T) {
+	t.Run("sorts object keys alphabetically", func(t *testing.T) {
+		e := editor.New("mykey", []byte(`{"zebra":1,"apple":2}`))
+		e.SetMode(editor.ModeJSON)
+		e.SetSortKeys(true)
+
+		if err := e.FormatJSON(); err != nil {
+			t.Fatalf("unexpected error formatting JSON: %v", err)
+		}
+
+		if strings.Index(e.Value(), "apple") > strings.Index(e.Value(), "zebra") {
+			t.Errorf("expected apple before zebra, got: %q", e.Value())
+		}
+	})
+
+	t.Run("array order untouched", func(t *testing.T) {
+		e := editor.New("mykey", []byte(`["zebra","apple"]`))
+		e.SetMode(editor.ModeJSON)
+		e.SetSortKeys(true)
+
+		if err := e.FormatJSON(); err != nil {
+			t.Fatalf("unexpected error formatting JSON: %v", err)
+		}
+
+		if strings.Index(e.Value(), "zebra") > strings.Index(e.Value(), "apple") {
+			t.Errorf("expected array order preserved (zebra before apple), got: %q", e.Value())
+		}
+	})
+}
+
 func TestEditor_Mode(t *testing.T) {
 	e := editor.New("mykey", []byte("content"))
 
@@ -299,6 +454,7 @@ func TestEditorSaveMsg_Fields(t *testing.T) {
 		Key:         "test-key",
 		Value:       []byte("test-value"),
 		OriginalCAS: 999,
+		Flags:       5,
 	}
 
 	if msg.Key != "test-key" {
@@ -310,6 +466,9 @@ func TestEditorSaveMsg_Fields(t *testing.T) {
 	if msg.OriginalCAS != 999 {
 		t.Errorf("expected OriginalCAS 999, got %d", msg.OriginalCAS)
 	}
+	if msg.Flags != 5 {
+		t.Errorf("expected Flags 5, got %d", msg.Flags)
+	}
 }
 
 func TestEditorCancelMsg_Type(t *testing.T) {
@@ -368,3 +527,304 @@ func TestEditor_MultilineContent(t *testing.T) {
 		t.Errorf("expected multiline content, got '%s'", value)
 	}
 }
+
+func TestEditor_Paste_InsertsMultilineBlockAsSingleUndoEntry(t *testing.T) {
+	e := editor.New("mykey", []byte(""))
+	e.Init()
+	e.SetSize(80, 24)
+
+	pasted := "line1\nline2\nline3"
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(pasted), Paste: true})
+	e = model.(*editor.Editor)
+
+	if e.Value() != pasted {
+		t.Errorf("expected pasted content %q intact, got %q", pasted, e.Value())
+	}
+	if !e.IsDirty() {
+		t.Error("expected editor to be marked dirty after paste")
+	}
+	if e.UndoEntries() != 1 {
+		t.Errorf("expected exactly 1 undo entry for the paste, got %d", e.UndoEntries())
+	}
+}
+
+func TestEditor_Undo_RestoresPreviousContent(t *testing.T) {
+	e := editor.New("mykey", []byte("initial"))
+	e.Init()
+	e.SetSize(80, 24)
+
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" typed")})
+	e = model.(*editor.Editor)
+	if e.Value() != "initial typed" {
+		t.Fatalf("expected 'initial typed', got %q", e.Value())
+	}
+
+	model, _ = e.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	e = model.(*editor.Editor)
+
+	if e.Value() != "initial" {
+		t.Errorf("expected undo to restore 'initial', got %q", e.Value())
+	}
+	if e.UndoEntries() != 0 {
+		t.Errorf("expected undo stack to be empty after undoing the only entry, got %d", e.UndoEntries())
+	}
+}
+
+func TestEditor_Undo_NoOpWhenStackEmpty(t *testing.T) {
+	e := editor.New("mykey", []byte("initial"))
+	e.Init()
+	e.SetSize(80, 24)
+
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	e = model.(*editor.Editor)
+
+	if e.Value() != "initial" {
+		t.Errorf("expected value to remain 'initial' with nothing to undo, got %q", e.Value())
+	}
+}
+
+func TestEditor_Base64Encode_Decode_RoundTrips(t *testing.T) {
+	e := editor.New("mykey", []byte("hello world"))
+
+	e.Base64Encode()
+	if e.Value() != "aGVsbG8gd29ybGQ=" {
+		t.Fatalf("expected base64-encoded value, got %q", e.Value())
+	}
+	if !e.IsDirty() {
+		t.Error("expected buffer to be marked dirty after Base64Encode")
+	}
+
+	if err := e.Base64Decode(); err != nil {
+		t.Fatalf("unexpected error decoding base64: %v", err)
+	}
+	if e.Value() != "hello world" {
+		t.Errorf("expected round-trip to restore 'hello world', got %q", e.Value())
+	}
+}
+
+func TestEditor_Base64Decode_InvalidBase64(t *testing.T) {
+	e := editor.New("mykey", []byte("not valid base64!!"))
+
+	if err := e.Base64Decode(); err == nil {
+		t.Error("expected error for invalid base64")
+	}
+	if e.Value() != "not valid base64!!" {
+		t.Errorf("expected buffer to remain unchanged after a failed decode, got %q", e.Value())
+	}
+}
+
+func TestEditor_GzipCompress_Decompress_RoundTrips(t *testing.T) {
+	e := editor.New("mykey", []byte("hello world"))
+
+	if err := e.GzipCompress(); err != nil {
+		t.Fatalf("unexpected error compressing: %v", err)
+	}
+	if e.Value() == "hello world" {
+		t.Error("expected buffer to change after GzipCompress")
+	}
+	if !e.IsDirty() {
+		t.Error("expected buffer to be marked dirty after GzipCompress")
+	}
+
+	if err := e.GzipDecompress(); err != nil {
+		t.Fatalf("unexpected error decompressing: %v", err)
+	}
+	if e.Value() != "hello world" {
+		t.Errorf("expected round-trip to restore 'hello world', got %q", e.Value())
+	}
+}
+
+func TestEditor_GzipDecompress_InvalidData(t *testing.T) {
+	e := editor.New("mykey", []byte("not gzip data"))
+
+	if err := e.GzipDecompress(); err == nil {
+		t.Error("expected error for invalid gzip data")
+	}
+	if e.Value() != "not gzip data" {
+		t.Errorf("expected buffer to remain unchanged after a failed decompress, got %q", e.Value())
+	}
+}
+
+func TestEditor_Base64Encode_UndoRestoresOriginal(t *testing.T) {
+	e := editor.New("mykey", []byte("hello world"))
+	e.Init()
+	e.SetSize(80, 24)
+
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyCtrlB})
+	e = model.(*editor.Editor)
+	if e.Value() == "hello world" {
+		t.Fatal("expected Ctrl+B to base64-encode the buffer")
+	}
+
+	model, _ = e.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	e = model.(*editor.Editor)
+	if e.Value() != "hello world" {
+		t.Errorf("expected undo to restore 'hello world', got %q", e.Value())
+	}
+}
+
+func TestEditor_EnterFormMode_FlatObject(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"name":"Alice","age":30,"active":true}`))
+	e.SetMode(editor.ModeJSON)
+
+	if err := e.EnterFormMode(); err != nil {
+		t.Fatalf("unexpected error entering form mode: %v", err)
+	}
+	if e.Mode() != editor.ModeForm {
+		t.Fatalf("expected ModeForm, got %v", e.Mode())
+	}
+	if e.FormFieldCount() != 3 {
+		t.Fatalf("expected 3 fields, got %d", e.FormFieldCount())
+	}
+
+	wantKeys := []string{"name", "age", "active"}
+	for i, want := range wantKeys {
+		if got := e.FormFieldKey(i); got != want {
+			t.Errorf("field %d: expected key %q, got %q", i, want, got)
+		}
+	}
+}
+
+func TestEditor_EnterFormMode_NestedObjectFallsBackToRawText(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"name":"Alice","address":{"city":"NYC"}}`))
+	e.SetMode(editor.ModeJSON)
+
+	err := e.EnterFormMode()
+	if err == nil {
+		t.Fatal("expected an error for a nested object")
+	}
+	if e.Mode() != editor.ModeJSON {
+		t.Errorf("expected mode to remain ModeJSON after a failed EnterFormMode, got %v", e.Mode())
+	}
+}
+
+func TestEditor_EnterFormMode_InvalidJSON(t *testing.T) {
+	e := editor.New("mykey", []byte(`not json`))
+
+	if err := e.EnterFormMode(); err == nil {
+		t.Fatal("expected an error for non-JSON content")
+	}
+}
+
+func TestEditor_SetFormFieldValue_ReserializesPreservingTypes(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"name":"Alice","age":30,"active":true}`))
+	e.SetMode(editor.ModeJSON)
+	if err := e.EnterFormMode(); err != nil {
+		t.Fatalf("unexpected error entering form mode: %v", err)
+	}
+
+	e.SetFormFieldValue(0, "Bob")
+	e.SetFormFieldValue(1, "31")
+	e.SetFormFieldValue(2, "false")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(e.Value()), &got); err != nil {
+		t.Fatalf("expected valid JSON, got error %v for %q", err, e.Value())
+	}
+
+	if got["name"] != "Bob" {
+		t.Errorf("expected name %q (string), got %#v", "Bob", got["name"])
+	}
+	if age, ok := got["age"].(float64); !ok || age != 31 {
+		t.Errorf("expected age 31 (number), got %#v", got["age"])
+	}
+	if active, ok := got["active"].(bool); !ok || active != false {
+		t.Errorf("expected active false (bool), got %#v", got["active"])
+	}
+}
+
+func TestEditor_FormField_InvalidNumberFallsBackToQuotedString(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"age":30}`))
+	if err := e.EnterFormMode(); err != nil {
+		t.Fatalf("unexpected error entering form mode: %v", err)
+	}
+
+	e.SetFormFieldValue(0, "thirty")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(e.Value()), &got); err != nil {
+		t.Fatalf("expected valid JSON even with an in-progress invalid number, got error %v for %q", err, e.Value())
+	}
+	if got["age"] != "thirty" {
+		t.Errorf("expected age to fall back to the string %q, got %#v", "thirty", got["age"])
+	}
+}
+
+func TestEditor_ExitFormMode_ReturnsToJSONMode(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"name":"Alice"}`))
+	if err := e.EnterFormMode(); err != nil {
+		t.Fatalf("unexpected error entering form mode: %v", err)
+	}
+
+	e.ExitFormMode()
+
+	if e.Mode() != editor.ModeJSON {
+		t.Errorf("expected ModeJSON after ExitFormMode, got %v", e.Mode())
+	}
+	if e.FormFieldCount() != 0 {
+		t.Errorf("expected 0 fields after ExitFormMode, got %d", e.FormFieldCount())
+	}
+}
+
+func TestEditor_CtrlT_TogglesFormMode(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"name":"Alice"}`))
+	e.Init()
+	e.SetSize(80, 24)
+	e.SetMode(editor.ModeJSON)
+
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	e = model.(*editor.Editor)
+	if e.Mode() != editor.ModeForm {
+		t.Fatalf("expected ModeForm after Ctrl+T, got %v", e.Mode())
+	}
+
+	model, _ = e.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	e = model.(*editor.Editor)
+	if e.Mode() != editor.ModeJSON {
+		t.Fatalf("expected ModeJSON after a second Ctrl+T, got %v", e.Mode())
+	}
+}
+
+func TestEditor_TabKey_MovesFocusBetweenFormFields(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"a":1,"b":2}`))
+	e.Init()
+	e.SetSize(80, 24)
+	if err := e.EnterFormMode(); err != nil {
+		t.Fatalf("unexpected error entering form mode: %v", err)
+	}
+
+	if e.FormFocusIndex() != 0 {
+		t.Fatalf("expected initial focus on field 0, got %d", e.FormFocusIndex())
+	}
+
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyTab})
+	e = model.(*editor.Editor)
+	if e.FormFocusIndex() != 1 {
+		t.Errorf("expected focus on field 1 after Tab, got %d", e.FormFocusIndex())
+	}
+
+	model, _ = e.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	e = model.(*editor.Editor)
+	if e.FormFocusIndex() != 0 {
+		t.Errorf("expected focus back on field 0 after Shift+Tab, got %d", e.FormFocusIndex())
+	}
+}
+
+func TestEditor_TypingInFormField_UpdatesFocusedField(t *testing.T) {
+	e := editor.New("mykey", []byte(`{"name":"Al"}`))
+	e.Init()
+	e.SetSize(80, 24)
+	if err := e.EnterFormMode(); err != nil {
+		t.Fatalf("unexpected error entering form mode: %v", err)
+	}
+
+	model, _ := e.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ice")})
+	e = model.(*editor.Editor)
+
+	if e.FormFieldValue(0) != "Alice" {
+		t.Errorf("expected field value 'Alice', got %q", e.FormFieldValue(0))
+	}
+	if !strings.Contains(e.Value(), `"Alice"`) {
+		t.Errorf("expected reserialized content to contain %q, got %q", `"Alice"`, e.Value())
+	}
+}
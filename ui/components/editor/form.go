@@ -0,0 +1,301 @@
+package editor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// FieldKind identifies the JSON type a form field's value was parsed as, so
+// reserializing it can preserve that type instead of always emitting a
+// string.
+type FieldKind int
+
+const (
+	// FieldString is a JSON string value.
+	FieldString FieldKind = iota
+	// FieldNumber is a JSON number value.
+	FieldNumber
+	// FieldBool is a JSON true/false value.
+	FieldBool
+	// FieldNull is a JSON null value.
+	FieldNull
+)
+
+// formField is one top-level key of a flat JSON object, editable as a
+// single labeled input.
+type formField struct {
+	key   string
+	kind  FieldKind
+	input textinput.Model
+}
+
+// EnterFormMode parses the current content as a flat JSON object and, if it
+// qualifies, switches to ModeForm with one input per top-level field. Top
+// -level values that are themselves objects or arrays are not supported, so
+// the content falls back to being edited as raw text: EnterFormMode returns
+// an error and the mode is left unchanged.
+func (e *Editor) EnterFormMode() error {
+	fields, err := parseFlatObjectFields(e.textarea.Value())
+	if err != nil {
+		return err
+	}
+
+	e.formFields = fields
+	e.formFocus = 0
+	if len(e.formFields) > 0 {
+		e.formFields[0].input.Focus()
+	}
+	e.mode = ModeForm
+	return nil
+}
+
+// ExitFormMode leaves form mode and returns to editing the reserialized
+// content as JSON text.
+func (e *Editor) ExitFormMode() {
+	e.mode = ModeJSON
+	e.formFields = nil
+	e.formFocus = 0
+}
+
+// FormFieldCount returns the number of fields in the current form, or 0
+// outside form mode.
+func (e *Editor) FormFieldCount() int {
+	return len(e.formFields)
+}
+
+// FormFieldKey returns the key of the i-th form field.
+func (e *Editor) FormFieldKey(i int) string {
+	return e.formFields[i].key
+}
+
+// FormFieldValue returns the current (possibly unsaved) text of the i-th
+// form field's input.
+func (e *Editor) FormFieldValue(i int) string {
+	return e.formFields[i].input.Value()
+}
+
+// SetFormFieldValue sets the text of the i-th form field's input and
+// reserializes the result into the underlying content, as if the user had
+// typed it.
+func (e *Editor) SetFormFieldValue(i int, value string) {
+	e.formFields[i].input.SetValue(value)
+	e.syncFormToContent()
+}
+
+// FormFocusIndex returns the index of the currently focused form field.
+func (e *Editor) FormFocusIndex() int {
+	return e.formFocus
+}
+
+// focusNextFormField moves focus to the next (forward=true) or previous
+// form field, wrapping around.
+func (e *Editor) focusNextFormField(forward bool) {
+	if len(e.formFields) == 0 {
+		return
+	}
+	e.formFields[e.formFocus].input.Blur()
+	if forward {
+		e.formFocus = (e.formFocus + 1) % len(e.formFields)
+	} else {
+		e.formFocus = (e.formFocus - 1 + len(e.formFields)) % len(e.formFields)
+	}
+	e.formFields[e.formFocus].input.Focus()
+}
+
+// updateFocusedFormField forwards msg to the focused field's input and
+// reserializes the form into the underlying content.
+func (e *Editor) updateFocusedFormField(msg tea.Msg) tea.Cmd {
+	if len(e.formFields) == 0 {
+		return nil
+	}
+	var cmd tea.Cmd
+	e.formFields[e.formFocus].input, cmd = e.formFields[e.formFocus].input.Update(msg)
+	e.syncFormToContent()
+	return cmd
+}
+
+// syncFormToContent reserializes the form fields and writes the result into
+// the textarea, so Value()/save() behave the same as in text/JSON mode.
+func (e *Editor) syncFormToContent() {
+	content, err := e.reserializeForm()
+	if err != nil {
+		return
+	}
+	e.textarea.SetValue(content)
+	e.checkDirty()
+}
+
+// reserializeForm rebuilds the JSON object text from the current form field
+// values, preserving each field's original type where the typed text is
+// still valid for that type, and indenting the same way FormatJSON does.
+func (e *Editor) reserializeForm() (string, error) {
+	fields := e.formFields
+	if e.sortJSONKeys {
+		sorted := make([]*formField, len(fields))
+		copy(sorted, fields)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].key < sorted[j].key })
+		fields = sorted
+	}
+
+	var compact bytes.Buffer
+	compact.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			compact.WriteByte(',')
+		}
+		keyJSON, err := json.Marshal(f.key)
+		if err != nil {
+			return "", err
+		}
+		compact.Write(keyJSON)
+		compact.WriteByte(':')
+		compact.WriteString(f.marshalValue())
+	}
+	compact.WriteByte('}')
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, compact.Bytes(), "", e.jsonIndent); err != nil {
+		return "", err
+	}
+	return indented.String(), nil
+}
+
+// marshalValue renders a field's current input text as a JSON literal of
+// its original kind, falling back to a quoted string if the text is no
+// longer valid for that kind (e.g. "tru" while typing "true"), so the
+// reserialized document is always valid JSON.
+func (f *formField) marshalValue() string {
+	text := f.input.Value()
+
+	switch f.kind {
+	case FieldNumber:
+		if json.Valid([]byte(text)) {
+			var n json.Number
+			if err := json.Unmarshal([]byte(text), &n); err == nil {
+				return n.String()
+			}
+		}
+	case FieldBool:
+		if text == "true" || text == "false" {
+			return text
+		}
+	case FieldNull:
+		if text == "null" {
+			return "null"
+		}
+	case FieldString:
+		if quoted, err := json.Marshal(text); err == nil {
+			return string(quoted)
+		}
+	}
+
+	quoted, _ := json.Marshal(text)
+	return string(quoted)
+}
+
+// formView renders one labeled line per form field.
+func (e *Editor) formView() string {
+	var b strings.Builder
+	for i, f := range e.formFields {
+		label := fmt.Sprintf("%-20s", f.key+":")
+		b.WriteString(e.metaStyle.Render(label))
+		b.WriteString(f.input.View())
+		if i < len(e.formFields)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// parseFlatObjectFields parses content as a JSON object whose top-level
+// values are all scalars (string/number/bool/null), returning one formField
+// per key in their original order. Returns an error if content isn't a
+// JSON object or any value is itself an object or array.
+func parseFlatObjectFields(content string) ([]*formField, error) {
+	dec := json.NewDecoder(strings.NewReader(content))
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("not a JSON object")
+	}
+
+	var fields []*formField
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		key, ok := keyTok.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid JSON: non-string key")
+		}
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+
+		field, err := newFormField(key, raw)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return fields, nil
+}
+
+// newFormField classifies a raw JSON value and builds the input for it.
+// Returns an error for object/array values, which form mode doesn't support.
+func newFormField(key string, raw json.RawMessage) (*formField, error) {
+	var kind FieldKind
+	var text string
+
+	trimmed := strings.TrimSpace(string(raw))
+	switch {
+	case len(trimmed) == 0:
+		return nil, fmt.Errorf("field %q: empty value", key)
+	case trimmed[0] == '{' || trimmed[0] == '[':
+		return nil, fmt.Errorf("field %q: nested objects and arrays are not supported in form mode", key)
+	case trimmed == "true" || trimmed == "false":
+		kind = FieldBool
+		text = trimmed
+	case trimmed == "null":
+		kind = FieldNull
+		text = "null"
+	case trimmed[0] == '"':
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, fmt.Errorf("field %q: %w", key, err)
+		}
+		kind = FieldString
+		text = s
+	default:
+		var n json.Number
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return nil, fmt.Errorf("field %q: %w", key, err)
+		}
+		kind = FieldNumber
+		text = n.String()
+	}
+
+	input := textinput.New()
+	input.SetValue(text)
+	input.Width = 40
+
+	return &formField{key: key, kind: kind, input: input}, nil
+}
@@ -25,10 +25,13 @@ type KeyBinding struct {
 
 // Model represents the help overlay component
 type Model struct {
-	visible  bool
-	width    int
-	height   int
-	bindings []KeyBinding
+	visible      bool
+	width        int
+	height       int
+	bindings     []KeyBinding
+	scrollOffset int
+	filtering    bool
+	filterInput  string
 
 	// Styles
 	overlayStyle lipgloss.Style
@@ -78,7 +81,12 @@ func (m *Model) initKeyBindings() []KeyBinding {
 		{Key: "?", Action: "Toggle Help", Category: CategoryGlobal},
 		{Key: "Tab", Action: "Switch Pane", Category: CategoryGlobal},
 		{Key: "r", Action: "Refresh", Category: CategoryGlobal},
+		{Key: "o", Action: "Reconnect", Category: CategoryGlobal},
+		{Key: "Ctrl+L", Action: "Force Redraw", Category: CategoryGlobal},
 		{Key: "s", Action: "Show Stats", Category: CategoryGlobal},
+		{Key: "S", Action: "Add Server", Category: CategoryGlobal},
+		{Key: "M", Action: "Manage Servers", Category: CategoryGlobal},
+		{Key: "Ctrl+E", Action: "Recently Viewed Keys", Category: CategoryGlobal},
 
 		// Key List pane keybindings
 		{Key: "Up, k", Action: "Move Up", Category: CategoryKeyList},
@@ -86,9 +94,16 @@ func (m *Model) initKeyBindings() []KeyBinding {
 		{Key: "Enter, l", Action: "Select / Expand", Category: CategoryKeyList},
 		{Key: "h", Action: "Collapse / Go to Parent", Category: CategoryKeyList},
 		{Key: "/", Action: "Search Mode", Category: CategoryKeyList},
+		{Key: ":", Action: "Go to Key (exact name)", Category: CategoryKeyList},
 		{Key: "d", Action: "Delete Key", Category: CategoryKeyList},
 		{Key: "n", Action: "Create New Key", Category: CategoryKeyList},
+		{Key: "t", Action: "Get and Touch (refresh TTL)", Category: CategoryKeyList},
+		{Key: "i", Action: "Toggle Key Details Panel", Category: CategoryKeyList},
 		{Key: "m", Action: "Load More", Category: CategoryKeyList},
+		{Key: "zM", Action: "Collapse All Folders", Category: CategoryKeyList},
+		{Key: "zR", Action: "Expand All Folders", Category: CategoryKeyList},
+		{Key: "v", Action: "Search Values (server reads)", Category: CategoryKeyList},
+		{Key: "K", Action: "Tail Newly Appearing Keys", Category: CategoryKeyList},
 
 		// Viewer pane keybindings
 		{Key: "e", Action: "Edit Mode", Category: CategoryViewer},
@@ -96,7 +111,21 @@ func (m *Model) initKeyBindings() []KeyBinding {
 		{Key: "H", Action: "Hex View", Category: CategoryViewer},
 		{Key: "T", Action: "Text View", Category: CategoryViewer},
 		{Key: "A", Action: "Auto Detect", Category: CategoryViewer},
+		{Key: "R", Action: "Raw View (escaped bytes)", Category: CategoryViewer},
+		{Key: "U", Action: "HTML View", Category: CategoryViewer},
+		{Key: "P", Action: "Toggle HTML Tag-Stripped Preview", Category: CategoryViewer},
+		{Key: "w", Action: "Toggle Line Wrap", Category: CategoryViewer},
+		{Key: "L", Action: "Toggle Line Numbers", Category: CategoryViewer},
+		{Key: "[, ]", Action: "Decrease/Increase Hex Width", Category: CategoryViewer},
+		{Key: "#", Action: "Toggle Hex Column Ruler", Category: CategoryViewer},
+		{Key: "B", Action: "Toggle Byte-Range Window (Hex)", Category: CategoryViewer},
+		{Key: "{, }", Action: "Page Byte-Range Window Backward/Forward", Category: CategoryViewer},
+		{Key: "F", Action: "Go to Matching Key (JSON string token)", Category: CategoryViewer},
+		{Key: "N", Action: "Cycle Detected Type", Category: CategoryViewer},
+		{Key: "x", Action: "Toggle Compact/Pretty JSON", Category: CategoryViewer},
 		{Key: "c", Action: "Copy Value", Category: CategoryViewer},
+		{Key: "Y", Action: "Snapshot Value To New Key", Category: CategoryViewer},
+		{Key: "C", Action: "Copy Formatted Value (hex/JSON/etc.)", Category: CategoryViewer},
 		{Key: "PageUp", Action: "Page Up", Category: CategoryViewer},
 		{Key: "PageDown", Action: "Page Down", Category: CategoryViewer},
 	}
@@ -133,21 +162,90 @@ func (m *Model) KeyBindings() []KeyBinding {
 	return m.bindings
 }
 
+// IsFiltering returns true while the user is typing a filter query
+func (m *Model) IsFiltering() bool {
+	return m.filtering
+}
+
+// FilteredBindings returns the keybindings matching the current filter
+// query (matched against both the key and the action description, case
+// insensitively). Returns all bindings when no filter is active.
+func (m *Model) FilteredBindings() []KeyBinding {
+	if m.filterInput == "" {
+		return m.bindings
+	}
+
+	query := strings.ToLower(m.filterInput)
+	var result []KeyBinding
+	for _, b := range m.bindings {
+		if strings.Contains(strings.ToLower(b.Action), query) || strings.Contains(strings.ToLower(b.Key), query) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
 // Update handles messages
 func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		switch msg.Type {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	if m.filtering {
+		switch keyMsg.Type {
 		case tea.KeyEsc:
-			if m.visible {
-				m.Hide()
+			m.filtering = false
+			m.filterInput = ""
+			m.scrollOffset = 0
+		case tea.KeyEnter:
+			m.filtering = false
+		case tea.KeyBackspace:
+			if m.filterInput != "" {
+				m.filterInput = m.filterInput[:len(m.filterInput)-1]
+				m.scrollOffset = 0
 			}
 		case tea.KeyRunes:
-			if len(msg.Runes) > 0 && msg.Runes[0] == '?' {
-				m.Toggle()
+			m.filterInput += string(keyMsg.Runes)
+			m.scrollOffset = 0
+		}
+		return m, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		m.Hide()
+	case tea.KeyUp:
+		if m.scrollOffset > 0 {
+			m.scrollOffset--
+		}
+	case tea.KeyDown:
+		m.scrollOffset++
+	case tea.KeyPgUp:
+		m.scrollOffset -= m.height
+		if m.scrollOffset < 0 {
+			m.scrollOffset = 0
+		}
+	case tea.KeyPgDown:
+		m.scrollOffset += m.height
+	case tea.KeyRunes:
+		switch string(keyMsg.Runes) {
+		case "?":
+			m.Toggle()
+		case "q":
+			m.Hide()
+		case "/":
+			m.filtering = true
+			m.filterInput = ""
+		case "j":
+			m.scrollOffset++
+		case "k":
+			if m.scrollOffset > 0 {
+				m.scrollOffset--
 			}
 		}
 	}
+
 	return m, nil
 }
 
@@ -157,30 +255,66 @@ func (m *Model) View() string {
 		return ""
 	}
 
-	var b strings.Builder
-
-	// Title
-	b.WriteString(m.titleStyle.Render("Keybindings Help"))
-	b.WriteString("\n")
+	bindings := m.FilteredBindings()
 
-	// Render bindings by category
+	var bodyLines []string
 	categories := []Category{CategoryGlobal, CategoryKeyList, CategoryViewer}
-
 	for _, cat := range categories {
-		b.WriteString(m.sectionStyle.Render(string(cat)))
-		b.WriteString("\n")
-
-		for _, binding := range m.bindings {
+		var catLines []string
+		for _, binding := range bindings {
 			if binding.Category == cat {
 				key := m.keyStyle.Render(binding.Key)
 				action := m.actionStyle.Render(binding.Action)
-				b.WriteString(key + action + "\n")
+				catLines = append(catLines, key+action)
 			}
 		}
+		if len(catLines) == 0 {
+			continue
+		}
+		bodyLines = append(bodyLines, m.sectionStyle.Render(string(cat)))
+		bodyLines = append(bodyLines, catLines...)
+	}
+
+	if len(bindings) == 0 {
+		bodyLines = append(bodyLines, m.actionStyle.Render("No bindings match your filter"))
+	}
+
+	// Apply scroll window to the body, keeping the title pinned
+	if m.height > 0 {
+		contentHeight := m.height - 4 // reserve space for title/footer/filter line
+		if contentHeight < 1 {
+			contentHeight = 1
+		}
+		maxOffset := len(bodyLines) - contentHeight
+		if maxOffset < 0 {
+			maxOffset = 0
+		}
+		if m.scrollOffset > maxOffset {
+			m.scrollOffset = maxOffset
+		}
+		if m.scrollOffset < 0 {
+			m.scrollOffset = 0
+		}
+		end := m.scrollOffset + contentHeight
+		if end > len(bodyLines) {
+			end = len(bodyLines)
+		}
+		bodyLines = bodyLines[m.scrollOffset:end]
+	}
+
+	var b strings.Builder
+	b.WriteString(m.titleStyle.Render("Keybindings Help"))
+	b.WriteString("\n")
+	for _, line := range bodyLines {
+		b.WriteString(line)
+		b.WriteString("\n")
 	}
 
-	// Footer with close hint
-	b.WriteString(m.footerStyle.Render("Press ? or Esc to close"))
+	if m.filtering {
+		b.WriteString(m.footerStyle.Render("Filter: " + m.filterInput + "_"))
+	} else {
+		b.WriteString(m.footerStyle.Render("/ to filter, j/k to scroll, Press ? or Esc to close"))
+	}
 
 	content := b.String()
 
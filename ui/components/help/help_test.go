@@ -304,6 +304,70 @@ func TestModel_Update_UnrelatedKeyDoesNotToggle(t *testing.T) {
 	}
 }
 
+func TestModel_FilterNarrowsVisibleLines(t *testing.T) {
+	m := help.NewModel()
+	m.SetSize(80, 40)
+	m.Show()
+
+	full := m.View()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	if !m.IsFiltering() {
+		t.Fatal("expected filtering mode after '/'")
+	}
+	for _, r := range "quit" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	filtered := m.View()
+	if len(filtered) >= len(full) {
+		t.Errorf("expected filtered view to be shorter than full view (%d) >= (%d)", len(filtered), len(full))
+	}
+	if !strings.Contains(filtered, "Quit") {
+		t.Errorf("expected filtered view to still contain the matching 'Quit' binding, got: %s", filtered)
+	}
+	if strings.Contains(filtered, "Copy Value") {
+		t.Errorf("expected filtered view to exclude non-matching bindings, got: %s", filtered)
+	}
+}
+
+func TestModel_FilterEscCancels(t *testing.T) {
+	m := help.NewModel()
+	m.SetSize(80, 40)
+	m.Show()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if m.IsFiltering() {
+		t.Error("expected filtering to be canceled after Esc")
+	}
+	if !m.Visible() {
+		t.Error("expected help overlay to remain visible after canceling filter")
+	}
+}
+
+func TestModel_ScrollDoesNotPanic(t *testing.T) {
+	m := help.NewModel()
+	m.SetSize(80, 10)
+	m.Show()
+
+	for i := 0; i < 50; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if m.View() == "" {
+		t.Error("expected non-empty view after scrolling past the end")
+	}
+
+	for i := 0; i < 50; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	}
+	if m.View() == "" {
+		t.Error("expected non-empty view after scrolling back to the top")
+	}
+}
+
 func TestModel_Update_ReturnsCmd(t *testing.T) {
 	m := help.NewModel()
 
@@ -0,0 +1,170 @@
+package keylist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// Column identifies an optional metadata column FilteredKeys' leaf rows can
+// render alongside the key name. Columns are off by default; see
+// Model.SetColumns and Model.ToggleColumn.
+type Column int
+
+// Supported columns, in their canonical display order (see AllColumns).
+const (
+	ColumnSize Column = iota
+	ColumnTTL
+	ColumnSlab
+	ColumnNode
+	ColumnLastAccess
+)
+
+// String returns the column's header label.
+func (c Column) String() string {
+	switch c {
+	case ColumnSize:
+		return "Size"
+	case ColumnTTL:
+		return "TTL"
+	case ColumnSlab:
+		return "Slab"
+	case ColumnNode:
+		return "Node"
+	case ColumnLastAccess:
+		return "Last Access"
+	default:
+		return "?"
+	}
+}
+
+// AllColumns returns every supported column, in canonical display order.
+func AllColumns() []Column {
+	return []Column{ColumnSize, ColumnTTL, ColumnSlab, ColumnNode, ColumnLastAccess}
+}
+
+// ParseColumn parses a config-file column name (e.g. "size", "ttl",
+// "last_access") into a Column, for config.UIConfig.KeyListColumns.
+func ParseColumn(name string) (Column, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "size":
+		return ColumnSize, nil
+	case "ttl":
+		return ColumnTTL, nil
+	case "slab":
+		return ColumnSlab, nil
+	case "node":
+		return ColumnNode, nil
+	case "last_access":
+		return ColumnLastAccess, nil
+	default:
+		return 0, fmt.Errorf("unknown key list column: %q", name)
+	}
+}
+
+// SetColumns replaces the set of enabled columns. Columns are rendered to
+// the right of each leaf row's name in canonical order (see AllColumns),
+// regardless of the order passed here.
+func (m *Model) SetColumns(cols []Column) {
+	m.enabledColumns = make(map[Column]bool, len(cols))
+	for _, c := range cols {
+		m.enabledColumns[c] = true
+	}
+}
+
+// Columns returns the currently enabled columns, in canonical display
+// order.
+func (m *Model) Columns() []Column {
+	var cols []Column
+	for _, c := range AllColumns() {
+		if m.enabledColumns[c] {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+
+// ToggleColumn enables col if it's currently disabled, or disables it if
+// it's currently enabled.
+func (m *Model) ToggleColumn(col Column) {
+	if m.enabledColumns == nil {
+		m.enabledColumns = make(map[Column]bool)
+	}
+	m.enabledColumns[col] = !m.enabledColumns[col]
+}
+
+// ColumnEnabled reports whether col is currently enabled.
+func (m *Model) ColumnEnabled(col Column) bool {
+	return m.enabledColumns[col]
+}
+
+// columnValue formats ki's value for column c, relative to now (used by
+// ColumnTTL).
+func columnValue(c Column, ki *models.KeyInfo, now int64) string {
+	switch c {
+	case ColumnSize:
+		return models.FormatBytes(int64(ki.Size))
+	case ColumnTTL:
+		return ki.TTLDisplay(now)
+	case ColumnSlab:
+		return strconv.Itoa(ki.SlabClass)
+	case ColumnNode:
+		if ki.Node == "" {
+			return "-"
+		}
+		return ki.Node
+	case ColumnLastAccess:
+		return ki.LastAccessDisplay()
+	default:
+		return ""
+	}
+}
+
+// columnWidths computes the render width of each currently enabled column,
+// sized to the widest value among the filtered key set (or its own header,
+// whichever is wider), so columns stay aligned across rows.
+func (m *Model) columnWidths(now int64) map[Column]int {
+	cols := m.Columns()
+	if len(cols) == 0 {
+		return nil
+	}
+
+	widths := make(map[Column]int, len(cols))
+	for _, c := range cols {
+		widths[c] = utf8.RuneCountInString(c.String())
+	}
+
+	for i := range m.filtered {
+		ki := &m.filtered[i]
+		for _, c := range cols {
+			if w := utf8.RuneCountInString(columnValue(c, ki, now)); w > widths[c] {
+				widths[c] = w
+			}
+		}
+	}
+
+	return widths
+}
+
+// renderColumns formats ki's enabled column values, right-aligned to
+// widths, as a single suffix to append after a leaf row's name.
+func (m *Model) renderColumns(ki *models.KeyInfo, widths map[Column]int, now int64) string {
+	var b strings.Builder
+	for _, c := range m.Columns() {
+		b.WriteString("  ")
+		b.WriteString(padLeft(columnValue(c, ki, now), widths[c]))
+	}
+	return b.String()
+}
+
+// padLeft right-aligns s within width by adding leading spaces. Returns s
+// unchanged if it's already at least width runes wide.
+func padLeft(s string, width int) string {
+	if n := width - utf8.RuneCountInString(s); n > 0 {
+		return strings.Repeat(" ", n) + s
+	}
+	return s
+}
@@ -0,0 +1,108 @@
+package keylist_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestModel_Columns_NoneEnabledByDefault(t *testing.T) {
+	m := keylist.NewModel()
+	if cols := m.Columns(); len(cols) != 0 {
+		t.Errorf("expected no columns enabled by default, got %v", cols)
+	}
+}
+
+func TestModel_ToggleColumn(t *testing.T) {
+	m := keylist.NewModel()
+
+	m.ToggleColumn(keylist.ColumnTTL)
+	if !m.ColumnEnabled(keylist.ColumnTTL) {
+		t.Fatal("expected TTL column to be enabled after toggling on")
+	}
+
+	m.ToggleColumn(keylist.ColumnTTL)
+	if m.ColumnEnabled(keylist.ColumnTTL) {
+		t.Fatal("expected TTL column to be disabled after toggling off")
+	}
+}
+
+func TestModel_SetColumns(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetColumns([]keylist.Column{keylist.ColumnSize, keylist.ColumnNode})
+
+	got := m.Columns()
+	if len(got) != 2 || got[0] != keylist.ColumnSize || got[1] != keylist.ColumnNode {
+		t.Errorf("expected [Size Node] in canonical order, got %v", got)
+	}
+}
+
+func TestModel_View_TTLColumn_EnabledRendersValues(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(80, 10)
+	m.SetKeys([]models.KeyInfo{
+		{Key: "permanent", Expiration: 0},
+	})
+
+	m.ToggleColumn(keylist.ColumnTTL)
+
+	view := m.View()
+	if !strings.Contains(view, "never") {
+		t.Errorf("expected view to contain TTL value %q when TTL column enabled, got:\n%s", "never", view)
+	}
+}
+
+func TestModel_View_TTLColumn_DisabledOmitsValues(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(80, 10)
+	m.SetKeys([]models.KeyInfo{
+		{Key: "permanent", Expiration: 0},
+	})
+
+	view := m.View()
+	if strings.Contains(view, "never") {
+		t.Errorf("expected view not to contain TTL value %q when no column enabled, got:\n%s", "never", view)
+	}
+
+	// Enable then disable again - should go back to omitting it.
+	m.ToggleColumn(keylist.ColumnTTL)
+	m.ToggleColumn(keylist.ColumnTTL)
+
+	view = m.View()
+	if strings.Contains(view, "never") {
+		t.Errorf("expected view not to contain TTL value %q after disabling column, got:\n%s", "never", view)
+	}
+}
+
+func TestParseColumn(t *testing.T) {
+	tests := []struct {
+		name string
+		want keylist.Column
+	}{
+		{"size", keylist.ColumnSize},
+		{"TTL", keylist.ColumnTTL},
+		{"slab", keylist.ColumnSlab},
+		{"node", keylist.ColumnNode},
+		{"last_access", keylist.ColumnLastAccess},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := keylist.ParseColumn(tt.name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseColumn(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseColumn_Invalid(t *testing.T) {
+	if _, err := keylist.ParseColumn("bogus"); err == nil {
+		t.Error("expected error for unknown column name")
+	}
+}
@@ -1,13 +1,35 @@
 package keylist
 
 import (
+	"bytes"
+	"fmt"
+	"regexp"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/nnnkkk7/memtui/models"
 )
 
+// FilterMode selects how SetFilter interprets its pattern argument
+type FilterMode int
+
+// Filter mode options
+const (
+	// FilterSubstring matches keys containing the pattern anywhere (default)
+	FilterSubstring FilterMode = iota
+	// FilterGlob matches keys against a `*`/`?` wildcard pattern, anchored
+	// to the full key
+	FilterGlob
+	// FilterValue matches keys whose value (as reported by ValueLookup)
+	// contains the pattern. Fetching and caching values is the caller's
+	// responsibility (see app.FetchValuesCmd); a key with no looked-up value
+	// never matches.
+	FilterValue
+)
+
 // TreeNode represents a node in the key tree
 type TreeNode struct {
 	Name     string
@@ -19,6 +41,23 @@ type TreeNode struct {
 	depth    int
 }
 
+// TotalSize returns the sum of Size across all leaf descendants of this
+// node. Leaf nodes return their own KeyInfo.Size.
+func (n *TreeNode) TotalSize() int {
+	if n.IsLeaf {
+		if n.KeyInfo == nil {
+			return 0
+		}
+		return n.KeyInfo.Size
+	}
+
+	total := 0
+	for _, child := range n.Children {
+		total += child.TotalSize()
+	}
+	return total
+}
+
 // NewTreeNode creates a new non-leaf tree node
 func NewTreeNode(name string, expanded bool) *TreeNode {
 	return &TreeNode{
@@ -43,37 +82,81 @@ type KeySelectedMsg struct {
 	Key models.KeyInfo
 }
 
+// DefaultSelectAllThreshold is the select-all confirmation threshold used by
+// NewModel. Selecting more keys than this at once usually precedes a batch
+// delete, which would otherwise be catastrophic on a large cache.
+const DefaultSelectAllThreshold = 500
+
+// SelectAllConfirmMsg is sent instead of performing the selection when
+// Ctrl+A would select more than SelectAllThreshold keys, so the caller can
+// ask the user to confirm before committing to such a large selection.
+type SelectAllConfirmMsg struct {
+	Count int
+}
+
 // Model represents the key list component
 type Model struct {
-	keys      []models.KeyInfo
-	filtered  []models.KeyInfo
-	filter    string
-	delimiter string
-	tree      *TreeNode
-	cursor    int
-	offset    int
-	width     int
-	height    int
-	flatNodes []*TreeNode // Flattened visible nodes for navigation
+	keys       []models.KeyInfo
+	filtered   []models.KeyInfo
+	filter     string
+	filterMode FilterMode
+	delimiter  string
+	tree       *TreeNode
+	cursor     int
+	offset     int
+	width      int
+	height     int
+	flatNodes  []*TreeNode // Flattened visible nodes for navigation
+	pendingKey rune        // First rune of a pending vim-style two-key sequence (e.g. 'z')
+
+	// valueLookup backs FilterValue, see SetValueLookup
+	valueLookup func(key string) ([]byte, bool)
+
+	// sizeFilter is an optional additional predicate on KeyInfo.Size,
+	// combined (AND) with the text filter above. See SetSizeFilter.
+	sizeFilter     SizePredicate
+	sizeFilterExpr string
+
+	// ttlFilter is an optional additional predicate on KeyInfo.Expiration,
+	// combined (AND) with the text and size filters above. See
+	// SetTTLFilter.
+	ttlFilter     TTLPredicate
+	ttlFilterExpr string
+
+	// enabledColumns is the set of optional metadata columns rendered
+	// alongside each leaf row's name. Empty by default. See SetColumns.
+	enabledColumns map[Column]bool
 
 	// Multi-select support
-	selected    map[string]bool // Map of selected key names
-	multiSelect bool            // Whether multi-select mode is enabled
+	selected           map[string]bool // Map of selected key names
+	multiSelect        bool            // Whether multi-select mode is enabled
+	selectAllThreshold int             // Ctrl+A selections above this count require confirmation (see SelectAllConfirmMsg)
+
+	// sessionModified marks keys created or edited this session, for the
+	// marker rendered by renderNode. Owned by app.Model; see SetSessionModified.
+	sessionModified map[string]bool
+
+	// pinned marks keys shown in the synthetic "Pinned" folder rebuildTree
+	// prepends to the tree, regardless of the active filter. Owned by
+	// app.Model; see SetPinned.
+	pinned map[string]bool
 
 	// Styles
-	normalStyle   lipgloss.Style
-	selectedStyle lipgloss.Style
-	folderStyle   lipgloss.Style
-	leafStyle     lipgloss.Style
-	markedStyle   lipgloss.Style // Style for multi-selected items
+	normalStyle           lipgloss.Style
+	selectedStyle         lipgloss.Style
+	folderStyle           lipgloss.Style
+	leafStyle             lipgloss.Style
+	markedStyle           lipgloss.Style // Style for multi-selected items
+	sessionModifiedMarker lipgloss.Style // Style for the session-modified marker
 }
 
 // NewModel creates a new key list model
 func NewModel() *Model {
 	return &Model{
-		delimiter: ":",
-		tree:      NewTreeNode("root", true),
-		selected:  make(map[string]bool),
+		delimiter:          ":",
+		tree:               NewTreeNode("root", true),
+		selected:           make(map[string]bool),
+		selectAllThreshold: DefaultSelectAllThreshold,
 		normalStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("252")),
 		selectedStyle: lipgloss.NewStyle().
@@ -88,6 +171,8 @@ func NewModel() *Model {
 			Background(lipgloss.Color("136")).
 			Foreground(lipgloss.Color("230")).
 			Bold(true),
+		sessionModifiedMarker: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("214")),
 	}
 }
 
@@ -98,28 +183,180 @@ func (m *Model) SetKeys(keys []models.KeyInfo) {
 	m.rebuildTree()
 }
 
+// SetSessionModified sets the keys to mark as created or edited this
+// session, rendered with a marker alongside each matching leaf row.
+func (m *Model) SetSessionModified(modified map[string]bool) {
+	m.sessionModified = modified
+}
+
+// SetPinned sets the keys to show in the "Pinned" section at the top of the
+// tree and rebuilds it accordingly.
+func (m *Model) SetPinned(pinned map[string]bool) {
+	m.pinned = pinned
+	m.rebuildTree()
+}
+
 // SetDelimiter sets the delimiter for tree building
 func (m *Model) SetDelimiter(d string) {
 	m.delimiter = d
 	m.rebuildTree()
 }
 
-// SetFilter sets the filter pattern
+// SetValueLookup installs the function SetFilter consults when FilterMode is
+// FilterValue. Fetching and caching values is the caller's responsibility
+// (see app.FetchValuesCmd) since the key list has no network access of its
+// own; lookup should return ok=false for a key whose value hasn't been
+// fetched yet.
+func (m *Model) SetValueLookup(lookup func(key string) ([]byte, bool)) {
+	m.valueLookup = lookup
+}
+
+// SetFilter sets the filter pattern, interpreted according to the current
+// filter mode (substring by default; see SetFilterMode). Combined (AND)
+// with the active size filter, if any (see SetSizeFilter).
 func (m *Model) SetFilter(pattern string) {
 	m.filter = pattern
-	if pattern == "" {
+	m.applyFilters()
+}
+
+// SetSizeFilter parses expr with ParseSizeFilter and applies it as an
+// additional predicate on KeyInfo.Size, combined (AND) with the active text
+// filter. Pass "" to clear it. Returns an error, leaving the previous size
+// filter in place, if expr cannot be parsed.
+func (m *Model) SetSizeFilter(expr string) error {
+	if expr == "" {
+		m.sizeFilter = nil
+		m.sizeFilterExpr = ""
+		m.applyFilters()
+		return nil
+	}
+
+	pred, err := ParseSizeFilter(expr)
+	if err != nil {
+		return err
+	}
+	m.sizeFilter = pred
+	m.sizeFilterExpr = expr
+	m.applyFilters()
+	return nil
+}
+
+// SizeFilterExpr returns the active size filter expression, or "" if none
+// is set.
+func (m *Model) SizeFilterExpr() string {
+	return m.sizeFilterExpr
+}
+
+// SetTTLFilter parses expr with ParseTTLFilter and applies it as an
+// additional predicate on KeyInfo.Expiration, combined (AND) with the
+// active text and size filters. Pass "" to clear it. Returns an error,
+// leaving the previous TTL filter in place, if expr cannot be parsed.
+func (m *Model) SetTTLFilter(expr string) error {
+	if expr == "" {
+		m.ttlFilter = nil
+		m.ttlFilterExpr = ""
+		m.applyFilters()
+		return nil
+	}
+
+	pred, err := ParseTTLFilter(expr)
+	if err != nil {
+		return err
+	}
+	m.ttlFilter = pred
+	m.ttlFilterExpr = expr
+	m.applyFilters()
+	return nil
+}
+
+// TTLFilterExpr returns the active TTL filter expression, or "" if none is
+// set.
+func (m *Model) TTLFilterExpr() string {
+	return m.ttlFilterExpr
+}
+
+// applyFilters recomputes m.filtered from m.keys using the active text
+// filter (m.filter/m.filterMode), size filter (m.sizeFilter), and TTL
+// filter (m.ttlFilter); a key must satisfy all of them to appear in the
+// result.
+func (m *Model) applyFilters() {
+	if m.filter == "" && m.sizeFilter == nil && m.ttlFilter == nil {
 		m.filtered = m.keys
-	} else {
-		m.filtered = nil
-		for _, ki := range m.keys {
-			if strings.Contains(ki.Key, pattern) {
-				m.filtered = append(m.filtered, ki)
+		m.rebuildTree()
+		return
+	}
+
+	now := time.Now().Unix()
+
+	var match func(key string) bool
+	switch m.filterMode {
+	case FilterGlob:
+		re := CompileGlob(m.filter)
+		match = re.MatchString
+	case FilterValue:
+		match = func(key string) bool {
+			if m.valueLookup == nil {
+				return false
 			}
+			value, ok := m.valueLookup(key)
+			if !ok {
+				return false
+			}
+			return bytes.Contains(value, []byte(m.filter))
+		}
+	default:
+		match = func(key string) bool { return strings.Contains(key, m.filter) }
+	}
+
+	m.filtered = nil
+	for _, ki := range m.keys {
+		if m.filter != "" && !match(ki.Key) {
+			continue
+		}
+		if m.sizeFilter != nil && !m.sizeFilter(ki.Size) {
+			continue
+		}
+		if m.ttlFilter != nil && !m.ttlFilter(ki.Expiration, now) {
+			continue
 		}
+		m.filtered = append(m.filtered, ki)
 	}
 	m.rebuildTree()
 }
 
+// SetFilterMode sets how SetFilter interprets its pattern and re-applies
+// the current filter
+func (m *Model) SetFilterMode(mode FilterMode) {
+	m.filterMode = mode
+	m.SetFilter(m.filter)
+}
+
+// FilterMode returns the current filter mode
+func (m *Model) FilterMode() FilterMode {
+	return m.filterMode
+}
+
+// CompileGlob converts a `*`/`?` wildcard pattern into an anchored regexp
+// matching the full key. Exported so other packages that need to match
+// against the same glob dialect as FilterGlob (e.g. a protected-key-pattern
+// guard) don't have to reimplement it.
+func CompileGlob(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
 // SetSize sets the component size
 func (m *Model) SetSize(width, height int) {
 	m.width = width
@@ -146,6 +383,28 @@ func (m *Model) FilteredKeys() []models.KeyInfo {
 	return m.filtered
 }
 
+// Breadcrumb returns the ancestor path to the node under the cursor,
+// joined as "root > child > ... > name". Returns "" when there are no
+// visible nodes.
+func (m *Model) Breadcrumb() string {
+	if m.cursor < 0 || m.cursor >= len(m.flatNodes) {
+		return ""
+	}
+
+	node := m.flatNodes[m.cursor]
+	var names []string
+	for n := node; n != nil && n != m.tree; n = n.Parent {
+		names = append(names, n.Name)
+	}
+
+	// names was collected leaf-to-root; reverse it
+	for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+		names[i], names[j] = names[j], names[i]
+	}
+
+	return strings.Join(names, " > ")
+}
+
 // SelectedKey returns the currently selected key
 func (m *Model) SelectedKey() *models.KeyInfo {
 	if m.cursor < 0 || m.cursor >= len(m.flatNodes) {
@@ -155,6 +414,80 @@ func (m *Model) SelectedKey() *models.KeyInfo {
 	return node.KeyInfo
 }
 
+// SelectKey moves the cursor to the leaf node for key, expanding any
+// collapsed ancestor folders and adjusting the scroll offset so it becomes
+// visible. Returns false if key is not present in the current (filtered)
+// key set.
+func (m *Model) SelectKey(key string) bool {
+	if !m.expandAncestors(m.tree, key) {
+		return false
+	}
+	m.flattenTree()
+
+	for i, node := range m.flatNodes {
+		if node.IsLeaf && node.KeyInfo != nil && node.KeyInfo.Key == key {
+			m.cursor = i
+			m.ensureCursorVisible()
+			return true
+		}
+	}
+	return false
+}
+
+// Offset returns the index of the first visible row (for testing).
+func (m *Model) Offset() int {
+	return m.offset
+}
+
+// ensureCursorVisible adjusts the scroll offset, if needed, so the cursor
+// row falls within the visible range.
+func (m *Model) ensureCursorVisible() {
+	visibleHeight := m.height
+	if visibleHeight <= 0 {
+		visibleHeight = 20
+	}
+
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+visibleHeight {
+		m.offset = m.cursor - visibleHeight + 1
+	}
+}
+
+// RevealInTree clears any active text, size, and TTL filters, rebuilds the
+// tree from the full key set, and moves the cursor to key with its
+// ancestors expanded. This is how a key selected from a filtered (possibly
+// flattened) view gets located back in the unfiltered tree. Returns false
+// if key is not present in the full key set.
+func (m *Model) RevealInTree(key string) bool {
+	m.filter = ""
+	m.sizeFilter = nil
+	m.sizeFilterExpr = ""
+	m.ttlFilter = nil
+	m.ttlFilterExpr = ""
+	m.filtered = m.keys
+	m.rebuildTree()
+	return m.SelectKey(key)
+}
+
+// expandAncestors reports whether key exists beneath node, expanding every
+// folder along the path to it as it unwinds.
+func (m *Model) expandAncestors(node *TreeNode, key string) bool {
+	for _, child := range node.Children {
+		if child.IsLeaf {
+			if child.KeyInfo != nil && child.KeyInfo.Key == key {
+				return true
+			}
+			continue
+		}
+		if m.expandAncestors(child, key) {
+			child.Expanded = true
+			return true
+		}
+	}
+	return false
+}
+
 // Multi-select methods
 
 // ToggleSelection toggles the selection state of the current cursor item
@@ -208,6 +541,29 @@ func (m *Model) SelectAll() {
 	}
 }
 
+// SelectableCount returns how many leaf keys SelectAll would select.
+func (m *Model) SelectableCount() int {
+	count := 0
+	for _, node := range m.flatNodes {
+		if node.IsLeaf && node.KeyInfo != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// SetSelectAllThreshold sets the key count above which Ctrl+A requires
+// confirmation (see SelectAllConfirmMsg) instead of selecting immediately.
+// A threshold <= 0 disables the guard, making Ctrl+A always select at once.
+func (m *Model) SetSelectAllThreshold(n int) {
+	m.selectAllThreshold = n
+}
+
+// SelectAllThreshold returns the current select-all confirmation threshold.
+func (m *Model) SelectAllThreshold() int {
+	return m.selectAllThreshold
+}
+
 // HasSelection returns true if any items are selected
 func (m *Model) HasSelection() bool {
 	return len(m.selected) > 0
@@ -226,10 +582,109 @@ func (m *Model) IsMultiSelectMode() bool {
 	return m.multiSelect
 }
 
+// CollapseAll folds every folder node in the tree, leaving only top-level
+// nodes visible. The cursor is moved to the nearest still-visible ancestor.
+func (m *Model) CollapseAll() {
+	current := m.cursorNode()
+	setExpandedAll(m.tree, false)
+	m.flattenTree()
+	m.restoreCursor(current)
+}
+
+// ExpandAll unfolds every folder node in the tree, restoring full visibility.
+func (m *Model) ExpandAll() {
+	current := m.cursorNode()
+	setExpandedAll(m.tree, true)
+	m.flattenTree()
+	m.restoreCursor(current)
+}
+
+// setExpandedAll recursively sets Expanded on every non-leaf node
+func setExpandedAll(node *TreeNode, expanded bool) {
+	for _, child := range node.Children {
+		if !child.IsLeaf {
+			child.Expanded = expanded
+			setExpandedAll(child, expanded)
+		}
+	}
+}
+
+// cursorNode returns the node currently under the cursor, or nil
+func (m *Model) cursorNode() *TreeNode {
+	if m.cursor < 0 || m.cursor >= len(m.flatNodes) {
+		return nil
+	}
+	return m.flatNodes[m.cursor]
+}
+
+// jumpToSibling moves the cursor to the sibling of the current node offset
+// by delta positions (1 for next, -1 for previous) within their shared
+// parent's Children, skipping over any descendants along the way. Does
+// nothing if there is no current node or no sibling in that direction.
+func (m *Model) jumpToSibling(delta int) {
+	node := m.cursorNode()
+	if node == nil || node.Parent == nil {
+		return
+	}
+
+	siblings := node.Parent.Children
+	idx := -1
+	for i, s := range siblings {
+		if s == node {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return
+	}
+
+	target := idx + delta
+	if target < 0 || target >= len(siblings) {
+		return
+	}
+
+	if i, ok := m.findFlatIndex(siblings[target]); ok {
+		m.cursor = i
+	}
+}
+
+// findFlatIndex returns node's position in flatNodes, or ok=false if it
+// isn't currently visible.
+func (m *Model) findFlatIndex(node *TreeNode) (int, bool) {
+	for i, n := range m.flatNodes {
+		if n == node {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// restoreCursor moves the cursor to node if still visible, otherwise to the
+// nearest visible ancestor
+func (m *Model) restoreCursor(node *TreeNode) {
+	if node == nil {
+		return
+	}
+	for n := node; n != nil && n != m.tree; n = n.Parent {
+		for i, visible := range m.flatNodes {
+			if visible == n {
+				m.cursor = i
+				return
+			}
+		}
+	}
+}
+
 // rebuildTree rebuilds the tree from filtered keys
 func (m *Model) rebuildTree() {
 	m.tree = NewTreeNode("root", true)
 
+	if pinnedFolder := m.buildPinnedFolder(); pinnedFolder != nil {
+		pinnedFolder.Parent = m.tree
+		m.tree.Children = append(m.tree.Children, pinnedFolder)
+	}
+
 	for i := range m.filtered {
 		m.insertKey(&m.filtered[i])
 	}
@@ -237,6 +692,33 @@ func (m *Model) rebuildTree() {
 	m.flattenTree()
 }
 
+// buildPinnedFolder returns a synthetic "Pinned" folder containing a flat
+// leaf for every pinned key still present in m.keys, or nil if none are
+// pinned. Built from m.keys rather than m.filtered so the section stays
+// visible regardless of the active filter.
+func (m *Model) buildPinnedFolder() *TreeNode {
+	if len(m.pinned) == 0 {
+		return nil
+	}
+
+	folder := NewTreeNode("Pinned", true)
+	for i := range m.keys {
+		ki := &m.keys[i]
+		if !m.pinned[ki.Key] {
+			continue
+		}
+		leaf := NewLeafNode(ki)
+		leaf.Name = ki.Key
+		leaf.Parent = folder
+		leaf.depth = 1
+		folder.Children = append(folder.Children, leaf)
+	}
+	if len(folder.Children) == 0 {
+		return nil
+	}
+	return folder
+}
+
 // insertKey inserts a key into the tree
 func (m *Model) insertKey(ki *models.KeyInfo) {
 	if m.delimiter == "" || !strings.Contains(ki.Key, m.delimiter) {
@@ -299,9 +781,22 @@ func (m *Model) flattenNode(node *TreeNode) {
 func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		// Handle vim-style navigation (j/k)
+		// Handle vim-style navigation (j/k) and fold sequences (zM/zR)
 		if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 {
-			switch msg.Runes[0] {
+			r := msg.Runes[0]
+
+			if m.pendingKey == 'z' {
+				m.pendingKey = 0
+				switch r {
+				case 'M':
+					m.CollapseAll()
+				case 'R':
+					m.ExpandAll()
+				}
+				return m, nil
+			}
+
+			switch r {
 			case 'j':
 				if m.cursor < len(m.flatNodes)-1 {
 					m.cursor++
@@ -312,6 +807,15 @@ func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
 					m.cursor--
 				}
 				return m, nil
+			case 'z':
+				m.pendingKey = 'z'
+				return m, nil
+			case '}':
+				m.jumpToSibling(1)
+				return m, nil
+			case '{':
+				m.jumpToSibling(-1)
+				return m, nil
 			}
 		}
 
@@ -327,6 +831,16 @@ func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
 		case tea.KeySpace:
 			// Toggle selection of current item (multi-select)
 			m.ToggleSelection()
+		case tea.KeyCtrlA:
+			// Select all visible keys, confirming first if that would select
+			// more than SelectAllThreshold (a likely-accidental mass selection)
+			count := m.SelectableCount()
+			if m.selectAllThreshold > 0 && count > m.selectAllThreshold {
+				return m, func() tea.Msg {
+					return SelectAllConfirmMsg{Count: count}
+				}
+			}
+			m.SelectAll()
 		case tea.KeyEnter:
 			if m.cursor >= 0 && m.cursor < len(m.flatNodes) {
 				node := m.flatNodes[m.cursor]
@@ -367,11 +881,59 @@ func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
 				}
 			}
 		}
+
+	case tea.MouseMsg:
+		me := tea.MouseEvent(msg)
+		switch me.Button {
+		case tea.MouseButtonWheelUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case tea.MouseButtonWheelDown:
+			if m.cursor < len(m.flatNodes)-1 {
+				m.cursor++
+			}
+		case tea.MouseButtonLeft:
+			if me.Action == tea.MouseActionPress {
+				if idx, ok := m.nodeIndexAtRow(me.Y); ok {
+					m.cursor = idx
+					node := m.flatNodes[idx]
+					if node.IsLeaf && node.KeyInfo != nil {
+						return m, func() tea.Msg {
+							return KeySelectedMsg{Key: *node.KeyInfo}
+						}
+					}
+					node.Expanded = !node.Expanded
+					m.flattenTree()
+				}
+			}
+		}
 	}
 
 	return m, nil
 }
 
+// nodeIndexAtRow maps a screen row (as reported by a mouse event's Y
+// coordinate) to an index into flatNodes, accounting for the breadcrumb
+// header line. Returns ok=false for rows outside the rendered list.
+func (m *Model) nodeIndexAtRow(y int) (int, bool) {
+	headerLines := 0
+	if m.Breadcrumb() != "" {
+		headerLines = 1
+	}
+
+	row := y - headerLines
+	if row < 0 {
+		return 0, false
+	}
+
+	idx := m.offset + row
+	if idx < 0 || idx >= len(m.flatNodes) {
+		return 0, false
+	}
+	return idx, true
+}
+
 // View renders the key list
 func (m *Model) View() string {
 	if len(m.flatNodes) == 0 {
@@ -380,6 +942,11 @@ func (m *Model) View() string {
 
 	var b strings.Builder
 
+	if crumb := m.Breadcrumb(); crumb != "" {
+		b.WriteString(m.folderStyle.Render(crumb))
+		b.WriteString("\n")
+	}
+
 	// Calculate visible range
 	visibleHeight := m.height
 	if visibleHeight <= 0 {
@@ -387,16 +954,15 @@ func (m *Model) View() string {
 	}
 
 	// Adjust offset to keep cursor visible
-	if m.cursor < m.offset {
-		m.offset = m.cursor
-	} else if m.cursor >= m.offset+visibleHeight {
-		m.offset = m.cursor - visibleHeight + 1
-	}
+	m.ensureCursorVisible()
+
+	now := time.Now().Unix()
+	widths := m.columnWidths(now)
 
 	// Render visible nodes
 	for i := m.offset; i < len(m.flatNodes) && i < m.offset+visibleHeight; i++ {
 		node := m.flatNodes[i]
-		line := m.renderNode(node, i == m.cursor)
+		line := m.renderNode(node, i == m.cursor, widths, now)
 		b.WriteString(line)
 		b.WriteString("\n")
 	}
@@ -404,13 +970,15 @@ func (m *Model) View() string {
 	return b.String()
 }
 
-func (m *Model) renderNode(node *TreeNode, cursorOnThis bool) string {
+func (m *Model) renderNode(node *TreeNode, cursorOnThis bool, widths map[Column]int, now int64) string {
 	indent := strings.Repeat("  ", node.depth)
 
 	// Determine if this node is multi-selected (marked for batch operation)
 	isMarked := false
+	isSessionModified := false
 	if node.IsLeaf && node.KeyInfo != nil {
 		isMarked = m.selected[node.KeyInfo.Key]
+		isSessionModified = m.sessionModified[node.KeyInfo.Key]
 	}
 
 	// Build prefix with selection marker
@@ -427,30 +995,61 @@ func (m *Model) renderNode(node *TreeNode, cursorOnThis bool) string {
 		prefix = "▶ "
 	}
 
+	// Mark keys created or edited this session with a trailing dot, so the
+	// user can see at a glance what they've changed since connecting.
+	// Rendered with its own color after the rest of the line is styled, so
+	// it stays visible regardless of cursor/selection/leaf/folder styling.
+	marker := ""
+	if isSessionModified {
+		marker = " ●"
+	}
+
 	name := node.Name
-	if node.IsLeaf && node.KeyInfo != nil {
-		name = node.Name
+	if !node.IsLeaf {
+		name = fmt.Sprintf("%s (%s)", node.Name, models.FormatBytes(int64(node.TotalSize())))
 	}
 
-	line := indent + prefix + name
+	columns := ""
+	if node.IsLeaf && node.KeyInfo != nil && len(widths) > 0 {
+		columns = m.renderColumns(node.KeyInfo, widths, now)
+	}
 
-	// Truncate if needed
-	if m.width > 0 && len(line) > m.width {
-		line = line[:m.width-3] + "..."
+	// Truncate the name to fit, accounting for indentation, the selection
+	// marker/expand arrow, the session-modified marker, and any enabled
+	// columns, so very long key or folder names (keys can be up to 250
+	// bytes) don't overflow the list width or break column alignment.
+	if m.width > 0 {
+		available := m.width - utf8.RuneCountInString(indent) - utf8.RuneCountInString(prefix) - utf8.RuneCountInString(marker) - utf8.RuneCountInString(columns)
+		name = truncateMiddle(name, available)
 	}
 
+	line := indent + prefix + name + columns
+
 	// Apply styles based on cursor position and selection state
-	if cursorOnThis && isMarked {
-		// Both cursor and marked - use a combined style
-		return m.selectedStyle.Render(line)
-	} else if cursorOnThis {
-		return m.selectedStyle.Render(line)
-	} else if isMarked {
-		return m.markedStyle.Render(line)
+	var rendered string
+	switch {
+	case cursorOnThis:
+		rendered = m.selectedStyle.Render(line)
+	case isMarked:
+		rendered = m.markedStyle.Render(line)
+	case node.IsLeaf:
+		rendered = m.leafStyle.Render(line)
+	default:
+		rendered = m.folderStyle.Render(line)
 	}
 
-	if node.IsLeaf {
-		return m.leafStyle.Render(line)
+	if marker != "" {
+		rendered += m.sessionModifiedMarker.Render(marker)
 	}
-	return m.folderStyle.Render(line)
+	return rendered
+}
+
+// truncateMiddle shortens s to at most maxWidth display columns by replacing
+// its middle with "...", preserving the more identifying start and end of
+// key names (e.g. common prefixes and distinguishing suffixes). Returns s
+// unchanged if it already fits. See models.TruncateMiddleWidth for the
+// display-width accounting, which keeps wide (CJK) key names from
+// overflowing the column.
+func truncateMiddle(s string, maxWidth int) string {
+	return models.TruncateMiddleWidth(s, maxWidth)
 }
@@ -1,6 +1,8 @@
 package keylist_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -165,6 +167,322 @@ func TestModel_FilterEmpty(t *testing.T) {
 	}
 }
 
+func TestModel_FilterGlob(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1:profile"},
+		{Key: "user:2:profile"},
+		{Key: "user:1:session"},
+		{Key: "cache:data"},
+	}
+	m.SetKeys(keys)
+	m.SetFilterMode(keylist.FilterGlob)
+
+	m.SetFilter("user:*:profile")
+
+	filtered := m.FilteredKeys()
+	if len(filtered) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(filtered))
+	}
+	for _, ki := range filtered {
+		if !strings.HasSuffix(ki.Key, ":profile") {
+			t.Errorf("unexpected match %q", ki.Key)
+		}
+	}
+}
+
+func TestModel_FilterGlob_LiteralIsExactMatch(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1"},
+		{Key: "user:1:extra"},
+	}
+	m.SetKeys(keys)
+	m.SetFilterMode(keylist.FilterGlob)
+
+	m.SetFilter("user:1")
+
+	filtered := m.FilteredKeys()
+	if len(filtered) != 1 || filtered[0].Key != "user:1" {
+		t.Errorf("expected exact match only, got %v", filtered)
+	}
+}
+
+func TestModel_Breadcrumb(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "e2e_nav:user:1001"},
+		{Key: "e2e_nav:user:1002"},
+	}
+	m.SetKeys(keys)
+
+	// Navigate down to the deep leaf: the flattened tree is
+	// e2e_nav (folder) > user (folder) > 1001 (leaf) > 1002 (leaf).
+	for i := 0; i < 2; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+
+	got := m.Breadcrumb()
+	want := "e2e_nav > user > 1001"
+	if got != want {
+		t.Errorf("expected breadcrumb %q, got %q", want, got)
+	}
+}
+
+func TestModel_Breadcrumb_Empty(t *testing.T) {
+	m := keylist.NewModel()
+	if got := m.Breadcrumb(); got != "" {
+		t.Errorf("expected empty breadcrumb with no keys, got %q", got)
+	}
+}
+
+func TestModel_CollapseAllExpandAll(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1001"},
+		{Key: "user:1002"},
+		{Key: "session:abc"},
+	}
+	m.SetKeys(keys)
+
+	fullCount := len(m.Tree().Children) // top-level folders: user, session
+	totalVisible := countVisible(t, m)
+
+	m.CollapseAll()
+	if got := countVisible(t, m); got != fullCount {
+		t.Errorf("expected only %d top-level nodes visible after CollapseAll, got %d", fullCount, got)
+	}
+
+	m.ExpandAll()
+	if got := countVisible(t, m); got != totalVisible {
+		t.Errorf("expected %d nodes visible after ExpandAll, got %d", totalVisible, got)
+	}
+}
+
+func TestModel_CollapseAll_ViaKeySequence(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1001"},
+		{Key: "session:abc"},
+	}
+	m.SetKeys(keys)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'M'}})
+
+	if got := countVisible(t, m); got != len(m.Tree().Children) {
+		t.Errorf("expected top-level-only visibility after 'zM', got %d nodes", got)
+	}
+}
+
+// countVisible renders the tree and counts visible lines by re-deriving
+// the flattened node count via the cursor navigation bounds.
+func countVisible(t *testing.T, m *keylist.Model) int {
+	t.Helper()
+	count := 0
+	for {
+		before := m.Cursor()
+		m.Update(tea.KeyMsg{Type: tea.KeyDown})
+		if m.Cursor() == before {
+			break
+		}
+		count++
+	}
+	// Reset cursor back to top for subsequent assertions in the same test.
+	for i := 0; i < count; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	}
+	return count + 1
+}
+
+func TestModel_SiblingJump(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "cache:a"},
+		{Key: "user:1001"},
+		{Key: "user:1002"},
+		{Key: "session:abc"},
+	}
+	m.SetKeys(keys)
+
+	if !m.SelectKey("user:1001") {
+		t.Fatal("expected to find user:1001")
+	}
+	// Move to the "user" folder itself, the parent of the cursor's leaf.
+	m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if name := m.Tree().Children[1].Name; name != "user" {
+		t.Fatalf("expected second top-level node to be 'user', got %q", name)
+	}
+
+	// '}' from within "user" should jump to the next top-level namespace.
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'}'}})
+	selected := m.SelectedKey()
+	if got := m.Breadcrumb(); got != "session" {
+		t.Errorf("expected breadcrumb 'session' after '}', got %q", got)
+	}
+	if selected != nil {
+		t.Errorf("expected a folder (no KeyInfo) under the cursor, got %+v", selected)
+	}
+
+	// '{' should move back to "user".
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'{'}})
+	if got := m.Breadcrumb(); got != "user" {
+		t.Errorf("expected breadcrumb 'user' after '{', got %q", got)
+	}
+
+	// '{' again should jump to "cache", the first top-level namespace.
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'{'}})
+	if got := m.Breadcrumb(); got != "cache" {
+		t.Errorf("expected breadcrumb 'cache' after second '{', got %q", got)
+	}
+
+	// No previous sibling from the first node - cursor should not move.
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'{'}})
+	if got := m.Breadcrumb(); got != "cache" {
+		t.Errorf("expected cursor to stay on 'cache' with no previous sibling, got %q", got)
+	}
+}
+
+func TestModel_SiblingJump_NestedStaysWithinParent(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1001"},
+		{Key: "user:1002"},
+	}
+	m.SetKeys(keys)
+
+	if !m.SelectKey("user:1001") {
+		t.Fatal("expected to find user:1001")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'}'}})
+	if got := m.Breadcrumb(); got != "user > 1002" {
+		t.Errorf("expected '}' to move to the sibling leaf 'user > 1002', got %q", got)
+	}
+}
+
+func TestTreeNode_TotalSize(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1001", Size: 100},
+		{Key: "user:1002", Size: 250},
+		{Key: "session:abc", Size: 50},
+	}
+	m.SetKeys(keys)
+
+	var userNode *keylist.TreeNode
+	for _, child := range m.Tree().Children {
+		if child.Name == "user" {
+			userNode = child
+		}
+	}
+	if userNode == nil {
+		t.Fatal("expected a 'user' namespace node")
+	}
+
+	if got := userNode.TotalSize(); got != 350 {
+		t.Errorf("expected user namespace total size 350, got %d", got)
+	}
+}
+
+func TestTreeNode_TotalSize_RespectsFilter(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1001", Size: 100},
+		{Key: "user:1002", Size: 250},
+	}
+	m.SetKeys(keys)
+	m.SetFilter("1001")
+
+	var userNode *keylist.TreeNode
+	for _, child := range m.Tree().Children {
+		if child.Name == "user" {
+			userNode = child
+		}
+	}
+	if userNode == nil {
+		t.Fatal("expected a 'user' namespace node")
+	}
+
+	if got := userNode.TotalSize(); got != 100 {
+		t.Errorf("expected filtered total size 100, got %d", got)
+	}
+}
+
+func TestModel_MouseWheelScroll(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "alpha"},
+		{Key: "beta"},
+		{Key: "gamma"},
+	}
+	m.SetKeys(keys)
+
+	m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	if m.Cursor() != 1 {
+		t.Errorf("expected cursor 1 after wheel down, got %d", m.Cursor())
+	}
+
+	m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelUp})
+	if m.Cursor() != 0 {
+		t.Errorf("expected cursor 0 after wheel up, got %d", m.Cursor())
+	}
+}
+
+func TestModel_MouseClickSelectsKey(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetDelimiter("") // flat list so row index matches key index directly
+	keys := []models.KeyInfo{
+		{Key: "alpha"},
+		{Key: "beta"},
+		{Key: "gamma"},
+	}
+	m.SetKeys(keys)
+	m.SetSize(40, 10)
+
+	// Row 0 is the breadcrumb header line; rows 1.. map to flatNodes[0..].
+	_, cmd := m.Update(tea.MouseMsg{
+		Y:      2,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionPress,
+	})
+
+	if m.Cursor() != 1 {
+		t.Errorf("expected cursor to move to row 1, got %d", m.Cursor())
+	}
+	if cmd == nil {
+		t.Fatal("expected a KeySelectedMsg command for a click on a leaf")
+	}
+	msg := cmd()
+	sel, ok := msg.(keylist.KeySelectedMsg)
+	if !ok {
+		t.Fatalf("expected KeySelectedMsg, got %T", msg)
+	}
+	if sel.Key.Key != "beta" {
+		t.Errorf("expected selected key 'beta', got '%s'", sel.Key.Key)
+	}
+}
+
+func TestModel_MouseClickOutsideList(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{{Key: "alpha"}}
+	m.SetKeys(keys)
+	m.SetSize(40, 10)
+
+	_, cmd := m.Update(tea.MouseMsg{
+		Y:      50,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionPress,
+	})
+
+	if cmd != nil {
+		t.Error("expected no command for a click outside the visible list")
+	}
+	if m.Cursor() != 0 {
+		t.Errorf("expected cursor to remain unchanged, got %d", m.Cursor())
+	}
+}
+
 func TestModel_SelectedKey(t *testing.T) {
 	m := keylist.NewModel()
 	m.SetSize(40, 20)
@@ -384,6 +702,83 @@ func TestModel_SelectAll(t *testing.T) {
 	}
 }
 
+func TestModel_SelectAllViaCtrlA_BelowThreshold(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetDelimiter("") // Flat list
+	m.SetSelectAllThreshold(5)
+	keys := []models.KeyInfo{
+		{Key: "key1", Size: 100},
+		{Key: "key2", Size: 200},
+	}
+	m.SetKeys(keys)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if cmd != nil {
+		t.Fatal("expected no command (selection should happen immediately) below threshold")
+	}
+	if m.SelectionCount() != 2 {
+		t.Errorf("expected 2 selections, got %d", m.SelectionCount())
+	}
+}
+
+func TestModel_SelectAllViaCtrlA_AboveThreshold(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetDelimiter("") // Flat list
+	m.SetSelectAllThreshold(2)
+	keys := []models.KeyInfo{
+		{Key: "key1", Size: 100},
+		{Key: "key2", Size: 200},
+		{Key: "key3", Size: 300},
+	}
+	m.SetKeys(keys)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if cmd == nil {
+		t.Fatal("expected a confirmation command above threshold")
+	}
+	if m.SelectionCount() != 0 {
+		t.Errorf("expected no selection yet pending confirmation, got %d", m.SelectionCount())
+	}
+
+	msg := cmd()
+	confirmMsg, ok := msg.(keylist.SelectAllConfirmMsg)
+	if !ok {
+		t.Fatalf("expected SelectAllConfirmMsg, got %T", msg)
+	}
+	if confirmMsg.Count != 3 {
+		t.Errorf("expected count 3, got %d", confirmMsg.Count)
+	}
+}
+
+func TestModel_SelectAllThreshold_Disabled(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetDelimiter("")
+	m.SetSelectAllThreshold(0)
+	keys := []models.KeyInfo{
+		{Key: "key1", Size: 100},
+		{Key: "key2", Size: 200},
+	}
+	m.SetKeys(keys)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if cmd != nil {
+		t.Fatal("expected no confirmation when threshold is disabled")
+	}
+	if m.SelectionCount() != 2 {
+		t.Errorf("expected 2 selections, got %d", m.SelectionCount())
+	}
+}
+
+func TestModel_SelectAllThreshold_Default(t *testing.T) {
+	m := keylist.NewModel()
+	if m.SelectAllThreshold() != keylist.DefaultSelectAllThreshold {
+		t.Errorf("expected default threshold %d, got %d", keylist.DefaultSelectAllThreshold, m.SelectAllThreshold())
+	}
+}
+
 func TestModel_HasSelection(t *testing.T) {
 	m := keylist.NewModel()
 	m.SetSize(40, 20)
@@ -491,3 +886,321 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestModel_SelectKey_MovesCursorAndExpandsFolders(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	keys := []models.KeyInfo{
+		{Key: "session:abc", Size: 10},
+		{Key: "session:def", Size: 20},
+		{Key: "other:xyz", Size: 30},
+	}
+	m.SetKeys(keys)
+
+	if !m.SelectKey("session:def") {
+		t.Fatal("expected SelectKey to find 'session:def'")
+	}
+	selected := m.SelectedKey()
+	if selected == nil || selected.Key != "session:def" {
+		t.Errorf("expected cursor on 'session:def', got %+v", selected)
+	}
+}
+
+func TestModel_SelectKey_ScrollsDeepKeyUnderCollapsedAncestorsIntoView(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 3) // Small viewport, so a deep key forces a scroll.
+
+	keys := make([]models.KeyInfo, 0, 10)
+	for i := 0; i < 10; i++ {
+		keys = append(keys, models.KeyInfo{Key: fmt.Sprintf("group%d:leaf", i), Size: 10})
+	}
+	m.SetKeys(keys)
+
+	// All ten "groupN" folders start collapsed; the last one is well below
+	// the 3-row viewport.
+	if !m.SelectKey("group9:leaf") {
+		t.Fatal("expected SelectKey to find 'group9:leaf'")
+	}
+
+	selected := m.SelectedKey()
+	if selected == nil || selected.Key != "group9:leaf" {
+		t.Fatalf("expected cursor on 'group9:leaf', got %+v", selected)
+	}
+
+	cursor, offset := m.Cursor(), m.Offset()
+	if cursor < offset || cursor >= offset+3 {
+		t.Errorf("expected cursor (%d) within the visible range [%d, %d)", cursor, offset, offset+3)
+	}
+
+	// The row should also actually be rendered, not just logically visible.
+	if !contains(m.View(), "leaf") {
+		t.Errorf("expected the rendered view to contain the selected key's row")
+	}
+}
+
+func TestModel_SelectKey_NotFound(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetKeys([]models.KeyInfo{{Key: "session:abc", Size: 10}})
+
+	if m.SelectKey("session:missing") {
+		t.Error("expected SelectKey to return false for a nonexistent key")
+	}
+}
+
+func TestModel_RevealInTree_ClearsFilterAndExpandsAncestors(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	keys := []models.KeyInfo{
+		{Key: "session:abc", Size: 10},
+		{Key: "session:def", Size: 20},
+		{Key: "other:xyz", Size: 30},
+	}
+	m.SetKeys(keys)
+
+	m.SetFilter("session:def")
+	if !m.SelectKey("session:def") {
+		t.Fatal("expected to be able to select 'session:def' within the filtered results")
+	}
+
+	if !m.RevealInTree("session:def") {
+		t.Fatal("expected RevealInTree to find 'session:def'")
+	}
+
+	selected := m.SelectedKey()
+	if selected == nil || selected.Key != "session:def" {
+		t.Errorf("expected cursor on 'session:def' after reveal, got %+v", selected)
+	}
+	if got := len(m.FilteredKeys()); got != len(keys) {
+		t.Errorf("expected filter to be cleared and all %d keys visible, got %d", len(keys), got)
+	}
+
+	for _, child := range m.Tree().Children {
+		if !child.IsLeaf && child.Name == "session" && !child.Expanded {
+			t.Error("expected 'session' folder to be expanded after reveal")
+		}
+	}
+}
+
+func TestModel_RevealInTree_NotFound(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetKeys([]models.KeyInfo{{Key: "session:abc", Size: 10}})
+	m.SetFilter("session")
+
+	if m.RevealInTree("session:missing") {
+		t.Error("expected RevealInTree to return false for a nonexistent key")
+	}
+}
+
+func TestModel_View_TruncatesOverlongKeyNames(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+
+	longKey := strings.Repeat("a", 250)
+	m.SetKeys([]models.KeyInfo{{Key: longKey, Size: 10}})
+
+	var row string
+	for _, line := range strings.Split(m.View(), "\n") {
+		if strings.HasPrefix(line, "[ ]") || strings.HasPrefix(line, "[x]") {
+			row = line
+		}
+	}
+	if row == "" {
+		t.Fatal("expected a rendered key row in the view")
+	}
+	if len([]rune(row)) > 40 {
+		t.Errorf("expected the key row to fit within width 40, got %d runes: %q", len([]rune(row)), row)
+	}
+	if !strings.Contains(row, "...") {
+		t.Errorf("expected the overlong key to be middle-ellipsis truncated, got %q", row)
+	}
+}
+
+func TestModel_SelectedKey_ReturnsFullNameForOverlongKey(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+
+	longKey := strings.Repeat("a", 250)
+	m.SetKeys([]models.KeyInfo{{Key: longKey, Size: 10}})
+
+	selected := m.SelectedKey()
+	if selected == nil || selected.Key != longKey {
+		t.Errorf("expected SelectedKey to return the full 250-char key, got %+v", selected)
+	}
+}
+
+func TestNew_OptionOrderIndependent(t *testing.T) {
+	keys := []models.KeyInfo{
+		{Key: "session.abc", Size: 10},
+		{Key: "session.def", Size: 20},
+	}
+
+	a := keylist.New(
+		keylist.WithDelimiter("."),
+		keylist.WithKeys(keys),
+		keylist.WithSize(40, 20),
+		keylist.WithFilterMode(keylist.FilterGlob),
+		keylist.WithFilter("session.*"),
+	)
+
+	b := keylist.New(
+		keylist.WithFilter("session.*"),
+		keylist.WithFilterMode(keylist.FilterGlob),
+		keylist.WithSize(40, 20),
+		keylist.WithKeys(keys),
+		keylist.WithDelimiter("."),
+	)
+
+	if !a.SelectKey("session.def") || !b.SelectKey("session.def") {
+		t.Fatal("expected both models to find 'session.def' regardless of option order")
+	}
+	if a.FilterMode() != b.FilterMode() {
+		t.Error("expected equivalent filter mode regardless of option order")
+	}
+}
+
+func TestNew_EquivalentToNewModelPlusSetters(t *testing.T) {
+	keys := []models.KeyInfo{{Key: "session:abc", Size: 10}}
+
+	a := keylist.New(keylist.WithKeys(keys))
+
+	b := keylist.NewModel()
+	b.SetKeys(keys)
+
+	if !a.SelectKey("session:abc") || !b.SelectKey("session:abc") {
+		t.Error("expected New to match NewModel+SetKeys")
+	}
+}
+
+func TestModel_FilterValue_MatchesByValueContentNotName(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	keys := []models.KeyInfo{
+		{Key: "user:1", Size: 10},
+		{Key: "user:2", Size: 10},
+		{Key: "other:3", Size: 10},
+	}
+	m.SetKeys(keys)
+
+	values := map[string][]byte{
+		"user:1":  []byte(`{"email":"alice@example.com"}`),
+		"user:2":  []byte(`{"email":"bob@example.com"}`),
+		"other:3": []byte(`{"note":"mentions alice in passing"}`),
+	}
+	m.SetValueLookup(func(key string) ([]byte, bool) {
+		v, ok := values[key]
+		return v, ok
+	})
+
+	m.SetFilterMode(keylist.FilterValue)
+	m.SetFilter("alice@example.com")
+
+	filtered := m.FilteredKeys()
+	if len(filtered) != 1 || filtered[0].Key != "user:1" {
+		t.Errorf("expected only 'user:1' to match by value, got %+v", filtered)
+	}
+}
+
+func TestModel_FilterValue_ExcludesNameOnlyMatches(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	keys := []models.KeyInfo{
+		{Key: "session:abc", Size: 10},
+		{Key: "session:def", Size: 10},
+	}
+	m.SetKeys(keys)
+
+	// "session" appears in every key name but in neither value, so a
+	// value-search for it should match nothing.
+	values := map[string][]byte{
+		"session:abc": []byte("unrelated payload"),
+		"session:def": []byte("another payload"),
+	}
+	m.SetValueLookup(func(key string) ([]byte, bool) {
+		v, ok := values[key]
+		return v, ok
+	})
+
+	m.SetFilterMode(keylist.FilterValue)
+	m.SetFilter("session")
+
+	if filtered := m.FilteredKeys(); len(filtered) != 0 {
+		t.Errorf("expected no matches for a name-only substring, got %+v", filtered)
+	}
+}
+
+func TestModel_FilterValue_NoLookupMatchesNothing(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetKeys([]models.KeyInfo{{Key: "user:1", Size: 10}})
+
+	m.SetFilterMode(keylist.FilterValue)
+	m.SetFilter("anything")
+
+	if filtered := m.FilteredKeys(); len(filtered) != 0 {
+		t.Errorf("expected no matches without a value lookup installed, got %+v", filtered)
+	}
+}
+
+func TestModel_SetSessionModified_MarksMatchingKeyInView(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetDelimiter("") // Flat list
+	m.SetKeys([]models.KeyInfo{
+		{Key: "key1", Size: 100},
+		{Key: "key2", Size: 200},
+	})
+
+	m.SetSessionModified(map[string]bool{"key1": true})
+
+	view := m.View()
+	key1Line, key2Line := "", ""
+	for _, line := range strings.Split(view, "\n") {
+		switch {
+		case strings.Contains(line, "key1"):
+			key1Line = line
+		case strings.Contains(line, "key2"):
+			key2Line = line
+		}
+	}
+	if !contains(key1Line, "●") {
+		t.Errorf("expected session-modified marker on key1's line, got %q", key1Line)
+	}
+	if contains(key2Line, "●") {
+		t.Errorf("expected no session-modified marker on key2's line, got %q", key2Line)
+	}
+}
+
+func TestModel_SetPinned_RendersPinnedSectionRegardlessOfFilter(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetDelimiter("")
+	m.SetKeys([]models.KeyInfo{
+		{Key: "key1", Size: 100},
+		{Key: "key2", Size: 200},
+	})
+
+	m.SetPinned(map[string]bool{"key1": true})
+	m.SetFilter("key2")
+
+	view := m.View()
+	if !strings.Contains(view, "Pinned") {
+		t.Errorf("expected a Pinned section in the view, got:\n%s", view)
+	}
+	if !strings.Contains(view, "key1") {
+		t.Errorf("expected pinned key1 to remain visible despite a non-matching filter, got:\n%s", view)
+	}
+}
+
+func TestModel_SetPinned_EmptySetHidesPinnedSection(t *testing.T) {
+	m := keylist.NewModel()
+	m.SetSize(40, 20)
+	m.SetKeys([]models.KeyInfo{{Key: "key1"}})
+
+	m.SetPinned(nil)
+
+	if strings.Contains(m.View(), "Pinned") {
+		t.Errorf("expected no Pinned section when nothing is pinned, got:\n%s", m.View())
+	}
+}
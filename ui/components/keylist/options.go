@@ -0,0 +1,137 @@
+package keylist
+
+import "github.com/nnnkkk7/memtui/models"
+
+// Option configures a Model constructed with New. Options are applied in a
+// fixed, dependency-safe order regardless of the order they're passed in —
+// in particular, the delimiter and filter mode are always applied before
+// keys and a filter pattern, since rebuilding the tree and filtering both
+// depend on them.
+type Option func(*options)
+
+type options struct {
+	width, height int
+	sizeSet       bool
+
+	delimiter    string
+	delimiterSet bool
+
+	keys    []models.KeyInfo
+	keysSet bool
+
+	filterMode    FilterMode
+	filterModeSet bool
+
+	filter    string
+	filterSet bool
+
+	multiSelect    bool
+	multiSelectSet bool
+
+	selectAllThreshold    int
+	selectAllThresholdSet bool
+
+	valueLookup func(key string) ([]byte, bool)
+}
+
+// WithSize sets the component's rendering dimensions.
+func WithSize(width, height int) Option {
+	return func(o *options) {
+		o.width, o.height = width, height
+		o.sizeSet = true
+	}
+}
+
+// WithDelimiter sets the delimiter used to build the key tree.
+func WithDelimiter(d string) Option {
+	return func(o *options) {
+		o.delimiter = d
+		o.delimiterSet = true
+	}
+}
+
+// WithKeys sets the initial keys to populate the tree with.
+func WithKeys(keys []models.KeyInfo) Option {
+	return func(o *options) {
+		o.keys = keys
+		o.keysSet = true
+	}
+}
+
+// WithFilterMode sets how a filter pattern is interpreted.
+func WithFilterMode(mode FilterMode) Option {
+	return func(o *options) {
+		o.filterMode = mode
+		o.filterModeSet = true
+	}
+}
+
+// WithFilter sets the initial filter pattern.
+func WithFilter(pattern string) Option {
+	return func(o *options) {
+		o.filter = pattern
+		o.filterSet = true
+	}
+}
+
+// WithMultiSelectMode enables or disables multi-select mode.
+func WithMultiSelectMode(enabled bool) Option {
+	return func(o *options) {
+		o.multiSelect = enabled
+		o.multiSelectSet = true
+	}
+}
+
+// WithSelectAllThreshold sets the key count above which Ctrl+A requires
+// confirmation instead of selecting immediately.
+func WithSelectAllThreshold(n int) Option {
+	return func(o *options) {
+		o.selectAllThreshold = n
+		o.selectAllThresholdSet = true
+	}
+}
+
+// WithValueLookup installs the function SetFilter consults when FilterMode
+// is FilterValue (see Model.SetValueLookup).
+func WithValueLookup(lookup func(key string) ([]byte, bool)) Option {
+	return func(o *options) { o.valueLookup = lookup }
+}
+
+// New creates a key list Model from a set of Options, applying them in a
+// fixed order so the result is the same regardless of the order Options are
+// listed. This is the preferred constructor when embedding the key list in
+// another Bubble Tea program; NewModel remains available for callers that
+// want to wire setters up manually.
+func New(opts ...Option) *Model {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	m := NewModel()
+	if o.sizeSet {
+		m.SetSize(o.width, o.height)
+	}
+	if o.selectAllThresholdSet {
+		m.SetSelectAllThreshold(o.selectAllThreshold)
+	}
+	if o.multiSelectSet {
+		m.SetMultiSelectMode(o.multiSelect)
+	}
+	if o.delimiterSet {
+		m.SetDelimiter(o.delimiter)
+	}
+	if o.valueLookup != nil {
+		m.SetValueLookup(o.valueLookup)
+	}
+	if o.keysSet {
+		m.SetKeys(o.keys)
+	}
+	if o.filterModeSet {
+		m.SetFilterMode(o.filterMode)
+	}
+	if o.filterSet {
+		m.SetFilter(o.filter)
+	}
+	return m
+}
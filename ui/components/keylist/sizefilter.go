@@ -0,0 +1,97 @@
+package keylist
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SizePredicate tests a KeyInfo.Size (in bytes) against a parsed size
+// filter expression. See ParseSizeFilter.
+type SizePredicate func(size int) bool
+
+var (
+	sizeRangeRe    = regexp.MustCompile(`(?i)^(\d+(?:\.\d+)?)([KMG]?B)?\.\.(\d+(?:\.\d+)?)([KMG]?B)?$`)
+	sizeOperatorRe = regexp.MustCompile(`(?i)^(>=|<=|>|<)(\d+(?:\.\d+)?)([KMG]?B)?$`)
+)
+
+// ParseSizeFilter parses a size filter expression into a SizePredicate.
+// Supported forms (units default to bytes and are case-insensitive):
+//
+//	>100KB      sizes greater than 100KB
+//	<1KB        sizes less than 1KB
+//	>=1MB       sizes greater than or equal to 1MB
+//	<=1MB       sizes less than or equal to 1MB
+//	10KB..1MB   sizes within the inclusive range [10KB, 1MB]
+//
+// Recognized units are B, KB, MB, and GB.
+func ParseSizeFilter(expr string) (SizePredicate, error) {
+	expr = strings.TrimSpace(expr)
+
+	if m := sizeRangeRe.FindStringSubmatch(expr); m != nil {
+		lo, err := parseSizeValue(m[1], m[2])
+		if err != nil {
+			return nil, err
+		}
+		hi, err := parseSizeValue(m[3], m[4])
+		if err != nil {
+			return nil, err
+		}
+		if lo > hi {
+			return nil, fmt.Errorf("invalid size filter %q: lower bound exceeds upper bound", expr)
+		}
+		return func(size int) bool { return size >= lo && size <= hi }, nil
+	}
+
+	if m := sizeOperatorRe.FindStringSubmatch(expr); m != nil {
+		op := m[1]
+		n, err := parseSizeValue(m[2], m[3])
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case ">":
+			return func(size int) bool { return size > n }, nil
+		case "<":
+			return func(size int) bool { return size < n }, nil
+		case ">=":
+			return func(size int) bool { return size >= n }, nil
+		case "<=":
+			return func(size int) bool { return size <= n }, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid size filter expression: %q", expr)
+}
+
+// parseSizeValue converts a numeric string and an optional unit suffix
+// (B, KB, MB, GB; case-insensitive, defaulting to B) into a byte count.
+func parseSizeValue(numStr, unit string) (int, error) {
+	const (
+		kb = 1024
+		mb = kb * 1024
+		gb = mb * 1024
+	)
+
+	n, err := strconv.ParseFloat(numStr, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size value %q: %w", numStr, err)
+	}
+
+	multiplier := 1.0
+	switch strings.ToUpper(unit) {
+	case "", "B":
+		multiplier = 1
+	case "KB":
+		multiplier = kb
+	case "MB":
+		multiplier = mb
+	case "GB":
+		multiplier = gb
+	default:
+		return 0, fmt.Errorf("invalid size unit %q", unit)
+	}
+
+	return int(n * multiplier), nil
+}
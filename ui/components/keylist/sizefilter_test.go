@@ -0,0 +1,189 @@
+package keylist_test
+
+import (
+	"testing"
+
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+func TestParseSizeFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		matches []int // sizes that should match
+		rejects []int // sizes that should not match
+	}{
+		{
+			name:    "greater than with KB unit",
+			expr:    ">100KB",
+			matches: []int{100*1024 + 1, 1024 * 1024},
+			rejects: []int{100 * 1024, 50 * 1024},
+		},
+		{
+			name:    "less than with KB unit",
+			expr:    "<1KB",
+			matches: []int{0, 500},
+			rejects: []int{1024, 2048},
+		},
+		{
+			name:    "greater than or equal with MB unit",
+			expr:    ">=1MB",
+			matches: []int{1024 * 1024, 2 * 1024 * 1024},
+			rejects: []int{1024*1024 - 1},
+		},
+		{
+			name:    "less than or equal with MB unit",
+			expr:    "<=1MB",
+			matches: []int{1024 * 1024, 0},
+			rejects: []int{1024*1024 + 1},
+		},
+		{
+			name:    "range between KB and MB",
+			expr:    "10KB..1MB",
+			matches: []int{10 * 1024, 1024 * 1024, 500 * 1024},
+			rejects: []int{10*1024 - 1, 1024*1024 + 1},
+		},
+		{
+			name:    "bare byte values with no unit",
+			expr:    ">100",
+			matches: []int{101},
+			rejects: []int{100, 50},
+		},
+		{
+			name:    "GB unit",
+			expr:    "<1GB",
+			matches: []int{0},
+			rejects: []int{1024 * 1024 * 1024},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pred, err := keylist.ParseSizeFilter(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, size := range tt.matches {
+				if !pred(size) {
+					t.Errorf("expected size %d to match %q", size, tt.expr)
+				}
+			}
+			for _, size := range tt.rejects {
+				if pred(size) {
+					t.Errorf("expected size %d not to match %q", size, tt.expr)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSizeFilter_InvalidExpression(t *testing.T) {
+	tests := []string{
+		"",
+		"abc",
+		"100",
+		">100XB",
+		"1MB..10KB", // inverted range
+		">",
+		"..",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := keylist.ParseSizeFilter(expr); err == nil {
+				t.Errorf("expected error for invalid expression %q", expr)
+			}
+		})
+	}
+}
+
+func TestModel_SetSizeFilter(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "small", Size: 10},
+		{Key: "medium", Size: 150 * 1024},
+		{Key: "large", Size: 5 * 1024 * 1024},
+	}
+	m.SetKeys(keys)
+
+	if err := m.SetSizeFilter(">100KB"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	filtered := m.FilteredKeys()
+	if len(filtered) != 2 {
+		t.Fatalf("expected 2 keys above 100KB, got %d: %+v", len(filtered), filtered)
+	}
+
+	if m.SizeFilterExpr() != ">100KB" {
+		t.Errorf("expected SizeFilterExpr to report %q, got %q", ">100KB", m.SizeFilterExpr())
+	}
+}
+
+func TestModel_SetSizeFilter_CombinesWithTextFilter(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1", Size: 10},
+		{Key: "user:2", Size: 200 * 1024},
+		{Key: "session:abc", Size: 200 * 1024},
+	}
+	m.SetKeys(keys)
+
+	m.SetFilter("user")
+	if err := m.SetSizeFilter(">100KB"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	filtered := m.FilteredKeys()
+	if len(filtered) != 1 || filtered[0].Key != "user:2" {
+		t.Fatalf("expected only user:2 to match both filters, got %+v", filtered)
+	}
+}
+
+func TestModel_SetSizeFilter_InvalidExprLeavesPreviousFilter(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "small", Size: 10},
+		{Key: "large", Size: 5 * 1024 * 1024},
+	}
+	m.SetKeys(keys)
+
+	if err := m.SetSizeFilter(">1KB"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := m.SetSizeFilter("bogus"); err == nil {
+		t.Fatal("expected error for invalid expression")
+	}
+
+	if m.SizeFilterExpr() != ">1KB" {
+		t.Errorf("expected previous filter to remain active, got %q", m.SizeFilterExpr())
+	}
+	if len(m.FilteredKeys()) != 1 {
+		t.Errorf("expected previous filter to still apply, got %d keys", len(m.FilteredKeys()))
+	}
+}
+
+func TestModel_SetSizeFilter_EmptyClears(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "small", Size: 10},
+		{Key: "large", Size: 5 * 1024 * 1024},
+	}
+	m.SetKeys(keys)
+
+	if err := m.SetSizeFilter(">1KB"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := m.SetSizeFilter(""); err != nil {
+		t.Fatalf("unexpected error clearing filter: %v", err)
+	}
+
+	if m.SizeFilterExpr() != "" {
+		t.Errorf("expected empty SizeFilterExpr after clearing, got %q", m.SizeFilterExpr())
+	}
+	if len(m.FilteredKeys()) != 2 {
+		t.Errorf("expected all keys after clearing filter, got %d", len(m.FilteredKeys()))
+	}
+}
@@ -0,0 +1,92 @@
+package keylist
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TTLPredicate tests a KeyInfo.Expiration (a Unix timestamp, or 0 for a key
+// that never expires) against a parsed TTL filter expression, given the
+// current time as a Unix timestamp. See ParseTTLFilter.
+type TTLPredicate func(expiration, now int64) bool
+
+var ttlOperatorRe = regexp.MustCompile(`(?i)^(>=|<=|>|<)(\d+(?:\.\d+)?)(s|m|h|d)?$`)
+
+// ParseTTLFilter parses a TTL filter expression into a TTLPredicate.
+// Supported forms (units default to seconds and are case-insensitive):
+//
+//	never   keys that never expire (KeyInfo.Expiration == 0)
+//	<5m     keys expiring in under 5 minutes
+//	>1h     keys expiring in more than 1 hour, or that never expire
+//	>=10s   keys expiring in at least 10 seconds, or that never expire
+//	<=2h    keys expiring within 2 hours (never-expiring keys never match)
+//
+// Recognized units are s, m, h, and d. A key that never expires has an
+// effectively infinite TTL: it matches every ">"/">=" expression and no
+// "<"/"<=" expression.
+func ParseTTLFilter(expr string) (TTLPredicate, error) {
+	expr = strings.TrimSpace(expr)
+
+	if strings.EqualFold(expr, "never") {
+		return func(expiration, now int64) bool { return expiration == 0 }, nil
+	}
+
+	m := ttlOperatorRe.FindStringSubmatch(expr)
+	if m == nil {
+		return nil, fmt.Errorf("invalid TTL filter expression: %q", expr)
+	}
+
+	op := m[1]
+	threshold, err := parseTTLDuration(m[2], m[3])
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case ">":
+		return func(expiration, now int64) bool {
+			return expiration == 0 || expiration-now > threshold
+		}, nil
+	case ">=":
+		return func(expiration, now int64) bool {
+			return expiration == 0 || expiration-now >= threshold
+		}, nil
+	case "<":
+		return func(expiration, now int64) bool {
+			return expiration != 0 && expiration-now < threshold
+		}, nil
+	case "<=":
+		return func(expiration, now int64) bool {
+			return expiration != 0 && expiration-now <= threshold
+		}, nil
+	}
+
+	return nil, fmt.Errorf("invalid TTL filter expression: %q", expr)
+}
+
+// parseTTLDuration converts a numeric string and an optional unit suffix
+// (s, m, h, d; case-insensitive, defaulting to s) into a second count.
+func parseTTLDuration(numStr, unit string) (int64, error) {
+	n, err := strconv.ParseFloat(numStr, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid TTL value %q: %w", numStr, err)
+	}
+
+	var multiplier float64
+	switch strings.ToLower(unit) {
+	case "", "s":
+		multiplier = 1
+	case "m":
+		multiplier = 60
+	case "h":
+		multiplier = 3600
+	case "d":
+		multiplier = 86400
+	default:
+		return 0, fmt.Errorf("invalid TTL unit %q", unit)
+	}
+
+	return int64(n * multiplier), nil
+}
@@ -0,0 +1,179 @@
+package keylist_test
+
+import (
+	"testing"
+
+	"github.com/nnnkkk7/memtui/models"
+	"github.com/nnnkkk7/memtui/ui/components/keylist"
+)
+
+// fixedNow is an arbitrary reference timestamp used throughout these tests
+// so expectations don't depend on the wall clock.
+const fixedNow int64 = 1_700_000_000
+
+func TestParseTTLFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		matches []int64 // Expiration values that should match at fixedNow
+		rejects []int64
+	}{
+		{
+			name:    "never matches only permanent keys",
+			expr:    "never",
+			matches: []int64{0},
+			rejects: []int64{fixedNow + 1, fixedNow - 1},
+		},
+		{
+			name:    "less than 5 minutes",
+			expr:    "<5m",
+			matches: []int64{fixedNow + 1, fixedNow + 299},
+			rejects: []int64{fixedNow + 300, fixedNow + 3600, 0},
+		},
+		{
+			name:    "greater than 1 hour also matches permanent keys",
+			expr:    ">1h",
+			matches: []int64{fixedNow + 3601, 0},
+			rejects: []int64{fixedNow + 3600, fixedNow + 60},
+		},
+		{
+			name:    "greater than or equal in seconds",
+			expr:    ">=30s",
+			matches: []int64{fixedNow + 30, fixedNow + 31, 0},
+			rejects: []int64{fixedNow + 29},
+		},
+		{
+			name:    "less than or equal in days never matches permanent keys",
+			expr:    "<=1d",
+			matches: []int64{fixedNow + 86400, fixedNow + 1},
+			rejects: []int64{fixedNow + 86401, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pred, err := keylist.ParseTTLFilter(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, exp := range tt.matches {
+				if !pred(exp, fixedNow) {
+					t.Errorf("expected expiration %d to match %q at now=%d", exp, tt.expr, fixedNow)
+				}
+			}
+			for _, exp := range tt.rejects {
+				if pred(exp, fixedNow) {
+					t.Errorf("expected expiration %d not to match %q at now=%d", exp, tt.expr, fixedNow)
+				}
+			}
+		})
+	}
+}
+
+func TestParseTTLFilter_InvalidExpression(t *testing.T) {
+	tests := []string{
+		"",
+		"soon",
+		"5m",
+		">5x",
+		">",
+		"never5m",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := keylist.ParseTTLFilter(expr); err == nil {
+				t.Errorf("expected error for invalid expression %q", expr)
+			}
+		})
+	}
+}
+
+func TestModel_SetTTLFilter(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "permanent", Expiration: 0},
+		{Key: "expiring-soon", Expiration: fixedNow + 10},
+		{Key: "expiring-later", Expiration: fixedNow + 7200},
+	}
+	m.SetKeys(keys)
+
+	if err := m.SetTTLFilter("never"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	filtered := m.FilteredKeys()
+	if len(filtered) != 1 || filtered[0].Key != "permanent" {
+		t.Fatalf("expected only the permanent key, got %+v", filtered)
+	}
+
+	if m.TTLFilterExpr() != "never" {
+		t.Errorf("expected TTLFilterExpr to report %q, got %q", "never", m.TTLFilterExpr())
+	}
+}
+
+func TestModel_SetTTLFilter_CombinesWithTextAndSizeFilters(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "user:1", Size: 10, Expiration: 0},
+		{Key: "user:2", Size: 200 * 1024, Expiration: 0},
+		{Key: "session:abc", Size: 200 * 1024, Expiration: 0},
+	}
+	m.SetKeys(keys)
+
+	m.SetFilter("user")
+	if err := m.SetSizeFilter(">100KB"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := m.SetTTLFilter("never"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	filtered := m.FilteredKeys()
+	if len(filtered) != 1 || filtered[0].Key != "user:2" {
+		t.Fatalf("expected only user:2 to match all three filters, got %+v", filtered)
+	}
+}
+
+func TestModel_SetTTLFilter_InvalidExprLeavesPreviousFilter(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "permanent", Expiration: 0},
+		{Key: "expiring", Expiration: fixedNow + 10},
+	}
+	m.SetKeys(keys)
+
+	if err := m.SetTTLFilter("never"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := m.SetTTLFilter("bogus"); err == nil {
+		t.Fatal("expected error for invalid expression")
+	}
+
+	if m.TTLFilterExpr() != "never" {
+		t.Errorf("expected previous filter to remain active, got %q", m.TTLFilterExpr())
+	}
+}
+
+func TestModel_SetTTLFilter_EmptyClears(t *testing.T) {
+	m := keylist.NewModel()
+	keys := []models.KeyInfo{
+		{Key: "permanent", Expiration: 0},
+		{Key: "expiring", Expiration: fixedNow + 10},
+	}
+	m.SetKeys(keys)
+
+	if err := m.SetTTLFilter("never"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := m.SetTTLFilter(""); err != nil {
+		t.Fatalf("unexpected error clearing filter: %v", err)
+	}
+
+	if m.TTLFilterExpr() != "" {
+		t.Errorf("expected empty TTLFilterExpr after clearing, got %q", m.TTLFilterExpr())
+	}
+	if len(m.FilteredKeys()) != 2 {
+		t.Errorf("expected all keys after clearing filter, got %d", len(m.FilteredKeys()))
+	}
+}
@@ -0,0 +1,255 @@
+// Package quickswitch provides a fuzzy-filtered overlay for jumping back to
+// a recently viewed key.
+package quickswitch
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/nnnkkk7/memtui/ui/components/command"
+)
+
+// KeySelectedMsg is sent when a key is chosen from the quick switcher.
+type KeySelectedMsg struct {
+	Key string
+}
+
+// CancelMsg is sent when the quick switcher is dismissed without a selection.
+type CancelMsg struct{}
+
+// Model is the quick switcher overlay component.
+type Model struct {
+	input    textinput.Model
+	keys     []string // recent keys, most-recent-first
+	filtered []string
+	selected int
+	width    int
+	height   int
+
+	// Styles
+	overlayStyle  lipgloss.Style
+	titleStyle    lipgloss.Style
+	itemStyle     lipgloss.Style
+	selectedStyle lipgloss.Style
+	emptyStyle    lipgloss.Style
+	hintStyle     lipgloss.Style
+}
+
+// New creates a new quick switcher populated with the given recent keys,
+// most recently viewed first.
+func New(keys []string) *Model {
+	ti := textinput.New()
+	ti.Placeholder = "Type to filter recent keys..."
+	ti.Focus()
+	ti.CharLimit = 256
+	ti.Width = 40
+
+	m := &Model{
+		input:    ti,
+		keys:     keys,
+		filtered: append([]string{}, keys...),
+
+		overlayStyle: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("#528BFF")).
+			Padding(1, 2).
+			Background(lipgloss.Color("#282C34")),
+
+		titleStyle: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#E5C07B")).
+			MarginBottom(1),
+
+		itemStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#ABB2BF")).
+			PaddingLeft(1),
+
+		selectedStyle: lipgloss.NewStyle().
+			Background(lipgloss.Color("#528BFF")).
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Bold(true).
+			PaddingLeft(1),
+
+		emptyStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#5C6370")).
+			Italic(true).
+			PaddingLeft(1),
+
+		hintStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#5C6370")).
+			MarginTop(1).
+			Italic(true),
+	}
+
+	return m
+}
+
+// Init implements tea.Model.
+func (m *Model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update implements tea.Model.
+func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyEnter:
+			return m, m.selectCurrent()
+
+		case tea.KeyEsc:
+			return m, m.cancel()
+
+		case tea.KeyUp:
+			m.moveUp()
+			return m, nil
+
+		case tea.KeyDown:
+			m.moveDown()
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	m.filterKeys(m.input.Value())
+
+	return m, cmd
+}
+
+// moveUp moves the selection up with wrapping.
+func (m *Model) moveUp() {
+	if len(m.filtered) == 0 {
+		return
+	}
+	m.selected--
+	if m.selected < 0 {
+		m.selected = len(m.filtered) - 1
+	}
+}
+
+// moveDown moves the selection down with wrapping.
+func (m *Model) moveDown() {
+	if len(m.filtered) == 0 {
+		return
+	}
+	m.selected++
+	if m.selected >= len(m.filtered) {
+		m.selected = 0
+	}
+}
+
+// filterKeys filters the recent key list by fuzzy-matching query, ranking
+// matches by score (highest first).
+func (m *Model) filterKeys(query string) {
+	if query == "" {
+		m.filtered = append([]string{}, m.keys...)
+		m.selected = 0
+		return
+	}
+
+	type scoredKey struct {
+		key   string
+		score int
+	}
+
+	scored := make([]scoredKey, 0, len(m.keys))
+	for _, key := range m.keys {
+		if ok, score := command.FuzzyMatch(query, key); ok {
+			scored = append(scored, scoredKey{key: key, score: score})
+		}
+	}
+
+	for i := 0; i < len(scored)-1; i++ {
+		for j := 0; j < len(scored)-i-1; j++ {
+			if scored[j].score < scored[j+1].score {
+				scored[j], scored[j+1] = scored[j+1], scored[j]
+			}
+		}
+	}
+
+	m.filtered = make([]string, len(scored))
+	for i, sk := range scored {
+		m.filtered[i] = sk.key
+	}
+	m.selected = 0
+}
+
+// selectCurrent returns a command that reports the selected key, if any.
+func (m *Model) selectCurrent() tea.Cmd {
+	if len(m.filtered) == 0 || m.selected >= len(m.filtered) {
+		return nil
+	}
+	key := m.filtered[m.selected]
+
+	return func() tea.Msg {
+		return KeySelectedMsg{Key: key}
+	}
+}
+
+// cancel returns a command that reports the switcher was dismissed.
+func (m *Model) cancel() tea.Cmd {
+	return func() tea.Msg {
+		return CancelMsg{}
+	}
+}
+
+// SetSize sets the dimensions used to lay out the overlay.
+func (m *Model) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// Selected returns the currently highlighted key, or "" if there is none.
+func (m *Model) Selected() string {
+	if len(m.filtered) == 0 || m.selected >= len(m.filtered) {
+		return ""
+	}
+	return m.filtered[m.selected]
+}
+
+// Filtered returns the currently filtered, ranked key list.
+func (m *Model) Filtered() []string {
+	return m.filtered
+}
+
+// View implements tea.Model.
+func (m *Model) View() string {
+	var b strings.Builder
+
+	b.WriteString(m.titleStyle.Render("Recently Viewed Keys"))
+	b.WriteString("\n\n")
+
+	b.WriteString(m.input.View())
+	b.WriteString("\n\n")
+
+	if len(m.keys) == 0 {
+		b.WriteString(m.emptyStyle.Render("No recently viewed keys yet"))
+		b.WriteString("\n")
+	} else if len(m.filtered) == 0 {
+		b.WriteString(m.emptyStyle.Render("No matching keys"))
+		b.WriteString("\n")
+	} else {
+		maxItems := 10
+		if len(m.filtered) < maxItems {
+			maxItems = len(m.filtered)
+		}
+
+		for i := 0; i < maxItems; i++ {
+			key := m.filtered[i]
+			if i == m.selected {
+				b.WriteString(m.selectedStyle.Render(key))
+			} else {
+				b.WriteString(m.itemStyle.Render(key))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString(m.hintStyle.Render("Enter: jump to key | Esc: cancel"))
+
+	return m.overlayStyle.Width(m.width - 10).Render(b.String())
+}
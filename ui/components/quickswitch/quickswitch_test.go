@@ -0,0 +1,84 @@
+package quickswitch
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNew_FilteredStartsAsTheFullMostRecentFirstList(t *testing.T) {
+	keys := []string{"user:3", "user:2", "user:1"}
+	m := New(keys)
+
+	if got := m.Filtered(); len(got) != 3 || got[0] != "user:3" || got[1] != "user:2" || got[2] != "user:1" {
+		t.Errorf("expected most-recent-first order [user:3 user:2 user:1], got %v", got)
+	}
+}
+
+func TestUpdate_TypingNarrowsToFuzzyMatches(t *testing.T) {
+	keys := []string{"user:session:1", "config:feature-flags", "user:profile:2"}
+	m := New(keys)
+
+	for _, r := range "user" {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	filtered := m.Filtered()
+	if len(filtered) != 2 {
+		t.Fatalf("expected 2 matches for 'user', got %d: %v", len(filtered), filtered)
+	}
+	for _, key := range filtered {
+		if key == "config:feature-flags" {
+			t.Errorf("expected 'config:feature-flags' to be filtered out, got %v", filtered)
+		}
+	}
+}
+
+func TestUpdate_EnterSelectsTheHighlightedKey(t *testing.T) {
+	keys := []string{"user:1", "user:2"}
+	m := New(keys)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected Enter to return a command")
+	}
+
+	msg := cmd()
+	selected, ok := msg.(KeySelectedMsg)
+	if !ok {
+		t.Fatalf("expected KeySelectedMsg, got %T", msg)
+	}
+	if selected.Key != "user:1" {
+		t.Errorf("expected 'user:1' (most recent), got %q", selected.Key)
+	}
+}
+
+func TestUpdate_EscapeCancels(t *testing.T) {
+	m := New([]string{"user:1"})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("expected Esc to return a command")
+	}
+
+	if _, ok := cmd().(CancelMsg); !ok {
+		t.Fatalf("expected CancelMsg, got %T", cmd())
+	}
+}
+
+func TestUpdate_NoMatchesReturnsEmptyFilteredList(t *testing.T) {
+	m := New([]string{"user:1", "user:2"})
+
+	for _, r := range "zzz" {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	if len(m.Filtered()) != 0 {
+		t.Errorf("expected no matches, got %v", m.Filtered())
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("expected Enter with no matches to return no command")
+	}
+}
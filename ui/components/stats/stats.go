@@ -14,12 +14,36 @@ import (
 // RefreshStatsMsg is a message requesting a stats refresh
 type RefreshStatsMsg struct{}
 
+// CloseMsg is sent when the user requests to close the stats view.
+type CloseMsg struct{}
+
+// statLine is a single renderable line of the stats view: either a section
+// header (label empty) or a "label: value" row. label holds the plain
+// metric name so filtering can match it without stripping lipgloss escape
+// codes out of text.
+type statLine struct {
+	label string
+	text  string
+}
+
 // StatsView is a Bubble Tea component for displaying Memcached statistics.
 type StatsView struct {
 	stats  *models.Stats
 	width  int
 	height int
 
+	// Scrolling (see ui/components/viewer.Model.scrollOffset): the window of
+	// lines currently in view, clamped against the rendered line count in
+	// View.
+	scrollOffset int
+
+	// Filter mode: narrows the visible lines to those whose metric name
+	// contains filterInput (case-insensitive). filtering is true while the
+	// filter text is being edited; the filter stays applied after
+	// confirming with Enter.
+	filtering   bool
+	filterInput string
+
 	// Styles
 	titleStyle   lipgloss.Style
 	sectionStyle lipgloss.Style
@@ -77,6 +101,24 @@ func (s *StatsView) Stats() *models.Stats {
 	return s.stats
 }
 
+// Filter returns the current filter text (matched case-insensitively
+// against metric names), or "" if no filter is applied.
+func (s *StatsView) Filter() string {
+	return s.filterInput
+}
+
+// SetFilter sets the filter text directly, narrowing the visible lines to
+// metrics whose name contains pattern.
+func (s *StatsView) SetFilter(pattern string) {
+	s.filterInput = pattern
+	s.scrollOffset = 0
+}
+
+// Filtering reports whether the filter input is currently being edited.
+func (s *StatsView) Filtering() bool {
+	return s.filtering
+}
+
 // Init initializes the component.
 func (s *StatsView) Init() tea.Cmd {
 	return nil
@@ -91,11 +133,55 @@ func (s *StatsView) Update(msg tea.Msg) (*StatsView, tea.Cmd) {
 		return s, nil
 
 	case tea.KeyMsg:
+		if s.filtering {
+			return s.handleFilterInput(msg)
+		}
+
 		switch msg.Type {
+		case tea.KeyEsc:
+			return s, func() tea.Msg { return CloseMsg{} }
+		case tea.KeyUp:
+			if s.scrollOffset > 0 {
+				s.scrollOffset--
+			}
+			return s, nil
+		case tea.KeyDown:
+			s.scrollOffset++
+			return s, nil
+		case tea.KeyPgUp:
+			s.scrollOffset -= s.pageScrollAmount()
+			if s.scrollOffset < 0 {
+				s.scrollOffset = 0
+			}
+			return s, nil
+		case tea.KeyPgDown:
+			s.scrollOffset += s.pageScrollAmount()
+			return s, nil
 		case tea.KeyRunes:
 			switch string(msg.Runes) {
+			case "q":
+				return s, func() tea.Msg { return CloseMsg{} }
 			case "r", "R":
 				return s, func() tea.Msg { return RefreshStatsMsg{} }
+			case "/":
+				s.filtering = true
+				s.filterInput = ""
+				return s, nil
+			case "j":
+				s.scrollOffset++
+				return s, nil
+			case "k":
+				if s.scrollOffset > 0 {
+					s.scrollOffset--
+				}
+				return s, nil
+			case "g":
+				s.scrollOffset = 0
+				return s, nil
+			case "G":
+				// Go to bottom (clamped against the line count in View)
+				s.scrollOffset = len(s.visibleLines())
+				return s, nil
 			}
 		}
 	}
@@ -103,6 +189,106 @@ func (s *StatsView) Update(msg tea.Msg) (*StatsView, tea.Cmd) {
 	return s, nil
 }
 
+// handleFilterInput updates the filter text while filtering is active. Esc
+// clears the filter and exits; Enter keeps the filter applied and exits
+// editing.
+func (s *StatsView) handleFilterInput(msg tea.KeyMsg) (*StatsView, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		s.filtering = false
+		s.filterInput = ""
+		s.scrollOffset = 0
+	case tea.KeyEnter:
+		s.filtering = false
+	case tea.KeyBackspace:
+		if s.filterInput != "" {
+			s.filterInput = s.filterInput[:len(s.filterInput)-1]
+			s.scrollOffset = 0
+		}
+	case tea.KeyRunes:
+		s.filterInput += string(msg.Runes)
+		s.scrollOffset = 0
+	}
+	return s, nil
+}
+
+// pageScrollAmount returns the number of lines a page up/down jumps by.
+func (s *StatsView) pageScrollAmount() int {
+	if s.height > 4 {
+		return s.height - 4
+	}
+	return 1
+}
+
+// buildLines renders every section and metric into a flat list of lines,
+// independent of the current filter or scroll position.
+func (s *StatsView) buildLines() []statLine {
+	if s.stats == nil {
+		return nil
+	}
+
+	var lines []statLine
+	section := func(title string) {
+		lines = append(lines, statLine{text: s.renderSection(title)})
+	}
+	row := func(label, value string) {
+		lines = append(lines, statLine{label: label, text: s.renderRow(label, value)})
+	}
+
+	section("Server Info")
+	row("Version", s.stats.Version)
+	row("PID", fmt.Sprintf("%d", s.stats.PID))
+	row("Uptime", s.formatUptime())
+
+	section("Connections")
+	row("Current", fmt.Sprintf("%d", s.stats.CurrentConnections))
+	row("Total", fmt.Sprintf("%d", s.stats.TotalConnections))
+
+	section("Items")
+	row("Current Items", fmt.Sprintf("%d", s.stats.CurrentItems))
+	row("Total Items", fmt.Sprintf("%d", s.stats.TotalItems))
+	row("Evictions", s.formatEvictions())
+
+	section("Memory")
+	row("Used", s.stats.BytesFormatted())
+	row("Limit", models.FormatBytes(s.stats.LimitMaxBytes))
+	row("Usage", s.formatMemoryPercentColored())
+
+	section("Performance")
+	row("Hit Rate", s.formatHitRateColored())
+	row("Get Hits", fmt.Sprintf("%d", s.stats.GetHits))
+	row("Get Misses", fmt.Sprintf("%d", s.stats.GetMisses))
+
+	section("Network I/O")
+	row("Bytes Read", models.FormatBytes(s.stats.BytesRead))
+	row("Bytes Written", models.FormatBytes(s.stats.BytesWritten))
+
+	return lines
+}
+
+// visibleLines returns buildLines filtered by filterInput. Section headers
+// are dropped while a filter is active, since they aren't metrics to
+// search by name; the hit-ratio and humanized values on matching rows are
+// left exactly as formatted by buildLines.
+func (s *StatsView) visibleLines() []statLine {
+	all := s.buildLines()
+	if s.filterInput == "" {
+		return all
+	}
+
+	pattern := strings.ToLower(s.filterInput)
+	var filtered []statLine
+	for _, l := range all {
+		if l.label == "" {
+			continue
+		}
+		if strings.Contains(strings.ToLower(l.label), pattern) {
+			filtered = append(filtered, l)
+		}
+	}
+	return filtered
+}
+
 // View renders the component.
 func (s *StatsView) View() string {
 	if s.stats == nil {
@@ -110,60 +296,59 @@ func (s *StatsView) View() string {
 	}
 
 	var b strings.Builder
-
-	// Title
 	b.WriteString(s.titleStyle.Render("Memcached Statistics"))
 	b.WriteString("\n")
 
-	// Server Info Section
-	b.WriteString(s.renderSection("Server Info"))
-	b.WriteString(s.renderRow("Version", s.stats.Version))
-	b.WriteString(s.renderRow("PID", fmt.Sprintf("%d", s.stats.PID)))
-	b.WriteString(s.renderRow("Uptime", s.formatUptime()))
-
-	// Connections Section
-	b.WriteString(s.renderSection("Connections"))
-	b.WriteString(s.renderRow("Current", fmt.Sprintf("%d", s.stats.CurrentConnections)))
-	b.WriteString(s.renderRow("Total", fmt.Sprintf("%d", s.stats.TotalConnections)))
-
-	// Items Section
-	b.WriteString(s.renderSection("Items"))
-	b.WriteString(s.renderRow("Current Items", fmt.Sprintf("%d", s.stats.CurrentItems)))
-	b.WriteString(s.renderRow("Total Items", fmt.Sprintf("%d", s.stats.TotalItems)))
-	b.WriteString(s.renderRow("Evictions", s.formatEvictions()))
-
-	// Memory Section
-	b.WriteString(s.renderSection("Memory"))
-	b.WriteString(s.renderRow("Used", s.stats.BytesFormatted()))
-	b.WriteString(s.renderRow("Limit", models.FormatBytes(s.stats.LimitMaxBytes)))
-	b.WriteString(s.renderRow("Usage", s.formatMemoryPercentColored()))
-
-	// Performance Section
-	b.WriteString(s.renderSection("Performance"))
-	b.WriteString(s.renderRow("Hit Rate", s.formatHitRateColored()))
-	b.WriteString(s.renderRow("Get Hits", fmt.Sprintf("%d", s.stats.GetHits)))
-	b.WriteString(s.renderRow("Get Misses", fmt.Sprintf("%d", s.stats.GetMisses)))
-
-	// Network I/O Section
-	b.WriteString(s.renderSection("Network I/O"))
-	b.WriteString(s.renderRow("Bytes Read", models.FormatBytes(s.stats.BytesRead)))
-	b.WriteString(s.renderRow("Bytes Written", models.FormatBytes(s.stats.BytesWritten)))
-
-	// Footer with refresh hint
+	lines := s.visibleLines()
+
+	// Reserve a couple of lines for the footer hint below the content.
+	// height <= 0 means the caller hasn't sized the component yet; render
+	// everything rather than windowing against a meaningless height.
+	contentHeight := s.height - 2
+	if s.height <= 0 || contentHeight < 1 {
+		contentHeight = len(lines)
+	}
+
+	maxOffset := len(lines) - contentHeight
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	if s.scrollOffset > maxOffset {
+		s.scrollOffset = maxOffset
+	}
+	if s.scrollOffset < 0 {
+		s.scrollOffset = 0
+	}
+
+	end := s.scrollOffset + contentHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+	for i := s.scrollOffset; i < end; i++ {
+		b.WriteString(lines[i].text)
+		b.WriteString("\n")
+	}
+
 	b.WriteString("\n")
-	b.WriteString(s.mutedStyle.Render("Press 'r' to refresh"))
+	if s.filtering {
+		b.WriteString(s.mutedStyle.Render(fmt.Sprintf("Filter: %s", s.filterInput)))
+	} else if s.filterInput != "" {
+		b.WriteString(s.mutedStyle.Render(fmt.Sprintf("Filter: %q (esc to clear) | r:refresh /:edit filter", s.filterInput)))
+	} else {
+		b.WriteString(s.mutedStyle.Render("Press 'r' to refresh, '/' to filter"))
+	}
 
 	return b.String()
 }
 
 // renderSection renders a section header.
 func (s *StatsView) renderSection(title string) string {
-	return "\n" + s.sectionStyle.Render(title) + "\n"
+	return s.sectionStyle.Render(title)
 }
 
 // renderRow renders a label-value row.
 func (s *StatsView) renderRow(label, value string) string {
-	return s.labelStyle.Render(label+":") + " " + s.valueStyle.Render(value) + "\n"
+	return s.labelStyle.Render(label+":") + " " + s.valueStyle.Render(value)
 }
 
 // formatMemoryPercent returns the memory usage as a percentage string.
@@ -210,6 +210,34 @@ func TestStatsView_Update(t *testing.T) {
 		}
 	})
 
+	t.Run("esc requests close", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 24)
+
+		_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+		if cmd == nil {
+			t.Fatal("expected a command from esc")
+		}
+		if _, ok := cmd().(CloseMsg); !ok {
+			t.Error("expected esc to return a CloseMsg")
+		}
+	})
+
+	t.Run("q requests close", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 24)
+
+		_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+
+		if cmd == nil {
+			t.Fatal("expected a command from 'q'")
+		}
+		if _, ok := cmd().(CloseMsg); !ok {
+			t.Error("expected 'q' to return a CloseMsg")
+		}
+	})
+
 	t.Run("handles unknown key gracefully", func(t *testing.T) {
 		view := New()
 		view.SetSize(80, 24)
@@ -362,3 +390,215 @@ func TestRefreshStatsMsg(t *testing.T) {
 		_ = msg // Just verify it compiles
 	})
 }
+
+func TestStatsView_Filter(t *testing.T) {
+	t.Run("narrows the visible stat lines by metric name", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+
+		full := len(view.visibleLines())
+
+		view.SetFilter("hit rate")
+		filtered := view.visibleLines()
+
+		if len(filtered) >= full {
+			t.Fatalf("expected filter to narrow line count below %d, got %d", full, len(filtered))
+		}
+		if len(filtered) == 0 {
+			t.Fatal("expected at least one line to match 'hit rate'")
+		}
+		for _, l := range filtered {
+			if l.label != "Hit Rate" {
+				t.Errorf("expected only 'Hit Rate' lines, got %q", l.label)
+			}
+		}
+	})
+
+	t.Run("keeps colored and humanized formatting while filtered", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+		view.SetFilter("hit rate")
+
+		output := view.View()
+
+		if !strings.Contains(output, "80.00%") {
+			t.Errorf("expected formatted hit rate '80.00%%' to survive filtering, got: %s", output)
+		}
+	})
+
+	t.Run("drops section headers while filtering", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+		view.SetFilter("zzz-no-match")
+
+		if lines := view.visibleLines(); len(lines) != 0 {
+			t.Errorf("expected no lines to match 'zzz-no-match', got %d", len(lines))
+		}
+	})
+
+	t.Run("empty filter shows every line", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+
+		all := view.buildLines()
+		visible := view.visibleLines()
+
+		if len(all) != len(visible) {
+			t.Errorf("expected %d lines with no filter, got %d", len(all), len(visible))
+		}
+	})
+
+	t.Run("'/' key enters filter editing mode", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+
+		updated, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+
+		if cmd != nil {
+			t.Error("expected no command from entering filter mode")
+		}
+		if !updated.Filtering() {
+			t.Error("expected filtering mode to be active")
+		}
+	})
+
+	t.Run("typing while filtering appends to the filter instead of refreshing", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+		view, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+
+		if cmd != nil {
+			t.Error("expected 'r' typed while filtering to not trigger a refresh")
+		}
+		if view.Filter() != "r" {
+			t.Errorf("expected filter text 'r', got %q", view.Filter())
+		}
+	})
+
+	t.Run("esc clears the filter and exits editing", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+		view.SetFilter("hit")
+		view.filtering = true
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+		if view.Filtering() {
+			t.Error("expected filtering mode to be exited")
+		}
+		if view.Filter() != "" {
+			t.Errorf("expected filter to be cleared, got %q", view.Filter())
+		}
+	})
+
+	t.Run("enter keeps the filter applied and exits editing", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p', 'i', 'd'}})
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		if view.Filtering() {
+			t.Error("expected filtering mode to be exited")
+		}
+		if view.Filter() != "pid" {
+			t.Errorf("expected filter 'pid' to remain applied, got %q", view.Filter())
+		}
+	})
+
+	t.Run("backspace trims the filter", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.filtering = true
+		view.filterInput = "pid"
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+
+		if view.Filter() != "pi" {
+			t.Errorf("expected filter 'pi', got %q", view.Filter())
+		}
+	})
+
+	t.Run("refresh still works once filter editing is confirmed", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 40)
+		view.SetStats(createTestStats())
+		view.SetFilter("hit")
+
+		_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+
+		if cmd == nil {
+			t.Error("expected 'r' to re-run the stats refresh command once not in filter-edit mode")
+		}
+	})
+}
+
+func TestStatsView_Scroll(t *testing.T) {
+	t.Run("down and up move the scroll offset", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 6)
+		view.SetStats(createTestStats())
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyDown})
+		if view.scrollOffset != 1 {
+			t.Errorf("expected scrollOffset 1 after down, got %d", view.scrollOffset)
+		}
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyUp})
+		if view.scrollOffset != 0 {
+			t.Errorf("expected scrollOffset 0 after up, got %d", view.scrollOffset)
+		}
+	})
+
+	t.Run("up does not go negative", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 6)
+		view.SetStats(createTestStats())
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+		if view.scrollOffset != 0 {
+			t.Errorf("expected scrollOffset to stay at 0, got %d", view.scrollOffset)
+		}
+	})
+
+	t.Run("View clamps scroll offset to the last page", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 6)
+		view.SetStats(createTestStats())
+		view.scrollOffset = 1000
+
+		output := view.View()
+
+		if !strings.Contains(output, "Network I/O") {
+			t.Errorf("expected the final section to be visible once clamped, got: %s", output)
+		}
+	})
+
+	t.Run("'G' jumps toward the bottom and 'g' back to the top", func(t *testing.T) {
+		view := New()
+		view.SetSize(80, 6)
+		view.SetStats(createTestStats())
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+		if view.scrollOffset == 0 {
+			t.Error("expected 'G' to move the scroll offset down")
+		}
+
+		view, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+		if view.scrollOffset != 0 {
+			t.Errorf("expected 'g' to reset scrollOffset to 0, got %d", view.scrollOffset)
+		}
+	})
+}
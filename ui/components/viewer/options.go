@@ -0,0 +1,138 @@
+package viewer
+
+import "github.com/nnnkkk7/memtui/models"
+
+// Option configures a Model constructed with New. Options are applied in a
+// fixed, dependency-safe order regardless of the order they're passed in, so
+// callers never need to worry about construction ordering (e.g. value vs.
+// view mode).
+type Option func(*options)
+
+type options struct {
+	width, height int
+	sizeSet       bool
+
+	value    []byte
+	valueSet bool
+
+	keyInfo    models.KeyInfo
+	keyInfoSet bool
+
+	viewMode    ViewMode
+	viewModeSet bool
+
+	wrap        *bool
+	lineNumbers *bool
+	hexWidth    *int
+	hexRuler    *bool
+	htmlStrip   *bool
+	jsonCompact *bool
+}
+
+// WithSize sets the component's rendering dimensions.
+func WithSize(width, height int) Option {
+	return func(o *options) {
+		o.width, o.height = width, height
+		o.sizeSet = true
+	}
+}
+
+// WithValue sets the value to display.
+func WithValue(value []byte) Option {
+	return func(o *options) {
+		o.value = value
+		o.valueSet = true
+	}
+}
+
+// WithKeyInfo sets the key metadata shown alongside the value.
+func WithKeyInfo(ki models.KeyInfo) Option {
+	return func(o *options) {
+		o.keyInfo = ki
+		o.keyInfoSet = true
+	}
+}
+
+// WithViewMode sets the initial view mode.
+func WithViewMode(mode ViewMode) Option {
+	return func(o *options) {
+		o.viewMode = mode
+		o.viewModeSet = true
+	}
+}
+
+// WithWrap enables or disables soft-wrapping of long lines.
+func WithWrap(wrap bool) Option {
+	return func(o *options) { o.wrap = &wrap }
+}
+
+// WithLineNumbers enables or disables the line number gutter.
+func WithLineNumbers(show bool) Option {
+	return func(o *options) { o.lineNumbers = &show }
+}
+
+// WithHexWidth sets the number of bytes shown per line in hex view.
+func WithHexWidth(width int) Option {
+	return func(o *options) { o.hexWidth = &width }
+}
+
+// WithHexRuler enables or disables the hex dump column ruler.
+func WithHexRuler(show bool) Option {
+	return func(o *options) { o.hexRuler = &show }
+}
+
+// WithHTMLStripTags enables or disables the tag-stripped HTML preview.
+func WithHTMLStripTags(strip bool) Option {
+	return func(o *options) { o.htmlStrip = &strip }
+}
+
+// WithJSONCompact enables or disables single-line, compact JSON rendering
+// in JSON view mode, instead of the default pretty-printed form.
+func WithJSONCompact(compact bool) Option {
+	return func(o *options) { o.jsonCompact = &compact }
+}
+
+// New creates a viewer Model from a set of Options, applying them in a fixed
+// order so the result is the same regardless of the order Options are
+// listed. This is the preferred constructor when embedding the viewer in
+// another Bubble Tea program; NewModel remains available for callers that
+// want to wire setters up manually.
+func New(opts ...Option) *Model {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	m := NewModel()
+	if o.sizeSet {
+		m.SetSize(o.width, o.height)
+	}
+	if o.wrap != nil {
+		m.SetWrap(*o.wrap)
+	}
+	if o.lineNumbers != nil {
+		m.SetLineNumbers(*o.lineNumbers)
+	}
+	if o.hexWidth != nil {
+		m.SetHexWidth(*o.hexWidth)
+	}
+	if o.hexRuler != nil {
+		m.SetHexRuler(*o.hexRuler)
+	}
+	if o.htmlStrip != nil {
+		m.SetHTMLStripTags(*o.htmlStrip)
+	}
+	if o.jsonCompact != nil {
+		m.SetJSONCompact(*o.jsonCompact)
+	}
+	if o.keyInfoSet {
+		m.SetKeyInfo(o.keyInfo)
+	}
+	if o.valueSet {
+		m.SetValue(o.value)
+	}
+	if o.viewModeSet {
+		m.SetViewMode(o.viewMode)
+	}
+	return m
+}
@@ -2,8 +2,13 @@
 package viewer
 
 import (
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
+	"hash/crc32"
+	"regexp"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -24,8 +29,26 @@ const (
 	ViewModeHex
 	// ViewModeText displays data as plain text
 	ViewModeText
+	// ViewModeJWT forces interpretation of the value as a JSON Web Token
+	ViewModeJWT
+	// ViewModeRaw renders the value with visible escape sequences for
+	// whitespace and non-printable bytes, without interpretation
+	ViewModeRaw
+	// ViewModeHTML displays data as indented HTML, or a tag-stripped text
+	// preview when strip-tags mode is enabled
+	ViewModeHTML
 )
 
+// PrefsChangedMsg is emitted when the user toggles a display preference
+// (wrap, line numbers, or hex width) at runtime, so the app layer can
+// persist the new value to the on-disk config.
+type PrefsChangedMsg struct {
+	Wrap        bool
+	LineNumbers bool
+	HexWidth    int
+	HexRuler    bool
+}
+
 // String returns the string representation of the view mode
 func (v ViewMode) String() string {
 	switch v {
@@ -37,6 +60,12 @@ func (v ViewMode) String() string {
 		return "Hex"
 	case ViewModeText:
 		return "Text"
+	case ViewModeJWT:
+		return "JWT"
+	case ViewModeRaw:
+		return "Raw"
+	case ViewModeHTML:
+		return "HTML"
 	default:
 		return "Unknown"
 	}
@@ -48,16 +77,68 @@ type Model struct {
 	keyInfo      models.KeyInfo
 	viewMode     ViewMode
 	detectedType viewerPkg.DataType
-	content      string
-	scrollOffset int
-	width        int
-	height       int
+	// typeOverridden is true once CycleDetectedType has manually picked
+	// detectedType, so ViewModeAuto renders that chosen type instead of
+	// re-running DetectType from scratch.
+	typeOverridden bool
+	content        string
+	scrollOffset   int
+	width          int
+	height         int
+
+	wrap          bool
+	lineNumbers   bool
+	hexWidth      int
+	hexRuler      bool
+	htmlStripTags bool
+	jsonCompact   bool
+
+	// checksumAlgo selects a checksum shown alongside the byte length in the
+	// header (see SetChecksumAlgorithm): "", "crc32", or "md5". Always
+	// computed over the full value, not the truncated display content.
+	checksumAlgo string
+
+	// maxDisplayBytes caps how much of an oversized value is formatted and
+	// rendered (see SetMaxDisplayBytes); 0 disables the cap. displayTruncated
+	// reports whether the most recent formatContent actually applied it, and
+	// showFullValue is a one-shot override set by LoadFullValue.
+	maxDisplayBytes  int
+	displayTruncated bool
+	showFullValue    bool
+
+	// Byte-range window for hex mode (see SetByteRange), used to page
+	// through extremely large values without formatting the whole thing
+	byteRangeEnabled bool
+	byteRangeStart   int
+	byteRangeLength  int
+
+	// pageScrollMode and pageScrollLines control how far PgUp/PgDn scroll
+	// (see SetPageScrollMode/SetPageScrollLines): "full" and "half" derive
+	// the amount from the viewport height, "lines" uses pageScrollLines
+	// directly. Defaults to "full" so behavior matches before these were
+	// configurable.
+	pageScrollMode  string
+	pageScrollLines int
+
+	// Rendered-line cache (see renderedLinesFor), keyed by the inputs that
+	// affect wrapping: the formatted content itself (which already reflects
+	// value and mode), the available width, and whether wrap is on. Scrolling
+	// re-renders every keystroke but doesn't change any of these, so the
+	// cache lets large values avoid re-splitting/re-wrapping on every frame.
+	renderedLinesCache      []renderedLine
+	renderedLinesContent    string
+	renderedLinesWidth      int
+	renderedLinesWrap       bool
+	renderedLinesCacheValid bool
 
 	// Formatters
 	jsonFormatter *viewerPkg.JSONFormatter
 	hexFormatter  *viewerPkg.HexFormatter
 	textFormatter *viewerPkg.TextFormatter
 	autoFormatter *viewerPkg.AutoFormatter
+	jwtFormatter  *viewerPkg.JWTFormatter
+	rawFormatter  *viewerPkg.RawFormatter
+	htmlFormatter *viewerPkg.HTMLFormatter
 
 	// Styles
 	headerStyle  lipgloss.Style
@@ -68,11 +149,17 @@ type Model struct {
 // NewModel creates a new viewer model
 func NewModel() *Model {
 	return &Model{
-		viewMode:      ViewModeAuto,
-		jsonFormatter: viewerPkg.NewJSONFormatter(),
-		hexFormatter:  viewerPkg.NewHexFormatter(),
-		textFormatter: viewerPkg.NewTextFormatter(),
-		autoFormatter: viewerPkg.NewAutoFormatter(),
+		viewMode:        ViewModeAuto,
+		hexWidth:        16,
+		pageScrollMode:  "full",
+		pageScrollLines: 10,
+		jsonFormatter:   viewerPkg.NewJSONFormatter(),
+		hexFormatter:    viewerPkg.NewHexFormatter(),
+		textFormatter:   viewerPkg.NewTextFormatter(),
+		autoFormatter:   viewerPkg.NewAutoFormatter(),
+		jwtFormatter:    viewerPkg.NewJWTFormatter(),
+		rawFormatter:    viewerPkg.NewRawFormatter(),
+		htmlFormatter:   viewerPkg.NewHTMLFormatter(),
 		headerStyle: lipgloss.NewStyle().
 			Bold(true).
 			Foreground(lipgloss.Color("205")),
@@ -87,10 +174,37 @@ func NewModel() *Model {
 func (m *Model) SetValue(value []byte) {
 	m.value = value
 	m.detectedType = viewerPkg.DetectType(value)
+	m.byteRangeEnabled = false
+	m.byteRangeStart = 0
+	m.byteRangeLength = 0
+	m.showFullValue = false
+	m.typeOverridden = false
 	m.formatContent()
 	m.scrollOffset = 0
 }
 
+// CycleDetectedType re-runs type detection excluding the current guess,
+// rotating through the next plausible interpretation of the value (see
+// viewer.CandidateTypes) - for when auto-detection guesses wrong, e.g.
+// borderline base64 vs binary. Only meaningful in ViewModeAuto; re-renders
+// immediately. A value with only one plausible interpretation is a no-op.
+func (m *Model) CycleDetectedType() {
+	candidates := viewerPkg.CandidateTypes(m.value)
+	if len(candidates) <= 1 {
+		return
+	}
+	m.detectedType = viewerPkg.NextCandidateType(m.value, m.detectedType)
+	m.typeOverridden = true
+	m.formatContent()
+}
+
+// DetectedTypeCandidates returns every plausible interpretation of the
+// current value, in the order CycleDetectedType rotates through them.
+// Exposed primarily for testing.
+func (m *Model) DetectedTypeCandidates() []viewerPkg.DataType {
+	return viewerPkg.CandidateTypes(m.value)
+}
+
 // SetKeyInfo sets the key info
 func (m *Model) SetKeyInfo(ki models.KeyInfo) {
 	m.keyInfo = ki
@@ -113,6 +227,270 @@ func (m *Model) ViewMode() ViewMode {
 	return m.viewMode
 }
 
+// SetWrap enables or disables soft-wrapping of long lines. When disabled,
+// lines wider than the viewport are truncated with a trailing ellipsis.
+func (m *Model) SetWrap(wrap bool) {
+	m.wrap = wrap
+}
+
+// Wrap returns whether soft-wrapping is enabled
+func (m *Model) Wrap() bool {
+	return m.wrap
+}
+
+// SetLineNumbers enables or disables the line number gutter
+func (m *Model) SetLineNumbers(show bool) {
+	m.lineNumbers = show
+}
+
+// LineNumbers returns whether the line number gutter is shown
+func (m *Model) LineNumbers() bool {
+	return m.lineNumbers
+}
+
+// SetHexWidth sets the number of bytes shown per line in hex view. Values
+// less than 1 are ignored.
+func (m *Model) SetHexWidth(width int) {
+	if width < 1 {
+		return
+	}
+	m.hexWidth = width
+	m.hexFormatter.SetBytesPerLine(width)
+	m.autoFormatter.SetHexBytesPerLine(width)
+	m.formatContent()
+}
+
+// HexWidth returns the number of bytes shown per line in hex view
+func (m *Model) HexWidth() int {
+	return m.hexWidth
+}
+
+// SetHexRuler enables or disables a column index header above hex dumps
+func (m *Model) SetHexRuler(show bool) {
+	m.hexRuler = show
+	m.hexFormatter.SetShowRuler(show)
+	m.autoFormatter.SetHexShowRuler(show)
+	m.formatContent()
+}
+
+// SetJSONIndent changes the indentation string (e.g. "  ", "    ", or "\t")
+// used when pretty-printing JSON.
+func (m *Model) SetJSONIndent(indent string) {
+	m.jsonFormatter.SetIndent(indent)
+	m.autoFormatter.SetJSONIndent(indent)
+	m.formatContent()
+}
+
+// HexRuler returns whether the hex dump column ruler is shown
+func (m *Model) HexRuler() bool {
+	return m.hexRuler
+}
+
+// SetSortKeys enables or disables alphabetical sorting of JSON object keys
+// when pretty-printing JSON. Array order is always preserved.
+func (m *Model) SetSortKeys(sort bool) {
+	m.jsonFormatter.SetSortKeys(sort)
+	m.autoFormatter.SetSortKeys(sort)
+	m.formatContent()
+}
+
+// SetHTMLStripTags enables or disables rendering HTML as a tag-stripped
+// text preview instead of indented markup.
+func (m *Model) SetHTMLStripTags(strip bool) {
+	m.htmlStripTags = strip
+	m.htmlFormatter.SetStripTags(strip)
+	m.formatContent()
+}
+
+// HTMLStripTags returns whether the HTML tag-stripped text preview is shown
+func (m *Model) HTMLStripTags() bool {
+	return m.htmlStripTags
+}
+
+// SetJSONCompact enables or disables single-line, compact JSON rendering in
+// JSON view mode, instead of the default pretty-printed (indented) form.
+func (m *Model) SetJSONCompact(compact bool) {
+	m.jsonCompact = compact
+	m.jsonFormatter.SetCompact(compact)
+	m.formatContent()
+}
+
+// JSONCompact returns whether JSON view mode renders compact, single-line
+// JSON instead of the default pretty-printed form.
+func (m *Model) JSONCompact() bool {
+	return m.jsonCompact
+}
+
+// SetChecksumAlgorithm selects a checksum to display alongside the byte
+// length in the header: "crc32", "md5", or "" to disable it. Invalid values
+// are treated as "".
+func (m *Model) SetChecksumAlgorithm(algo string) {
+	switch algo {
+	case "crc32", "md5":
+		m.checksumAlgo = algo
+	default:
+		m.checksumAlgo = ""
+	}
+}
+
+// ChecksumAlgorithm returns the checksum algorithm shown in the header, or
+// "" if the checksum display is disabled.
+func (m *Model) ChecksumAlgorithm() string {
+	return m.checksumAlgo
+}
+
+// checksum computes the configured checksum over the full value bytes,
+// independent of any display truncation, formatted as a hex string.
+func (m *Model) checksum() string {
+	switch m.checksumAlgo {
+	case "crc32":
+		return fmt.Sprintf("%08x", crc32.ChecksumIEEE(m.value))
+	case "md5":
+		sum := md5.Sum(m.value) //nolint:gosec // integrity display only, not used for security
+		return hex.EncodeToString(sum[:])
+	default:
+		return ""
+	}
+}
+
+// SetMaxDisplayBytes caps how much of the value is formatted and rendered;
+// values beyond this limit show a truncation notice instead of being
+// formatted in full, but the complete value (for copy/save/edit) is always
+// retained. Pass 0 to disable the cap.
+func (m *Model) SetMaxDisplayBytes(n int) {
+	m.maxDisplayBytes = n
+	m.formatContent()
+}
+
+// MaxDisplayBytes returns the current display size cap, or 0 if disabled.
+func (m *Model) MaxDisplayBytes() int {
+	return m.maxDisplayBytes
+}
+
+// IsTruncatedDisplay reports whether the currently rendered content reflects
+// only the first MaxDisplayBytes of the value rather than the whole thing.
+func (m *Model) IsTruncatedDisplay() bool {
+	return m.displayTruncated
+}
+
+// LoadFullValue disables the display size cap for the current value, so the
+// whole thing is formatted and rendered, until the next SetValue.
+func (m *Model) LoadFullValue() {
+	m.showFullValue = true
+	m.formatContent()
+}
+
+// SetPageScrollMode selects how far PgUp/PgDn scroll: "full" (the viewport
+// height), "half" (half the viewport height), or "lines" (a fixed count set
+// by SetPageScrollLines). An unrecognized value is treated as "full".
+func (m *Model) SetPageScrollMode(mode string) {
+	m.pageScrollMode = mode
+}
+
+// SetPageScrollLines sets the line count PgUp/PgDn scroll by when
+// PageScrollMode is "lines".
+func (m *Model) SetPageScrollLines(n int) {
+	m.pageScrollLines = n
+}
+
+// pageScrollAmount returns how many lines PgUp/PgDn should scroll by, given
+// the configured mode and the current viewport height.
+func (m *Model) pageScrollAmount() int {
+	viewportSize := m.height - 4
+	if viewportSize < 1 {
+		viewportSize = 10
+	}
+
+	switch m.pageScrollMode {
+	case "half":
+		amount := viewportSize / 2
+		if amount < 1 {
+			amount = 1
+		}
+		return amount
+	case "lines":
+		if m.pageScrollLines < 1 {
+			return 1
+		}
+		return m.pageScrollLines
+	default:
+		return viewportSize
+	}
+}
+
+// halfPageScrollAmount returns how many lines the Ctrl+D/Ctrl+U half-page
+// scroll keys move by, independent of PageScrollMode.
+func (m *Model) halfPageScrollAmount() int {
+	viewportSize := m.height - 4
+	if viewportSize < 1 {
+		viewportSize = 10
+	}
+	amount := viewportSize / 2
+	if amount < 1 {
+		amount = 1
+	}
+	return amount
+}
+
+// defaultByteRangeSize is the window size used when byte-range mode is
+// toggled on without an explicit length.
+const defaultByteRangeSize = 4096
+
+// SetByteRange enables a windowed hex dump showing only length bytes
+// starting at byte offset start, instead of formatting the entire value.
+// This matters for multi-megabyte values where formatting the whole thing
+// is wasteful when only a region is of interest. Pass length <= 0 to
+// disable the window and go back to formatting the whole value.
+func (m *Model) SetByteRange(start, length int) {
+	if length <= 0 {
+		m.byteRangeEnabled = false
+		m.byteRangeStart = 0
+		m.byteRangeLength = 0
+		m.formatContent()
+		return
+	}
+	if start < 0 {
+		start = 0
+	}
+	m.byteRangeEnabled = true
+	m.byteRangeStart = start
+	m.byteRangeLength = length
+	m.formatContent()
+}
+
+// ByteRange returns the current byte-range window and whether it's enabled.
+func (m *Model) ByteRange() (start, length int, enabled bool) {
+	return m.byteRangeStart, m.byteRangeLength, m.byteRangeEnabled
+}
+
+// NextByteRange pages the byte-range window forward by its length, clamped
+// to the end of the value. No-op when byte-range mode is disabled.
+func (m *Model) NextByteRange() {
+	if !m.byteRangeEnabled {
+		return
+	}
+	next := m.byteRangeStart + m.byteRangeLength
+	if next >= len(m.value) {
+		return
+	}
+	m.byteRangeStart = next
+	m.formatContent()
+}
+
+// PrevByteRange pages the byte-range window backward by its length, clamped
+// to the start of the value. No-op when byte-range mode is disabled.
+func (m *Model) PrevByteRange() {
+	if !m.byteRangeEnabled {
+		return
+	}
+	prev := m.byteRangeStart - m.byteRangeLength
+	if prev < 0 {
+		prev = 0
+	}
+	m.byteRangeStart = prev
+	m.formatContent()
+}
+
 // Content returns the formatted content
 func (m *Model) Content() string {
 	return m.content
@@ -128,26 +506,153 @@ func (m *Model) ScrollOffset() int {
 	return m.scrollOffset
 }
 
+// jsonStringTokenRe matches a JSON string literal, used to pull the string
+// value out of the line under the cursor in CurrentJSONStringToken.
+var jsonStringTokenRe = regexp.MustCompile(`"([^"\\]*(?:\\.[^"\\]*)*)"`)
+
+// CurrentJSONStringToken returns the string literal on the viewer's current
+// line when displaying JSON, e.g. the "abc" in `"session_id": "abc"`. When a
+// line has both a key and a value string, the value (the last match) is
+// returned. This is the token a "go to matching key" binding resolves
+// against a configured key-reference template (see config.ViewerConfig.
+// KeyRefTemplate). Returns false when not viewing JSON or the current line
+// has no string literal.
+func (m *Model) CurrentJSONStringToken() (string, bool) {
+	if m.viewMode != ViewModeJSON && m.detectedType != viewerPkg.DataTypeJSON {
+		return "", false
+	}
+
+	lines := strings.Split(m.content, "\n")
+	if m.scrollOffset < 0 || m.scrollOffset >= len(lines) {
+		return "", false
+	}
+
+	matches := jsonStringTokenRe.FindAllStringSubmatch(lines[m.scrollOffset], -1)
+	if len(matches) == 0 {
+		return "", false
+	}
+	return matches[len(matches)-1][1], true
+}
+
+// ResolveKeyRef substitutes token into template's "{}" placeholder to
+// derive a candidate Memcached key name, e.g. ResolveKeyRef("abc",
+// "session:{}") returns "session:abc". Returns "" when template is empty.
+func ResolveKeyRef(token, template string) string {
+	if template == "" {
+		return ""
+	}
+	return strings.ReplaceAll(template, "{}", token)
+}
+
+// maxCompressedPreviewBytes bounds the decompressed preview of a gzip/zlib
+// value when no explicit display cap (SetMaxDisplayBytes) is configured.
+// Decompression isn't given the "just format everything" treatment other
+// types get by default, since a small compressed value can still inflate to
+// something enormous.
+const maxCompressedPreviewBytes = 2 * 1024 * 1024
+
 // formatContent formats the value based on view mode
 func (m *Model) formatContent() {
 	if len(m.value) == 0 {
 		m.content = ""
+		m.displayTruncated = false
 		return
 	}
 
+	if m.viewMode == ViewModeAuto && !m.byteRangeEnabled &&
+		(m.detectedType == viewerPkg.DataTypeCompressedGzip || m.detectedType == viewerPkg.DataTypeCompressedZlib) {
+		m.formatCompressedContent()
+		return
+	}
+
+	// Cap how much of the value is formatted, unless a byte-range window
+	// (already a deliberately smaller slice) is active or the cap has been
+	// lifted for this value via LoadFullValue.
+	data := m.value
+	m.displayTruncated = false
+	if m.maxDisplayBytes > 0 && !m.showFullValue && !m.byteRangeEnabled && len(m.value) > m.maxDisplayBytes {
+		data = m.value[:m.maxDisplayBytes]
+		m.displayTruncated = true
+	}
+
 	var err error
 	switch m.viewMode {
 	case ViewModeJSON:
-		m.content, err = m.jsonFormatter.Format(m.value)
+		m.content, err = m.jsonFormatter.Format(data)
 		if err != nil {
-			m.content = string(m.value)
+			m.content = string(data)
 		}
 	case ViewModeHex:
-		m.content, _ = m.hexFormatter.Format(m.value)
+		if m.byteRangeEnabled {
+			m.content, _ = m.hexFormatter.FormatRange(m.value, m.byteRangeStart, m.byteRangeLength)
+		} else {
+			m.content, _ = m.hexFormatter.Format(data)
+		}
 	case ViewModeText:
-		m.content, _ = m.textFormatter.Format(m.value)
+		m.content, _ = m.textFormatter.Format(data)
+	case ViewModeJWT:
+		m.content, err = m.jwtFormatter.Format(data)
+		if err != nil {
+			m.content = string(data)
+		}
+	case ViewModeRaw:
+		m.content, _ = m.rawFormatter.Format(data)
+	case ViewModeHTML:
+		m.content, _ = m.htmlFormatter.Format(data)
 	case ViewModeAuto:
-		m.content, _ = m.autoFormatter.Format(m.value)
+		if m.typeOverridden {
+			m.content, _ = m.autoFormatter.FormatAs(data, m.detectedType)
+		} else {
+			m.content, _ = m.autoFormatter.Format(data)
+		}
+	}
+
+	if m.displayTruncated {
+		m.content += fmt.Sprintf(
+			"\n\n[truncated display (showing %s of %s) — press X to load all]",
+			models.FormatBytes(int64(len(data))), models.FormatBytes(int64(len(m.value))),
+		)
+	}
+}
+
+// formatCompressedContent decompresses a gzip/zlib value for display instead
+// of hex-dumping its compressed bytes, using DecompressPrefix to bound the
+// decompressed output rather than materializing the whole thing - truncating
+// the *compressed* bytes (as the generic maxDisplayBytes cap above does)
+// wouldn't bound the inflated size at all, and would just corrupt the
+// stream. LoadFullValue (showFullValue) decompresses in full instead, same
+// as the "load all" escape hatch other types get from the generic cap.
+func (m *Model) formatCompressedContent() {
+	m.displayTruncated = false
+
+	if m.showFullValue {
+		decompressed, err := viewerPkg.Decompress(m.value)
+		if err != nil {
+			m.content, _ = m.hexFormatter.Format(m.value)
+			return
+		}
+		m.content, _ = m.autoFormatter.Format(decompressed)
+		return
+	}
+
+	limit := m.maxDisplayBytes
+	if limit <= 0 {
+		limit = maxCompressedPreviewBytes
+	}
+
+	decompressed, err := viewerPkg.DecompressPrefix(m.value, limit)
+	if err != nil {
+		m.content, _ = m.hexFormatter.Format(m.value)
+		return
+	}
+
+	m.content, _ = m.autoFormatter.Format(decompressed)
+	if len(decompressed) >= limit {
+		m.displayTruncated = true
+		m.content += fmt.Sprintf(
+			"\n\n[decompressed preview capped at %s — press X to load all]",
+			models.FormatBytes(int64(limit)),
+		)
 	}
 }
 
@@ -163,20 +668,19 @@ func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
 		case tea.KeyDown:
 			m.scrollOffset++
 		case tea.KeyPgUp:
-			pageSize := m.height - 4
-			if pageSize < 1 {
-				pageSize = 10
-			}
-			m.scrollOffset -= pageSize
+			m.scrollOffset -= m.pageScrollAmount()
 			if m.scrollOffset < 0 {
 				m.scrollOffset = 0
 			}
 		case tea.KeyPgDown:
-			pageSize := m.height - 4
-			if pageSize < 1 {
-				pageSize = 10
+			m.scrollOffset += m.pageScrollAmount()
+		case tea.KeyCtrlU:
+			m.scrollOffset -= m.halfPageScrollAmount()
+			if m.scrollOffset < 0 {
+				m.scrollOffset = 0
 			}
-			m.scrollOffset += pageSize
+		case tea.KeyCtrlD:
+			m.scrollOffset += m.halfPageScrollAmount()
 		case tea.KeyRunes:
 			switch string(msg.Runes) {
 			case "j":
@@ -201,13 +705,106 @@ func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
 				m.SetViewMode(ViewModeText)
 			case "A":
 				m.SetViewMode(ViewModeAuto)
+			case "W":
+				m.SetViewMode(ViewModeJWT)
+			case "R":
+				m.SetViewMode(ViewModeRaw)
+			case "U":
+				m.SetViewMode(ViewModeHTML)
+			case "P":
+				if m.viewMode == ViewModeHTML {
+					m.SetHTMLStripTags(!m.htmlStripTags)
+				}
+			case "x":
+				if m.viewMode == ViewModeJSON {
+					m.SetJSONCompact(!m.jsonCompact)
+				}
+			case "w":
+				m.SetWrap(!m.wrap)
+				return m, m.prefsChangedCmd()
+			case "L":
+				m.SetLineNumbers(!m.lineNumbers)
+				return m, m.prefsChangedCmd()
+			case "[":
+				m.SetHexWidth(prevHexWidth(m.hexWidth))
+				return m, m.prefsChangedCmd()
+			case "]":
+				m.SetHexWidth(nextHexWidth(m.hexWidth))
+				return m, m.prefsChangedCmd()
+			case "#":
+				m.SetHexRuler(!m.hexRuler)
+				return m, m.prefsChangedCmd()
+			case "B":
+				if m.viewMode == ViewModeHex {
+					if m.byteRangeEnabled {
+						m.SetByteRange(0, 0)
+					} else {
+						m.SetByteRange(0, defaultByteRangeSize)
+					}
+				}
+			case "{":
+				m.PrevByteRange()
+			case "}":
+				m.NextByteRange()
+			case "X":
+				if m.displayTruncated {
+					m.LoadFullValue()
+				}
 			}
 		}
+
+	case tea.MouseMsg:
+		switch tea.MouseEvent(msg).Button {
+		case tea.MouseButtonWheelUp:
+			if m.scrollOffset > 0 {
+				m.scrollOffset--
+			}
+		case tea.MouseButtonWheelDown:
+			m.scrollOffset++
+		}
 	}
 
 	return m, nil
 }
 
+// prefsChangedCmd emits a PrefsChangedMsg carrying the current preferences
+func (m *Model) prefsChangedCmd() tea.Cmd {
+	return func() tea.Msg {
+		return PrefsChangedMsg{
+			Wrap:        m.wrap,
+			LineNumbers: m.lineNumbers,
+			HexWidth:    m.hexWidth,
+			HexRuler:    m.hexRuler,
+		}
+	}
+}
+
+// hexWidthSteps are the selectable hex dump widths, cycled through by the
+// "[" and "]" keys
+var hexWidthSteps = []int{8, 16, 32}
+
+// nextHexWidth returns the next larger step in hexWidthSteps, or the
+// largest step if current is already at or beyond it.
+func nextHexWidth(current int) int {
+	for _, step := range hexWidthSteps {
+		if step > current {
+			return step
+		}
+	}
+	return hexWidthSteps[len(hexWidthSteps)-1]
+}
+
+// prevHexWidth returns the next smaller step in hexWidthSteps, or the
+// smallest step if current is already at or below it.
+func prevHexWidth(current int) int {
+	for i := len(hexWidthSteps) - 1; i >= 0; i-- {
+		if hexWidthSteps[i] < current {
+			return hexWidthSteps[i]
+		}
+	}
+	return hexWidthSteps[0]
+}
+
 // View renders the viewer
 func (m *Model) View() string {
 	if m.width == 0 || m.height == 0 {
@@ -222,9 +819,27 @@ func (m *Model) View() string {
 		b.WriteString(header)
 		b.WriteString("\n")
 
-		// Metadata line
-		meta := fmt.Sprintf("Size: %d bytes | Type: %s | Mode: %s",
-			m.keyInfo.Size, m.detectedType.String(), m.viewMode.String())
+		// Metadata line. TTL is computed fresh from the key's absolute
+		// expiration timestamp each render, so it counts down live as long
+		// as something keeps redrawing the view (see app.TTLCountdownTickMsg).
+		meta := fmt.Sprintf("Size: %d bytes | Type: %s | Mode: %s | TTL: %s | Last access: %s",
+			m.keyInfo.Size, m.detectedType.String(), m.viewMode.String(), m.keyInfo.TTLDisplay(time.Now().Unix()), m.keyInfo.LastAccessDisplay())
+		if m.byteRangeEnabled {
+			end := m.byteRangeStart + m.byteRangeLength
+			if end > len(m.value) {
+				end = len(m.value)
+			}
+			meta += fmt.Sprintf(" | Range: [%d-%d)/%d", m.byteRangeStart, end, len(m.value))
+		}
+		if m.viewMode == ViewModeHTML && m.htmlStripTags {
+			meta += " | Preview (tags stripped)"
+		}
+		if m.viewMode == ViewModeJSON && m.jsonCompact {
+			meta += " | Compact"
+		}
+		if m.checksumAlgo != "" {
+			meta += fmt.Sprintf(" | %s: %s (%d bytes)", strings.ToUpper(m.checksumAlgo), m.checksum(), len(m.value))
+		}
 		b.WriteString(m.metaStyle.Render(meta))
 		b.WriteString("\n")
 		b.WriteString(strings.Repeat("─", min(m.width, 60)))
@@ -237,14 +852,23 @@ func (m *Model) View() string {
 		return b.String()
 	}
 
-	lines := strings.Split(m.content, "\n")
+	gutterWidth := 0
+	if m.lineNumbers {
+		gutterWidth = 6 // "%4d│ "
+	}
+	textWidth := m.width - gutterWidth
+	if textWidth < 1 {
+		textWidth = m.width
+	}
+
+	renderedLines := m.renderedLinesFor(m.content, textWidth, m.wrap)
 	contentHeight := m.height - 4 // Reserve space for header/footer
 	if contentHeight < 1 {
 		contentHeight = 10
 	}
 
 	// Clamp scroll offset
-	maxOffset := len(lines) - contentHeight
+	maxOffset := len(renderedLines) - contentHeight
 	if maxOffset < 0 {
 		maxOffset = 0
 	}
@@ -254,19 +878,75 @@ func (m *Model) View() string {
 
 	// Render visible lines
 	endLine := m.scrollOffset + contentHeight
-	if endLine > len(lines) {
-		endLine = len(lines)
+	if endLine > len(renderedLines) {
+		endLine = len(renderedLines)
 	}
 
 	for i := m.scrollOffset; i < endLine; i++ {
-		line := lines[i]
-		// Truncate long lines
-		if m.width > 0 && len(line) > m.width {
-			line = line[:m.width-3] + "..."
+		rl := renderedLines[i]
+		text := rl.text
+		// Truncate long lines when not wrapping. Uses display width rather
+		// than byte length so multibyte runes (and wide CJK characters)
+		// aren't split into mojibake.
+		if !m.wrap && textWidth > 0 {
+			text = models.TruncateWidth(text, textWidth)
+		}
+		if m.lineNumbers {
+			if rl.number > 0 {
+				text = fmt.Sprintf("%4d│ %s", rl.number, text)
+			} else {
+				text = fmt.Sprintf("%4s│ %s", "", text)
+			}
 		}
-		b.WriteString(m.contentStyle.Render(line))
+		b.WriteString(m.contentStyle.Render(text))
 		b.WriteString("\n")
 	}
 
 	return b.String()
 }
+
+// renderedLine is one display line produced by buildRenderedLines. number is
+// the 1-based source line it came from, or 0 if it's a wrap continuation of
+// the previous display line (so the number isn't repeated).
+type renderedLine struct {
+	number int
+	text   string
+}
+
+// buildRenderedLines splits content into display lines, optionally
+// soft-wrapping each source line to fit width.
+// renderedLinesFor returns the wrapped/split lines for content at width,
+// reusing the cached result from the previous call when content, width, and
+// wrap are unchanged (e.g. a pure scroll keystroke) instead of re-splitting
+// the whole value again.
+func (m *Model) renderedLinesFor(content string, width int, wrap bool) []renderedLine {
+	if m.renderedLinesCacheValid && m.renderedLinesContent == content && m.renderedLinesWidth == width && m.renderedLinesWrap == wrap {
+		return m.renderedLinesCache
+	}
+
+	m.renderedLinesCache = buildRenderedLines(content, width, wrap)
+	m.renderedLinesContent = content
+	m.renderedLinesWidth = width
+	m.renderedLinesWrap = wrap
+	m.renderedLinesCacheValid = true
+	return m.renderedLinesCache
+}
+
+func buildRenderedLines(content string, width int, wrap bool) []renderedLine {
+	raw := strings.Split(content, "\n")
+	out := make([]renderedLine, 0, len(raw))
+	for idx, line := range raw {
+		num := idx + 1
+		if !wrap || width < 1 || len(line) <= width {
+			out = append(out, renderedLine{number: num, text: line})
+			continue
+		}
+		for len(line) > width {
+			out = append(out, renderedLine{number: num, text: line[:width]})
+			line = line[width:]
+			num = 0
+		}
+		out = append(out, renderedLine{number: num, text: line})
+	}
+	return out
+}
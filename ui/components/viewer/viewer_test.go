@@ -1,6 +1,8 @@
 package viewer_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"strings"
 	"testing"
 
@@ -9,6 +11,21 @@ import (
 	"github.com/nnnkkk7/memtui/ui/components/viewer"
 )
 
+// gzipBytes compresses s for use as test fixture data detected as
+// DataTypeCompressedGzip.
+func gzipBytes(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(s)); err != nil {
+		t.Fatalf("failed to gzip fixture data: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close gzip writer: %v", err)
+	}
+	return buf.Bytes()
+}
+
 func TestNewModel(t *testing.T) {
 	m := viewer.NewModel()
 	if m == nil {
@@ -52,6 +69,7 @@ func TestModel_ViewModes(t *testing.T) {
 		{viewer.ViewModeJSON, "JSON"},
 		{viewer.ViewModeHex, "Hex"},
 		{viewer.ViewModeText, "Text"},
+		{viewer.ViewModeRaw, "Raw"},
 	}
 
 	for _, tt := range tests {
@@ -154,6 +172,24 @@ func TestModel_Scrolling(t *testing.T) {
 	}
 }
 
+func TestModel_MouseWheelScroll(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(40, 10)
+
+	longContent := strings.Repeat("line\n", 100)
+	m.SetValue([]byte(longContent))
+
+	m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	if m.ScrollOffset() != 1 {
+		t.Errorf("expected scroll offset 1 after wheel down, got %d", m.ScrollOffset())
+	}
+
+	m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelUp})
+	if m.ScrollOffset() != 0 {
+		t.Errorf("expected scroll offset 0 after wheel up, got %d", m.ScrollOffset())
+	}
+}
+
 func TestModel_PageNavigation(t *testing.T) {
 	m := viewer.NewModel()
 	m.SetSize(40, 10)
@@ -168,6 +204,98 @@ func TestModel_PageNavigation(t *testing.T) {
 	}
 }
 
+func TestModel_PageScrollMode_Half(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(40, 20)
+	m.SetPageScrollMode("half")
+
+	longContent := strings.Repeat("line\n", 100)
+	m.SetValue([]byte(longContent))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	if got, want := m.ScrollOffset(), 8; got != want {
+		t.Errorf("expected scroll offset %d after half-page down, got %d", want, got)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+	if got, want := m.ScrollOffset(), 0; got != want {
+		t.Errorf("expected scroll offset %d after half-page up, got %d", want, got)
+	}
+}
+
+func TestModel_PageScrollMode_Lines(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(40, 20)
+	m.SetPageScrollMode("lines")
+	m.SetPageScrollLines(3)
+
+	longContent := strings.Repeat("line\n", 100)
+	m.SetValue([]byte(longContent))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	if got, want := m.ScrollOffset(), 3; got != want {
+		t.Errorf("expected scroll offset %d after fixed-line page down, got %d", want, got)
+	}
+}
+
+func TestModel_HalfPageScroll_CtrlDCtrlU(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(40, 20)
+
+	longContent := strings.Repeat("line\n", 100)
+	m.SetValue([]byte(longContent))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if got, want := m.ScrollOffset(), 8; got != want {
+		t.Errorf("expected scroll offset %d after ctrl+d, got %d", want, got)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	if got, want := m.ScrollOffset(), 0; got != want {
+		t.Errorf("expected scroll offset %d after ctrl+u, got %d", want, got)
+	}
+}
+
+func TestModel_HalfPageScroll_ClampsAtBothEnds(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(40, 20)
+
+	longContent := strings.Repeat("line\n", 10)
+	m.SetValue([]byte(longContent))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	if got, want := m.ScrollOffset(), 0; got != want {
+		t.Errorf("expected scroll offset clamped to %d at top, got %d", want, got)
+	}
+
+	for i := 0; i < 10; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	}
+	m.View()
+	maxOffset := m.ScrollOffset()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	m.View()
+	if got := m.ScrollOffset(); got != maxOffset {
+		t.Errorf("expected scroll offset to stay clamped at %d, got %d", maxOffset, got)
+	}
+}
+
+func TestModel_HalfPageScroll_IndependentOfPageScrollMode(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(40, 20)
+	m.SetPageScrollMode("lines")
+	m.SetPageScrollLines(2)
+
+	longContent := strings.Repeat("line\n", 100)
+	m.SetValue([]byte(longContent))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if got, want := m.ScrollOffset(), 8; got != want {
+		t.Errorf("expected ctrl+d to scroll by half the viewport regardless of page scroll mode, got %d want %d", got, want)
+	}
+}
+
 func TestModel_SetSize(t *testing.T) {
 	m := viewer.NewModel()
 	m.SetSize(80, 24)
@@ -217,4 +345,718 @@ func TestModel_KeyboardShortcuts(t *testing.T) {
 	if m.ViewMode() != viewer.ViewModeAuto {
 		t.Errorf("expected ViewModeAuto after 'A', got %v", m.ViewMode())
 	}
+
+	// 'R' for Raw mode
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	if m.ViewMode() != viewer.ViewModeRaw {
+		t.Errorf("expected ViewModeRaw after 'R', got %v", m.ViewMode())
+	}
+}
+
+func TestModel_RawFormatting(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetValue([]byte("line one\tend\nline two\x00done"))
+	m.SetViewMode(viewer.ViewModeRaw)
+
+	content := m.Content()
+	expected := `line one\tend\nline two\x00done`
+	if content != expected {
+		t.Errorf("expected %q, got %q", expected, content)
+	}
+}
+
+func TestModel_SetWrap(t *testing.T) {
+	m := viewer.NewModel()
+	if m.Wrap() {
+		t.Error("expected wrap to default to false")
+	}
+
+	m.SetWrap(true)
+	if !m.Wrap() {
+		t.Error("expected wrap to be true after SetWrap(true)")
+	}
+}
+
+func TestModel_SetLineNumbers(t *testing.T) {
+	m := viewer.NewModel()
+	if m.LineNumbers() {
+		t.Error("expected line numbers to default to false")
+	}
+
+	m.SetLineNumbers(true)
+	if !m.LineNumbers() {
+		t.Error("expected line numbers to be true after SetLineNumbers(true)")
+	}
+
+	m.SetSize(40, 20)
+	m.SetKeyInfo(models.KeyInfo{Key: "k"})
+	m.SetValue([]byte("line one\nline two"))
+	view := m.View()
+	if !strings.Contains(view, "1") || !strings.Contains(view, "2") {
+		t.Errorf("expected line numbers in view, got: %s", view)
+	}
+}
+
+func TestModel_SetHexWidth(t *testing.T) {
+	m := viewer.NewModel()
+	if m.HexWidth() != 16 {
+		t.Errorf("expected default hex width 16, got %d", m.HexWidth())
+	}
+
+	m.SetHexWidth(8)
+	if m.HexWidth() != 8 {
+		t.Errorf("expected hex width 8, got %d", m.HexWidth())
+	}
+
+	// Non-positive widths are ignored
+	m.SetHexWidth(0)
+	if m.HexWidth() != 8 {
+		t.Errorf("expected hex width to stay 8, got %d", m.HexWidth())
+	}
+}
+
+func TestModel_SetHexRuler(t *testing.T) {
+	m := viewer.NewModel()
+	if m.HexRuler() {
+		t.Error("expected hex ruler to be disabled by default")
+	}
+
+	m.SetHexRuler(true)
+	if !m.HexRuler() {
+		t.Error("expected hex ruler to be enabled")
+	}
+}
+
+func TestModel_SetJSONCompact(t *testing.T) {
+	m := viewer.NewModel()
+	if m.JSONCompact() {
+		t.Error("expected JSON compact to default to false")
+	}
+
+	m.SetViewMode(viewer.ViewModeJSON)
+	m.SetValue([]byte(`{"key":"value","list":[1,2,3]}`))
+
+	pretty := m.Content()
+	if !strings.Contains(pretty, "\n") {
+		t.Errorf("expected pretty JSON to span multiple lines, got: %q", pretty)
+	}
+
+	m.SetJSONCompact(true)
+	if !m.JSONCompact() {
+		t.Error("expected JSON compact to be true after SetJSONCompact(true)")
+	}
+	compact := m.Content()
+	if strings.Contains(compact, "\n") {
+		t.Errorf("expected compact JSON to be single-line, got: %q", compact)
+	}
+
+	m.SetJSONCompact(false)
+	if strings.Join(strings.Fields(m.Content()), "") != strings.Join(strings.Fields(pretty), "") {
+		t.Error("expected toggling compact back off to restore the pretty-printed form")
+	}
+}
+
+func TestModel_SetChecksumAlgorithm(t *testing.T) {
+	m := viewer.NewModel()
+	if m.ChecksumAlgorithm() != "" {
+		t.Error("expected checksum display to default to disabled")
+	}
+
+	m.SetChecksumAlgorithm("crc32")
+	if m.ChecksumAlgorithm() != "crc32" {
+		t.Error("expected checksum algorithm to be crc32")
+	}
+
+	m.SetChecksumAlgorithm("bogus")
+	if m.ChecksumAlgorithm() != "" {
+		t.Error("expected an invalid checksum algorithm to disable the checksum display")
+	}
+}
+
+func TestModel_ChecksumDisplay_MatchesKnownValueAndUpdatesOnChange(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(80, 24)
+	m.SetKeyInfo(models.KeyInfo{Key: "user:1", Size: 5})
+	m.SetChecksumAlgorithm("crc32")
+	m.SetValue([]byte("hello"))
+
+	view := m.View()
+	// crc32.ChecksumIEEE("hello") = 0x3610a686
+	if !strings.Contains(view, "CRC32: 3610a686 (5 bytes)") {
+		t.Errorf("expected view to show the known CRC32 checksum and byte length, got: %s", view)
+	}
+
+	m.SetValue([]byte("hello!"))
+	updated := m.View()
+	if strings.Contains(updated, "3610a686") {
+		t.Error("expected the checksum to change when the value changes")
+	}
+	if !strings.Contains(updated, "(6 bytes)") {
+		t.Errorf("expected the byte length to update with the new value, got: %s", updated)
+	}
+}
+
+func TestModel_ChecksumDisplay_MD5(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(80, 24)
+	m.SetKeyInfo(models.KeyInfo{Key: "user:1", Size: 5})
+	m.SetChecksumAlgorithm("md5")
+	m.SetValue([]byte("hello"))
+
+	view := m.View()
+	// md5("hello") = 5d41402abc4b2a76b9719d911017c592
+	if !strings.Contains(view, "MD5: 5d41402abc4b2a76b9719d911017c592 (5 bytes)") {
+		t.Errorf("expected view to show the known MD5 checksum, got: %s", view)
+	}
+}
+
+func TestModel_ChecksumDisplay_DisabledByDefault(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(80, 24)
+	m.SetKeyInfo(models.KeyInfo{Key: "user:1", Size: 5})
+	m.SetValue([]byte("hello"))
+
+	view := m.View()
+	if strings.Contains(view, "CRC32") || strings.Contains(view, "MD5") {
+		t.Errorf("expected no checksum in the header when disabled, got: %s", view)
+	}
+}
+
+func TestModel_SetJSONIndent(t *testing.T) {
+	tests := []struct {
+		name   string
+		indent string
+	}{
+		{name: "four spaces", indent: "    "},
+		{name: "tab", indent: "\t"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := viewer.NewModel()
+			m.SetViewMode(viewer.ViewModeJSON)
+			m.SetValue([]byte(`{"key":"value"}`))
+
+			m.SetJSONIndent(tt.indent)
+
+			if !strings.Contains(m.Content(), tt.indent+`"key"`) {
+				t.Errorf("expected content indented with %q, got: %q", tt.indent, m.Content())
+			}
+		})
+	}
+}
+
+func TestModel_SetSortKeys(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeJSON)
+	m.SetValue([]byte(`{"zebra":1,"apple":2}`))
+
+	m.SetSortKeys(true)
+
+	content := m.Content()
+	if strings.Index(content, "apple") > strings.Index(content, "zebra") {
+		t.Errorf("expected sorted key order apple before zebra, got: %q", content)
+	}
+}
+
+func TestModel_JSONCompactKeybinding(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeJSON)
+	m.SetValue([]byte(`{"key":"value"}`))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if !m.JSONCompact() {
+		t.Fatal("expected 'x' to toggle JSON compact mode on")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if m.JSONCompact() {
+		t.Error("expected a second 'x' to toggle JSON compact mode back off")
+	}
+}
+
+func TestModel_JSONCompactKeybinding_IgnoredOutsideJSONMode(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeText)
+	m.SetValue([]byte("plain text"))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if m.JSONCompact() {
+		t.Error("expected 'x' to have no effect outside JSON view mode")
+	}
+}
+
+func TestModel_Wrap_NoTruncation(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetSize(10, 20)
+	m.SetKeyInfo(models.KeyInfo{Key: "k"})
+	m.SetValue([]byte("this is a long line that exceeds the width"))
+
+	m.SetWrap(false)
+	if !strings.Contains(m.View(), "...") {
+		t.Error("expected truncation with '...' when wrap is disabled")
+	}
+
+	m.SetWrap(true)
+	view := m.View()
+	if strings.Contains(view, "...") {
+		t.Error("expected no truncation when wrap is enabled")
+	}
+	if joined := strings.ReplaceAll(view, "\n", ""); !strings.Contains(joined, "exceeds the width") {
+		t.Errorf("expected wrapped content to preserve the full text across lines, got: %s", view)
+	}
+}
+
+func TestModel_TogglePrefs_EmitsPrefsChangedMsg(t *testing.T) {
+	m := viewer.NewModel()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	if cmd == nil {
+		t.Fatal("expected a command to be returned after toggling wrap")
+	}
+	msg, ok := cmd().(viewer.PrefsChangedMsg)
+	if !ok {
+		t.Fatalf("expected PrefsChangedMsg, got %T", msg)
+	}
+	if !msg.Wrap {
+		t.Error("expected PrefsChangedMsg.Wrap to be true after toggling")
+	}
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	msg = cmd().(viewer.PrefsChangedMsg)
+	if !msg.LineNumbers {
+		t.Error("expected PrefsChangedMsg.LineNumbers to be true after toggling")
+	}
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	msg = cmd().(viewer.PrefsChangedMsg)
+	if msg.HexWidth != 32 {
+		t.Errorf("expected hex width to step up to 32, got %d", msg.HexWidth)
+	}
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'['}})
+	msg = cmd().(viewer.PrefsChangedMsg)
+	if msg.HexWidth != 16 {
+		t.Errorf("expected hex width to step down to 16, got %d", msg.HexWidth)
+	}
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'#'}})
+	msg = cmd().(viewer.PrefsChangedMsg)
+	if !msg.HexRuler {
+		t.Error("expected PrefsChangedMsg.HexRuler to be true after toggling")
+	}
+}
+
+func TestModel_SetByteRange_ShowsOnlyRequestedSliceWithCorrectOffsets(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeHex)
+
+	data := make([]byte, 64)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	m.SetValue(data)
+
+	m.SetByteRange(32, 16)
+
+	start, length, enabled := m.ByteRange()
+	if !enabled || start != 32 || length != 16 {
+		t.Fatalf("expected byte range (32, 16, enabled), got (%d, %d, %v)", start, length, enabled)
+	}
+
+	content := m.Content()
+	if !strings.Contains(content, "00000020") {
+		t.Errorf("expected content to show absolute offset 00000020, got: %s", content)
+	}
+	if strings.Contains(content, "00000000") {
+		t.Errorf("expected content to omit the start of the value, got: %s", content)
+	}
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+	if len(lines) != 1 {
+		t.Errorf("expected exactly 1 line for a 16-byte window, got %d: %v", len(lines), lines)
+	}
+}
+
+func TestModel_ByteRange_NextPrevPageThroughWindows(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeHex)
+	m.SetValue(make([]byte, 100))
+	m.SetByteRange(0, 16)
+
+	m.NextByteRange()
+	start, _, _ := m.ByteRange()
+	if start != 16 {
+		t.Errorf("expected start 16 after NextByteRange, got %d", start)
+	}
+
+	m.PrevByteRange()
+	start, _, _ = m.ByteRange()
+	if start != 0 {
+		t.Errorf("expected start 0 after PrevByteRange, got %d", start)
+	}
+
+	// Can't go before the start
+	m.PrevByteRange()
+	start, _, _ = m.ByteRange()
+	if start != 0 {
+		t.Errorf("expected start to clamp at 0, got %d", start)
+	}
+}
+
+func TestModel_SetByteRange_DisableReturnsFullContent(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeHex)
+	m.SetValue([]byte("0123456789abcdef0123"))
+
+	full := m.Content()
+
+	m.SetByteRange(0, 8)
+	if m.Content() == full {
+		t.Fatal("expected windowed content to differ from full content")
+	}
+
+	m.SetByteRange(0, 0)
+	_, _, enabled := m.ByteRange()
+	if enabled {
+		t.Error("expected byte range to be disabled")
+	}
+	if m.Content() != full {
+		t.Errorf("expected disabling the range to restore full content, got: %s", m.Content())
+	}
+}
+
+func TestModel_SetMaxDisplayBytes_TruncatesOversizedValue(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeText)
+	m.SetMaxDisplayBytes(10)
+	m.SetValue([]byte("0123456789abcdefghij"))
+
+	if !m.IsTruncatedDisplay() {
+		t.Fatal("expected the display to be marked truncated")
+	}
+	if !strings.Contains(m.Content(), "0123456789") {
+		t.Errorf("expected the first 10 bytes in content, got: %s", m.Content())
+	}
+	if strings.Contains(m.Content(), "abcdefghij") {
+		t.Errorf("expected bytes beyond the cap to be omitted, got: %s", m.Content())
+	}
+	if !strings.Contains(m.Content(), "truncated display") {
+		t.Errorf("expected a truncation notice, got: %s", m.Content())
+	}
+	if !strings.Contains(m.Content(), "press X to load all") {
+		t.Errorf("expected the notice to mention the 'X' keybinding, got: %s", m.Content())
+	}
+}
+
+func TestModel_SetMaxDisplayBytes_UnderLimitNotTruncated(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeText)
+	m.SetMaxDisplayBytes(1024)
+	m.SetValue([]byte("short value"))
+
+	if m.IsTruncatedDisplay() {
+		t.Error("expected a value under the cap not to be truncated")
+	}
+	if m.Content() != "short value" {
+		t.Errorf("expected untruncated content, got: %s", m.Content())
+	}
+}
+
+func TestModel_LoadFullValue_ShowsEntireValue(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeText)
+	m.SetMaxDisplayBytes(10)
+	m.SetValue([]byte("0123456789abcdefghij"))
+
+	m.LoadFullValue()
+
+	if m.IsTruncatedDisplay() {
+		t.Error("expected IsTruncatedDisplay to clear after LoadFullValue")
+	}
+	if m.Content() != "0123456789abcdefghij" {
+		t.Errorf("expected the full value to be rendered, got: %s", m.Content())
+	}
+}
+
+func TestModel_LoadFullValue_ResetsOnNextSetValue(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeText)
+	m.SetMaxDisplayBytes(10)
+	m.SetValue([]byte("0123456789abcdefghij"))
+	m.LoadFullValue()
+
+	m.SetValue([]byte("0123456789abcdefghij"))
+
+	if !m.IsTruncatedDisplay() {
+		t.Error("expected the override to be a one-shot, reset by the next SetValue")
+	}
+}
+
+func TestModel_LoadFullValueKeybinding(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeText)
+	m.SetMaxDisplayBytes(10)
+	m.SetValue([]byte("0123456789abcdefghij"))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")})
+
+	if m.IsTruncatedDisplay() {
+		t.Error("expected 'X' to load the full value")
+	}
+}
+
+func TestModel_AutoDetect_DecompressesGzipContent(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetValue(gzipBytes(t, `{"hello":"world"}`))
+
+	if m.DetectedType() != "Gzip" {
+		t.Fatalf("expected detected type 'Gzip', got '%s'", m.DetectedType())
+	}
+	if !strings.Contains(m.Content(), "hello") || !strings.Contains(m.Content(), "world") {
+		t.Errorf("expected decompressed content to be rendered, got: %s", m.Content())
+	}
+	if strings.Contains(m.Content(), "00000000") {
+		t.Errorf("expected a decompressed preview, not a hex dump of the compressed bytes, got: %s", m.Content())
+	}
+}
+
+func TestModel_AutoDetect_BoundsDecompressedGzipPreview(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetMaxDisplayBytes(5)
+	m.SetValue(gzipBytes(t, "0123456789abcdefghij"))
+
+	if !m.IsTruncatedDisplay() {
+		t.Fatal("expected the decompressed preview to be marked truncated")
+	}
+	if !strings.Contains(m.Content(), "01234") {
+		t.Errorf("expected the first 5 decompressed bytes in content, got: %s", m.Content())
+	}
+	if strings.Contains(m.Content(), "56789") {
+		t.Errorf("expected decompressed bytes beyond the cap to be omitted, got: %s", m.Content())
+	}
+	if !strings.Contains(m.Content(), "decompressed preview capped") {
+		t.Errorf("expected a decompressed-preview truncation notice, got: %s", m.Content())
+	}
+}
+
+func TestModel_AutoDetect_GzipLoadFullValueDecompressesEverything(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetMaxDisplayBytes(5)
+	m.SetValue(gzipBytes(t, "0123456789abcdefghij"))
+
+	m.LoadFullValue()
+
+	if m.IsTruncatedDisplay() {
+		t.Error("expected IsTruncatedDisplay to clear after LoadFullValue")
+	}
+	if m.Content() != "0123456789abcdefghij" {
+		t.Errorf("expected the full decompressed value, got: %s", m.Content())
+	}
+}
+
+func TestModel_SetValue_ResetsByteRange(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeHex)
+	m.SetValue(make([]byte, 64))
+	m.SetByteRange(32, 16)
+
+	m.SetValue(make([]byte, 64))
+
+	_, _, enabled := m.ByteRange()
+	if enabled {
+		t.Error("expected byte range to reset when a new value is loaded")
+	}
+}
+
+func TestModel_ByteRangeKeybindings(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeHex)
+	m.SetValue(make([]byte, 100))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'B'}})
+	_, length, enabled := m.ByteRange()
+	if !enabled || length != 4096 {
+		t.Fatalf("expected byte range enabled with default length 4096, got enabled=%v length=%d", enabled, length)
+	}
+
+	// The default window (4096 bytes) already covers the whole 100-byte
+	// value, so paging forward is a no-op.
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'}'}})
+	start, _, _ := m.ByteRange()
+	if start != 0 {
+		t.Errorf("expected start to stay at 0 when the window covers the whole value, got %d", start)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'B'}})
+	_, _, enabled = m.ByteRange()
+	if enabled {
+		t.Error("expected 'B' to toggle the byte range back off")
+	}
+}
+
+func TestModel_CurrentJSONStringToken_ExtractsValueOnCursorLine(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeJSON)
+	m.SetValue([]byte(`{"session_id": "abc123", "count": 2}`))
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+
+	token, ok := m.CurrentJSONStringToken()
+	if !ok {
+		t.Fatal("expected a token on the session_id line")
+	}
+	if token != "abc123" {
+		t.Errorf("expected token 'abc123', got %q", token)
+	}
+}
+
+func TestModel_CurrentJSONStringToken_FalseWhenNotJSON(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetViewMode(viewer.ViewModeText)
+	m.SetValue([]byte(`"session_id": "abc123"`))
+
+	if _, ok := m.CurrentJSONStringToken(); ok {
+		t.Error("expected no token outside JSON view")
+	}
+}
+
+func TestResolveKeyRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		template string
+		expected string
+	}{
+		{"simple template", "abc", "session:{}", "session:abc"},
+		{"no placeholder", "abc", "static", "static"},
+		{"empty template disables", "abc", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := viewer.ResolveKeyRef(tt.token, tt.template); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNew_OptionOrderIndependent(t *testing.T) {
+	ki := models.KeyInfo{Key: "user:1", Size: 42}
+
+	a := viewer.New(
+		viewer.WithValue([]byte(`{"a":1}`)),
+		viewer.WithViewMode(viewer.ViewModeJSON),
+		viewer.WithKeyInfo(ki),
+		viewer.WithSize(80, 24),
+		viewer.WithWrap(true),
+		viewer.WithLineNumbers(true),
+		viewer.WithHexWidth(8),
+		viewer.WithHexRuler(true),
+	)
+
+	b := viewer.New(
+		viewer.WithHexRuler(true),
+		viewer.WithHexWidth(8),
+		viewer.WithLineNumbers(true),
+		viewer.WithWrap(true),
+		viewer.WithSize(80, 24),
+		viewer.WithKeyInfo(ki),
+		viewer.WithViewMode(viewer.ViewModeJSON),
+		viewer.WithValue([]byte(`{"a":1}`)),
+	)
+
+	if a.Content() != b.Content() {
+		t.Errorf("expected equivalent content regardless of option order, got %q vs %q", a.Content(), b.Content())
+	}
+	if a.ViewMode() != b.ViewMode() || a.Wrap() != b.Wrap() || a.LineNumbers() != b.LineNumbers() ||
+		a.HexWidth() != b.HexWidth() || a.HexRuler() != b.HexRuler() {
+		t.Error("expected equivalent settings regardless of option order")
+	}
+}
+
+func TestNew_EquivalentToNewModelPlusSetters(t *testing.T) {
+	a := viewer.New(viewer.WithValue([]byte("hello world")))
+
+	b := viewer.NewModel()
+	b.SetValue([]byte("hello world"))
+
+	if a.Content() != b.Content() {
+		t.Errorf("expected New to match NewModel+SetValue, got %q vs %q", a.Content(), b.Content())
+	}
+}
+
+func TestModel_CycleDetectedType_RotatesThroughCandidates(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetValue([]byte("1234"))
+
+	candidates := m.DetectedTypeCandidates()
+	if len(candidates) != 2 {
+		t.Fatalf("expected 2 candidates for an ambiguous short numeric value, got %v", candidates)
+	}
+
+	// DetectType picks DataTypeJSONScalar first, so the initial render is
+	// already "number: 1234" - cycling should move to the next candidate.
+	if !strings.Contains(m.Content(), "number: 1234") {
+		t.Fatalf("expected initial auto-detection to render as a scalar, got content %q", m.Content())
+	}
+
+	m.CycleDetectedType()
+	if m.Content() != "1234" {
+		t.Errorf("expected cycling to the plain text interpretation, got content %q", m.Content())
+	}
+
+	m.CycleDetectedType()
+	if !strings.Contains(m.Content(), "number: 1234") {
+		t.Errorf("expected cycling to wrap back to the scalar interpretation, got content %q", m.Content())
+	}
+}
+
+func TestModel_CycleDetectedType_NoOpForUnambiguousValue(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetValue([]byte("just some ordinary sentence with no other interpretation"))
+
+	candidates := m.DetectedTypeCandidates()
+	if len(candidates) != 1 {
+		t.Fatalf("expected a single candidate for plain text, got %v", candidates)
+	}
+
+	before := m.Content()
+	m.CycleDetectedType()
+	after := m.Content()
+
+	if before != after {
+		t.Errorf("expected no-op for an unambiguous value, content changed from %q to %q", before, after)
+	}
+}
+
+func TestModel_CycleDetectedType_ResetsOnSetValue(t *testing.T) {
+	m := viewer.NewModel()
+	m.SetValue([]byte("1234"))
+	m.CycleDetectedType()
+	if m.Content() != "1234" {
+		t.Fatalf("expected cycled content %q", m.Content())
+	}
+
+	// A fresh SetValue should drop the override and re-run auto-detection
+	// from scratch, even for a value with the same candidate types.
+	m.SetValue([]byte("5678"))
+	if !strings.Contains(m.Content(), "number: 5678") {
+		t.Errorf("expected SetValue to reset the manual type override, got content %q", m.Content())
+	}
+}
+
+func BenchmarkView_RepeatedScroll(b *testing.B) {
+	m := viewer.NewModel()
+	m.SetSize(100, 40)
+	m.SetWrap(true)
+	m.SetKeyInfo(models.KeyInfo{Key: "bench-key"})
+	m.SetValue([]byte(strings.Repeat("the quick brown fox jumps over the lazy dog\n", 20000)))
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyDown})
+		_ = m.View()
+	}
 }
@@ -0,0 +1,66 @@
+package viewer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nnnkkk7/memtui/models"
+)
+
+// sliceHeader compares by pointer to the first element, which is enough to
+// tell whether renderedLinesFor returned the cached slice or rebuilt one.
+func sameBackingArray(a, b []renderedLine) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	return &a[0] == &b[0]
+}
+
+func TestRenderedLinesFor_ReusesCacheWhenInputsUnchanged(t *testing.T) {
+	m := NewModel()
+	m.SetSize(40, 20)
+	m.SetKeyInfo(models.KeyInfo{Key: "k"})
+	m.SetWrap(true)
+	m.SetValue([]byte(strings.Repeat("line\n", 500)))
+
+	first := m.renderedLinesFor(m.content, 40, m.wrap)
+	second := m.renderedLinesFor(m.content, 40, m.wrap)
+
+	if !sameBackingArray(first, second) {
+		t.Fatal("expected renderedLinesFor to reuse the cached slice when content/width/wrap are unchanged")
+	}
+}
+
+func TestRenderedLinesFor_InvalidatesOnWidthChange(t *testing.T) {
+	m := NewModel()
+	m.SetSize(40, 20)
+	m.SetKeyInfo(models.KeyInfo{Key: "k"})
+	m.SetWrap(true)
+	m.SetValue([]byte(strings.Repeat("a", 200)))
+
+	first := m.renderedLinesFor(m.content, 40, m.wrap)
+	second := m.renderedLinesFor(m.content, 80, m.wrap)
+
+	if sameBackingArray(first, second) {
+		t.Fatal("expected renderedLinesFor to rebuild when width changes")
+	}
+	if len(first) == len(second) {
+		t.Fatalf("expected different wrapped line counts for different widths, got %d for both", len(first))
+	}
+}
+
+func TestRenderedLinesFor_InvalidatesOnModeChange(t *testing.T) {
+	m := NewModel()
+	m.SetSize(40, 20)
+	m.SetKeyInfo(models.KeyInfo{Key: "k"})
+	m.SetValue([]byte(`{"a":1}`))
+
+	first := m.renderedLinesFor(m.content, 40, m.wrap)
+
+	m.SetViewMode(ViewModeHex)
+	second := m.renderedLinesFor(m.content, 40, m.wrap)
+
+	if sameBackingArray(first, second) {
+		t.Fatal("expected renderedLinesFor to rebuild after the view mode (and so content) changes")
+	}
+}
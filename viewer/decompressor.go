@@ -60,6 +60,68 @@ func Decompress(data []byte) ([]byte, error) {
 	return data, nil
 }
 
+// DecompressPrefix automatically detects compression format and decompresses
+// only the first maxBytes of the decoded output, without materializing the
+// full decompressed payload in memory. This matters when only a prefix of a
+// large compressed value needs to be shown, e.g. the portion currently
+// scrolled into view. A maxBytes of 0 or less decompresses nothing, and
+// uncompressed data is simply truncated to maxBytes.
+func DecompressPrefix(data []byte, maxBytes int) ([]byte, error) {
+	if len(data) == 0 || maxBytes <= 0 {
+		return nil, nil
+	}
+
+	reader, err := decompressReader(data)
+	if err != nil {
+		return nil, err
+	}
+	if reader == nil {
+		// Not compressed; no decoding work to bound, just truncate.
+		if maxBytes < len(data) {
+			return data[:maxBytes], nil
+		}
+		return data, nil
+	}
+	defer func() { _ = reader.Close() }()
+
+	prefix, err := io.ReadAll(io.LimitReader(reader, int64(maxBytes)))
+	if err != nil {
+		return nil, err
+	}
+	return prefix, nil
+}
+
+// decompressReader returns a streaming reader for data's detected
+// compression format, decoding lazily as the caller reads from it rather
+// than all at once. Returns a nil reader (and nil error) when data is not
+// compressed.
+func decompressReader(data []byte) (io.ReadCloser, error) {
+	switch {
+	case IsGzipCompressed(data):
+		return gzip.NewReader(bytes.NewReader(data))
+	case IsZlibCompressed(data):
+		return zlib.NewReader(bytes.NewReader(data))
+	case IsZstdCompressed(data):
+		dec, err := zstd.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return zstdReadCloser{dec}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// zstdReadCloser adapts zstd.Decoder's error-less Close to io.ReadCloser.
+type zstdReadCloser struct {
+	*zstd.Decoder
+}
+
+func (z zstdReadCloser) Close() error {
+	z.Decoder.Close()
+	return nil
+}
+
 // decompressGzip decompresses gzip-compressed data
 func decompressGzip(data []byte) ([]byte, error) {
 	reader, err := gzip.NewReader(bytes.NewReader(data))
@@ -541,3 +541,92 @@ func TestDecompress_ZstdDetectionPriority(t *testing.T) {
 		}
 	})
 }
+
+func TestDecompressPrefix_ReturnsCorrectPrefix(t *testing.T) {
+	original := bytes.Repeat([]byte("abcdefghij"), 1000) // 10000 bytes
+
+	tests := []struct {
+		name     string
+		input    []byte
+		maxBytes int
+	}{
+		{name: "gzip prefix", input: createGzipData(t, original), maxBytes: 100},
+		{name: "zlib prefix", input: createZlibData(t, original), maxBytes: 250},
+		{name: "zstd prefix", input: createZstdData(t, original), maxBytes: 37},
+		{name: "uncompressed prefix", input: original, maxBytes: 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := viewer.DecompressPrefix(tt.input, tt.maxBytes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(result, original[:tt.maxBytes]) {
+				t.Errorf("expected prefix %q, got %q", original[:tt.maxBytes], result)
+			}
+		})
+	}
+}
+
+func TestDecompressPrefix_MaxBytesBeyondData(t *testing.T) {
+	original := []byte("hello world")
+	gzipData := createGzipData(t, original)
+
+	result, err := viewer.DecompressPrefix(gzipData, 1000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(result, original) {
+		t.Errorf("expected %q, got %q", original, result)
+	}
+}
+
+func TestDecompressPrefix_NonPositiveMaxBytes(t *testing.T) {
+	gzipData := createGzipData(t, []byte("hello world"))
+
+	result, err := viewer.DecompressPrefix(gzipData, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != nil {
+		t.Errorf("expected nil result for maxBytes <= 0, got %q", result)
+	}
+}
+
+func TestDecompressPrefix_InvalidCompressedData(t *testing.T) {
+	_, err := viewer.DecompressPrefix([]byte{0x1f, 0x8b, 0xff, 0xff}, 10)
+	if err == nil {
+		t.Error("expected error for invalid gzip data")
+	}
+}
+
+func BenchmarkDecompress_Eager(b *testing.B) {
+	original := bytes.Repeat([]byte("abcdefghij"), 1_000_000) // ~10MB
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, _ = w.Write(original)
+	_ = w.Close()
+	gzipData := buf.Bytes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = viewer.Decompress(gzipData)
+	}
+}
+
+func BenchmarkDecompress_Prefix(b *testing.B) {
+	original := bytes.Repeat([]byte("abcdefghij"), 1_000_000) // ~10MB
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, _ = w.Write(original)
+	_ = w.Close()
+	gzipData := buf.Bytes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = viewer.DecompressPrefix(gzipData, 4096)
+	}
+}
@@ -2,7 +2,10 @@ package viewer
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
+	"regexp"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -15,12 +18,33 @@ const (
 	DataTypeText DataType = iota
 	// DataTypeJSON indicates JSON data
 	DataTypeJSON
+	// DataTypeJSONScalar indicates an exact JSON scalar literal - true,
+	// false, null, or a number - rather than a JSON object or array
+	DataTypeJSONScalar
 	// DataTypeBinary indicates binary data
 	DataTypeBinary
 	// DataTypeCompressedGzip indicates gzip-compressed data
 	DataTypeCompressedGzip
 	// DataTypeCompressedZlib indicates zlib-compressed data
 	DataTypeCompressedZlib
+	// DataTypeJWT indicates a JSON Web Token
+	DataTypeJWT
+	// DataTypeHTML indicates an HTML document or fragment
+	DataTypeHTML
+	// DataTypeQueryString indicates a URL-encoded query string (e.g.
+	// "a=1&b=2&c=hello%20world")
+	DataTypeQueryString
+	// DataTypePHPSerialized indicates data produced by PHP's serialize()
+	// function (e.g. `a:2:{s:3:"foo";i:1;...}`), common in Laravel/WordPress
+	// caches
+	DataTypePHPSerialized
+	// DataTypeRubyMarshal indicates data produced by Ruby's Marshal.dump,
+	// identified by its leading 0x04 0x08 magic bytes (format version
+	// major.minor)
+	DataTypeRubyMarshal
+	// DataTypeJavaSerialized indicates a Java serialized object stream,
+	// identified by its leading 0xac 0xed magic bytes (STREAM_MAGIC)
+	DataTypeJavaSerialized
 )
 
 // String returns the string representation of the data type
@@ -30,12 +54,26 @@ func (dt DataType) String() string {
 		return "Text"
 	case DataTypeJSON:
 		return "JSON"
+	case DataTypeJSONScalar:
+		return "Scalar"
 	case DataTypeBinary:
 		return "Binary"
 	case DataTypeCompressedGzip:
 		return "Gzip"
 	case DataTypeCompressedZlib:
 		return "Zlib"
+	case DataTypeJWT:
+		return "JWT"
+	case DataTypeHTML:
+		return "HTML"
+	case DataTypeQueryString:
+		return "Query String"
+	case DataTypePHPSerialized:
+		return "PHP Serialized"
+	case DataTypeRubyMarshal:
+		return "Ruby Marshal"
+	case DataTypeJavaSerialized:
+		return "Java Serialized"
 	default:
 		return "Unknown"
 	}
@@ -55,19 +93,207 @@ func DetectType(data []byte) DataType {
 		return DataTypeCompressedZlib
 	}
 
+	// Check for JWT before binary, since JWTs are plain ASCII
+	if isJWT(data) {
+		return DataTypeJWT
+	}
+
+	// Check for Ruby Marshal and Java serialized object magic bytes before
+	// generic binary detection, so they get a dedicated label instead of the
+	// generic "Binary" one
+	if isRubyMarshal(data) {
+		return DataTypeRubyMarshal
+	}
+	if isJavaSerialized(data) {
+		return DataTypeJavaSerialized
+	}
+
 	// Check for binary data
 	if isBinary(data) {
 		return DataTypeBinary
 	}
 
+	// Check for HTML before JSON/text, since it's unambiguous once detected
+	if isHTML(data) {
+		return DataTypeHTML
+	}
+
+	// Check for PHP's serialize() format before JSON, since it's unambiguous
+	// once detected and doesn't overlap with JSON's leading characters
+	if IsPHPSerialized(data) {
+		return DataTypePHPSerialized
+	}
+
 	// Check for JSON
 	if isJSON(data) {
 		return DataTypeJSON
 	}
 
+	// Check for an exact JSON scalar literal (true/false/null/number)
+	if isJSONScalar(data) {
+		return DataTypeJSONScalar
+	}
+
+	// Check for a URL-encoded query string
+	if isQueryString(data) {
+		return DataTypeQueryString
+	}
+
 	return DataTypeText
 }
 
+// CandidateTypes returns every DataType classification that data plausibly
+// matches, most likely first (DetectType's own result always leads). This
+// backs a "cycle detected type" action for when the top guess is wrong, e.g.
+// borderline base64 vs binary: NextCandidateType rotates through the rest.
+// DataTypeText is always included as the final fallback, since any value can
+// be viewed as raw text.
+func CandidateTypes(data []byte) []DataType {
+	if len(data) == 0 {
+		return []DataType{DataTypeText}
+	}
+
+	var candidates []DataType
+	add := func(dt DataType) {
+		for _, c := range candidates {
+			if c == dt {
+				return
+			}
+		}
+		candidates = append(candidates, dt)
+	}
+
+	if isGzip(data) {
+		add(DataTypeCompressedGzip)
+	}
+	if isZlib(data) {
+		add(DataTypeCompressedZlib)
+	}
+	if isJWT(data) {
+		add(DataTypeJWT)
+	}
+	if isRubyMarshal(data) {
+		add(DataTypeRubyMarshal)
+	}
+	if isJavaSerialized(data) {
+		add(DataTypeJavaSerialized)
+	}
+	if isBinary(data) {
+		add(DataTypeBinary)
+	}
+	if isHTML(data) {
+		add(DataTypeHTML)
+	}
+	if IsPHPSerialized(data) {
+		add(DataTypePHPSerialized)
+	}
+	if isJSON(data) {
+		add(DataTypeJSON)
+	}
+	if isJSONScalar(data) {
+		add(DataTypeJSONScalar)
+	}
+	if isQueryString(data) {
+		add(DataTypeQueryString)
+	}
+	add(DataTypeText)
+
+	return candidates
+}
+
+// NextCandidateType returns the next plausible interpretation of data after
+// current, wrapping around to the first candidate once the end is reached.
+// If current isn't among the candidates (e.g. the first cycle after
+// auto-detection), it returns the first candidate.
+func NextCandidateType(data []byte, current DataType) DataType {
+	candidates := CandidateTypes(data)
+
+	for i, c := range candidates {
+		if c == current {
+			return candidates[(i+1)%len(candidates)]
+		}
+	}
+	return candidates[0]
+}
+
+// maxScalarIntegerDigits caps how many digits a bare (sign-less,
+// decimal-point-less) integer literal may have before it's treated as plain
+// text instead of a number scalar. Without this cap, things like phone
+// numbers or zip codes would be misclassified as numbers just because they
+// happen to consist only of digits.
+const maxScalarIntegerDigits = 4
+
+// isJSONScalar reports whether data is an exact JSON scalar literal: true,
+// false, null, or a number. Plain text that merely looks numeric (e.g. a
+// long digit run like a phone number) is deliberately excluded - see
+// maxScalarIntegerDigits.
+func isJSONScalar(data []byte) bool {
+	s := strings.TrimSpace(string(data))
+	switch s {
+	case "true", "false", "null":
+		return true
+	case "":
+		return false
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return false
+	}
+	if _, ok := v.(float64); !ok {
+		return false
+	}
+
+	// Numbers with a sign, decimal point, or exponent are unambiguous -
+	// phone numbers and similar identifiers are never written that way.
+	if strings.ContainsAny(s, ".-eE") {
+		return true
+	}
+	return len(s) <= maxScalarIntegerDigits
+}
+
+// isJWT checks if the data looks like a JSON Web Token: three base64url
+// segments (header, payload, signature) separated by dots, where the header
+// and payload segments decode to JSON objects.
+func isJWT(data []byte) bool {
+	segments := strings.Split(string(data), ".")
+	if len(segments) != 3 {
+		return false
+	}
+
+	for _, seg := range segments {
+		if seg == "" {
+			return false
+		}
+		if !isBase64URL(seg) {
+			return false
+		}
+	}
+
+	header, err := decodeJWTSegment(segments[0])
+	if err != nil || !isJSON(header) {
+		return false
+	}
+	payload, err := decodeJWTSegment(segments[1])
+	if err != nil || !isJSON(payload) {
+		return false
+	}
+
+	return true
+}
+
+// isBase64URL reports whether s contains only base64url alphabet characters.
+func isBase64URL(s string) bool {
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_', r == '=':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // isGzip checks for gzip magic bytes (0x1f, 0x8b)
 func isGzip(data []byte) bool {
 	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
@@ -82,6 +308,19 @@ func isZlib(data []byte) bool {
 	return data[0] == 0x78 && (data[1] == 0x01 || data[1] == 0x5e || data[1] == 0x9c || data[1] == 0xda)
 }
 
+// isRubyMarshal reports whether data starts with Ruby Marshal's magic bytes:
+// a major version byte (0x04) followed by a minor version byte (0x08 as of
+// Marshal format 4.8, stable since Ruby 1.8).
+func isRubyMarshal(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x04 && data[1] == 0x08
+}
+
+// isJavaSerialized reports whether data starts with the Java object
+// serialization stream's STREAM_MAGIC bytes (0xac 0xed).
+func isJavaSerialized(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0xac && data[1] == 0xed
+}
+
 // isBinary checks if the data contains binary (non-printable) characters
 func isBinary(data []byte) bool {
 	for i := 0; i < len(data); i++ {
@@ -106,6 +345,87 @@ func isBinary(data []byte) bool {
 	return false
 }
 
+// htmlTagNames lists common HTML tag names used to recognize a dominant tag
+// structure. It deliberately excludes generic names that XML documents or
+// plain text commonly also use, keeping detection conservative.
+var htmlTagNames = []string{
+	"html", "head", "body", "div", "span", "p", "table", "tr", "td", "th",
+	"h1", "h2", "h3", "h4", "h5", "h6", "img", "script", "style",
+	"meta", "link", "br", "title", "form", "input", "button", "nav",
+	"footer", "header", "section", "article", "ul", "li", "a",
+}
+
+// minHTMLTagMatches is the minimum number of distinct recognized HTML tags
+// that must appear before data lacking an explicit "<!DOCTYPE" or "<html"
+// marker is classified as HTML, rather than XML or text with stray angle
+// brackets.
+const minHTMLTagMatches = 3
+
+// isHTML reports whether data looks like an HTML document or fragment.
+// Detection is conservative: it requires either an explicit "<!DOCTYPE" or
+// "<html" marker, or content that both starts with '<' and contains several
+// distinct recognized HTML tags, so XML documents and text that merely
+// contains angle brackets aren't misclassified.
+func isHTML(data []byte) bool {
+	s := strings.TrimSpace(string(data))
+	if s == "" || s[0] != '<' {
+		return false
+	}
+
+	lower := strings.ToLower(s)
+	if strings.HasPrefix(lower, "<?xml") {
+		return false
+	}
+	if strings.HasPrefix(lower, "<!doctype") || strings.Contains(lower, "<html") {
+		return true
+	}
+
+	matched := 0
+	for _, tag := range htmlTagNames {
+		if strings.Contains(lower, "<"+tag+" ") || strings.Contains(lower, "<"+tag+">") || strings.Contains(lower, "</"+tag+">") {
+			matched++
+			if matched >= minHTMLTagMatches {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// queryPairPattern matches a single "key=value" query string pair. Both key
+// and value are restricted to unreserved and percent/plus-encoded
+// characters, so a pair containing a raw space or punctuation from an
+// ordinary sentence fails to match.
+var queryPairPattern = regexp.MustCompile(`^[A-Za-z0-9_.~%+-]+=[A-Za-z0-9_.~%+-]*$`)
+
+// isQueryString reports whether data looks like a URL-encoded query string:
+// two or more "key=value" pairs joined by '&'. Detection is conservative,
+// requiring both '=' and '&' plus every pair to match queryPairPattern, so
+// plain text that merely happens to contain an ampersand or equals sign
+// isn't misclassified.
+func isQueryString(data []byte) bool {
+	s := strings.TrimSpace(string(data))
+	if s == "" || !strings.Contains(s, "=") || !strings.Contains(s, "&") {
+		return false
+	}
+
+	pairs := strings.Split(s, "&")
+	if len(pairs) < 2 {
+		return false
+	}
+	for _, pair := range pairs {
+		if !queryPairPattern.MatchString(pair) {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeJWTSegment decodes a base64url JWT segment, tolerating missing padding.
+func decodeJWTSegment(seg string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(seg, "="))
+}
+
 // isJSON checks if the data is valid JSON
 func isJSON(data []byte) bool {
 	data = bytes.TrimSpace(data)
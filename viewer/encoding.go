@@ -0,0 +1,36 @@
+package viewer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+)
+
+// Base64Encode returns the standard base64 encoding of data.
+func Base64Encode(data []byte) []byte {
+	return []byte(base64.StdEncoding.EncodeToString(data))
+}
+
+// Base64Decode decodes standard base64-encoded data.
+func Base64Decode(data []byte) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(string(data))
+}
+
+// CompressGzip gzip-compresses data.
+func CompressGzip(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecompressGzip gzip-decompresses data. Unlike Decompress, this always
+// treats data as gzip-compressed rather than auto-detecting the format.
+func DecompressGzip(data []byte) ([]byte, error) {
+	return decompressGzip(data)
+}
@@ -0,0 +1,65 @@
+package viewer_test
+
+import (
+	"testing"
+
+	"github.com/nnnkkk7/memtui/viewer"
+)
+
+func TestBase64Encode_Decode_RoundTrips(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{name: "plain text", input: []byte("hello world")},
+		{name: "empty", input: []byte{}},
+		{name: "binary", input: []byte{0x00, 0x01, 0xFF, 0xFE}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := viewer.Base64Encode(tt.input)
+			decoded, err := viewer.Base64Decode(encoded)
+			if err != nil {
+				t.Fatalf("unexpected error decoding: %v", err)
+			}
+			if string(decoded) != string(tt.input) {
+				t.Errorf("expected round-trip to restore %q, got %q", tt.input, decoded)
+			}
+		})
+	}
+}
+
+func TestBase64Decode_InvalidInput(t *testing.T) {
+	_, err := viewer.Base64Decode([]byte("not valid base64!!"))
+	if err == nil {
+		t.Error("expected error for invalid base64 input")
+	}
+}
+
+func TestCompressGzip_DecompressGzip_RoundTrips(t *testing.T) {
+	input := []byte("hello world, this is a test of gzip round-tripping")
+
+	compressed, err := viewer.CompressGzip(input)
+	if err != nil {
+		t.Fatalf("unexpected error compressing: %v", err)
+	}
+	if !viewer.IsGzipCompressed(compressed) {
+		t.Error("expected compressed output to be recognized as gzip")
+	}
+
+	decompressed, err := viewer.DecompressGzip(compressed)
+	if err != nil {
+		t.Fatalf("unexpected error decompressing: %v", err)
+	}
+	if string(decompressed) != string(input) {
+		t.Errorf("expected round-trip to restore %q, got %q", input, decompressed)
+	}
+}
+
+func TestDecompressGzip_InvalidInput(t *testing.T) {
+	_, err := viewer.DecompressGzip([]byte("not gzip data"))
+	if err == nil {
+		t.Error("expected error for invalid gzip input")
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 )
 
 // Formatter formats data for display
@@ -15,29 +17,94 @@ type Formatter interface {
 
 // JSONFormatter formats JSON data with indentation
 type JSONFormatter struct {
-	indent string
+	indent   string
+	compact  bool
+	sortKeys bool
 }
 
-// NewJSONFormatter creates a new JSON formatter
+// NewJSONFormatter creates a new JSON formatter, indented with two spaces.
 func NewJSONFormatter() *JSONFormatter {
 	return &JSONFormatter{
 		indent: "  ",
 	}
 }
 
-// Format formats JSON data with indentation
+// NewJSONFormatterWithIndent creates a new JSON formatter using indent
+// (e.g. "  ", "    ", or "\t") in place of the default two spaces.
+func NewJSONFormatterWithIndent(indent string) *JSONFormatter {
+	return &JSONFormatter{
+		indent: indent,
+	}
+}
+
+// SetIndent changes the indentation string used for non-compact formatting.
+func (f *JSONFormatter) SetIndent(indent string) {
+	f.indent = indent
+}
+
+// SetCompact enables or disables single-line, compact JSON rendering
+// instead of the default indented form.
+func (f *JSONFormatter) SetCompact(compact bool) {
+	f.compact = compact
+}
+
+// SetSortKeys enables or disables alphabetical sorting of JSON object keys.
+// Array order is always preserved; sorting applies recursively to nested
+// objects. Disabled by default.
+func (f *JSONFormatter) SetSortKeys(sort bool) {
+	f.sortKeys = sort
+}
+
+// Format formats JSON data, indented by default, or as single-line compact
+// JSON when SetCompact(true) has been called.
 func (f *JSONFormatter) Format(data []byte) (string, error) {
+	if f.sortKeys {
+		return f.formatSorted(data)
+	}
+
 	var out bytes.Buffer
-	err := json.Indent(&out, data, "", f.indent)
-	if err != nil {
+	if f.compact {
+		if err := json.Compact(&out, data); err != nil {
+			return "", fmt.Errorf("invalid JSON: %w", err)
+		}
+		return out.String(), nil
+	}
+	if err := json.Indent(&out, data, "", f.indent); err != nil {
 		return "", fmt.Errorf("invalid JSON: %w", err)
 	}
 	return out.String(), nil
 }
 
+// formatSorted re-marshals data through Go's generic JSON representation,
+// which sorts map keys alphabetically (including in nested objects) while
+// leaving array element order untouched. A json.Decoder with UseNumber is
+// used to avoid losing precision on large integers.
+func (f *JSONFormatter) formatSorted(data []byte) (string, error) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if f.compact {
+		out, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("invalid JSON: %w", err)
+		}
+		return string(out), nil
+	}
+	out, err := json.MarshalIndent(v, "", f.indent)
+	if err != nil {
+		return "", fmt.Errorf("invalid JSON: %w", err)
+	}
+	return string(out), nil
+}
+
 // HexFormatter formats binary data as hex dump
 type HexFormatter struct {
 	bytesPerLine int
+	showRuler    bool
 }
 
 // NewHexFormatter creates a new hex formatter
@@ -47,32 +114,93 @@ func NewHexFormatter() *HexFormatter {
 	}
 }
 
+// SetBytesPerLine sets the number of bytes shown per line of the hex dump.
+// Values less than 1 are ignored.
+func (f *HexFormatter) SetBytesPerLine(n int) {
+	if n < 1 {
+		return
+	}
+	f.bytesPerLine = n
+}
+
+// SetShowRuler enables or disables a header line of byte column indices
+// (00 01 02 ... ) printed above the dump, aligned with bytesPerLine.
+func (f *HexFormatter) SetShowRuler(show bool) {
+	f.showRuler = show
+}
+
+// ShowRuler returns whether the column ruler header is shown
+func (f *HexFormatter) ShowRuler() bool {
+	return f.showRuler
+}
+
+// ruler renders the column index header line, aligned with the offset and
+// hex-byte columns produced by Format
+func (f *HexFormatter) ruler() string {
+	var out strings.Builder
+	out.WriteString(strings.Repeat(" ", 10)) // matches "%08x  " offset prefix
+	for j := 0; j < f.bytesPerLine; j++ {
+		fmt.Fprintf(&out, "%02x ", j)
+	}
+	out.WriteString("\n")
+	return out.String()
+}
+
 // Format formats data as a hex dump
 func (f *HexFormatter) Format(data []byte) (string, error) {
-	if len(data) == 0 {
+	return f.formatFrom(data, 0)
+}
+
+// FormatRange formats only the window of data spanning [start, start+length)
+// as a hex dump, using absolute offsets in the output so the window reads
+// the same as it would in a dump of the full value. This avoids formatting
+// bytes outside the window, which matters when data is very large and only
+// a region of it needs to be inspected. A non-positive length, or a start
+// at or beyond the end of data, yields an empty dump.
+func (f *HexFormatter) FormatRange(data []byte, start, length int) (string, error) {
+	if start < 0 {
+		start = 0
+	}
+	if length <= 0 || start >= len(data) {
+		return "", nil
+	}
+	end := start + length
+	if end > len(data) {
+		end = len(data)
+	}
+	return f.formatFrom(data[start:end], start)
+}
+
+// formatFrom renders chunk as a hex dump, labeling offsets starting at
+// baseOffset rather than 0.
+func (f *HexFormatter) formatFrom(chunk []byte, baseOffset int) (string, error) {
+	if len(chunk) == 0 {
 		return "", nil
 	}
 
 	var out strings.Builder
-	for i := 0; i < len(data); i += f.bytesPerLine {
+	if f.showRuler {
+		out.WriteString(f.ruler())
+	}
+	for i := 0; i < len(chunk); i += f.bytesPerLine {
 		end := i + f.bytesPerLine
-		if end > len(data) {
-			end = len(data)
+		if end > len(chunk) {
+			end = len(chunk)
 		}
-		chunk := data[i:end]
+		line := chunk[i:end]
 
 		// Offset
-		fmt.Fprintf(&out, "%08x  ", i)
+		fmt.Fprintf(&out, "%08x  ", baseOffset+i)
 
 		// Hex bytes
-		hexStr := hex.EncodeToString(chunk)
+		hexStr := hex.EncodeToString(line)
 		for j := 0; j < len(hexStr); j += 2 {
 			out.WriteString(hexStr[j : j+2])
 			out.WriteByte(' ')
 		}
 
 		// Padding for incomplete lines
-		padding := f.bytesPerLine - len(chunk)
+		padding := f.bytesPerLine - len(line)
 		for j := 0; j < padding; j++ {
 			out.WriteString("   ")
 		}
@@ -80,7 +208,7 @@ func (f *HexFormatter) Format(data []byte) (string, error) {
 		out.WriteString(" |")
 
 		// ASCII representation
-		for _, b := range chunk {
+		for _, b := range line {
 			if b >= 32 && b < 127 {
 				out.WriteByte(b)
 			} else {
@@ -94,6 +222,163 @@ func (f *HexFormatter) Format(data []byte) (string, error) {
 	return out.String(), nil
 }
 
+// HTMLFormatter indents HTML for readability, or optionally strips tags
+// down to a plain-text preview of the visible content.
+type HTMLFormatter struct {
+	indent    string
+	stripTags bool
+}
+
+// NewHTMLFormatter creates a new HTML formatter
+func NewHTMLFormatter() *HTMLFormatter {
+	return &HTMLFormatter{
+		indent: "  ",
+	}
+}
+
+// SetStripTags enables or disables rendering a tag-stripped text preview
+// instead of an indented markup dump.
+func (f *HTMLFormatter) SetStripTags(strip bool) {
+	f.stripTags = strip
+}
+
+// StripTags returns whether tag-stripped preview mode is enabled
+func (f *HTMLFormatter) StripTags() bool {
+	return f.stripTags
+}
+
+// Format indents data as HTML, one tag or text node per line nested under
+// its enclosing element, or renders a tag-stripped text preview when
+// StripTags is enabled.
+func (f *HTMLFormatter) Format(data []byte) (string, error) {
+	tokens := tokenizeHTML(string(data))
+	if f.stripTags {
+		return stripHTMLTags(tokens), nil
+	}
+	return indentHTMLTokens(tokens, f.indent), nil
+}
+
+// htmlToken is a single tag, comment/doctype, or run of text extracted by
+// tokenizeHTML.
+type htmlToken struct {
+	kind string // "open", "close", "void", "other" (comment/doctype), or "text"
+	name string // lowercased tag name, for "open"/"close"/"void"
+	raw  string
+}
+
+// htmlVoidElements lists elements that never have a closing tag and so
+// never increase indentation depth.
+var htmlVoidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"source": true, "track": true, "wbr": true,
+}
+
+// tokenizeHTML splits HTML source into a flat sequence of tag and text
+// tokens. It's a lightweight scanner, not a full parser: it doesn't handle
+// malformed markup beyond passing it through as text.
+func tokenizeHTML(s string) []htmlToken {
+	var tokens []htmlToken
+	for i := 0; i < len(s); {
+		lt := strings.IndexByte(s[i:], '<')
+		if lt < 0 {
+			if text := strings.TrimSpace(s[i:]); text != "" {
+				tokens = append(tokens, htmlToken{kind: "text", raw: text})
+			}
+			break
+		}
+		if lt > 0 {
+			if text := strings.TrimSpace(s[i : i+lt]); text != "" {
+				tokens = append(tokens, htmlToken{kind: "text", raw: text})
+			}
+		}
+		i += lt
+
+		gt := strings.IndexByte(s[i:], '>')
+		if gt < 0 {
+			tokens = append(tokens, htmlToken{kind: "text", raw: s[i:]})
+			break
+		}
+		tag := s[i : i+gt+1]
+		i += gt + 1
+
+		inner := strings.TrimSpace(tag[1 : len(tag)-1])
+		switch {
+		case strings.HasPrefix(inner, "!"):
+			tokens = append(tokens, htmlToken{kind: "other", raw: tag})
+		case strings.HasPrefix(inner, "/"):
+			tokens = append(tokens, htmlToken{kind: "close", name: htmlTagName(inner), raw: tag})
+		case strings.HasSuffix(inner, "/"):
+			tokens = append(tokens, htmlToken{kind: "void", name: htmlTagName(inner), raw: tag})
+		default:
+			name := htmlTagName(inner)
+			if htmlVoidElements[name] {
+				tokens = append(tokens, htmlToken{kind: "void", name: name, raw: tag})
+			} else {
+				tokens = append(tokens, htmlToken{kind: "open", name: name, raw: tag})
+			}
+		}
+	}
+	return tokens
+}
+
+// htmlTagName extracts the lowercased tag name from a tag's inner content
+// (the part between '<' and '>', with any leading '/' or trailing '/'
+// already stripped by the caller's switch).
+func htmlTagName(inner string) string {
+	inner = strings.TrimPrefix(inner, "/")
+	inner = strings.TrimSuffix(inner, "/")
+	inner = strings.TrimSpace(inner)
+	end := strings.IndexAny(inner, " \t\n\r")
+	if end < 0 {
+		return strings.ToLower(inner)
+	}
+	return strings.ToLower(inner[:end])
+}
+
+// indentHTMLTokens renders tokens as one line per tag or text node, indented
+// by nesting depth.
+func indentHTMLTokens(tokens []htmlToken, indent string) string {
+	var out strings.Builder
+	depth := 0
+	for _, t := range tokens {
+		if t.kind == "close" && depth > 0 {
+			depth--
+		}
+		out.WriteString(strings.Repeat(indent, depth))
+		out.WriteString(t.raw)
+		out.WriteString("\n")
+		if t.kind == "open" {
+			depth++
+		}
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+// stripHTMLTags renders only the text content of tokens, dropping all tags
+// and the contents of <script> and <style> elements.
+func stripHTMLTags(tokens []htmlToken) string {
+	var lines []string
+	skipDepth := 0
+	for _, t := range tokens {
+		switch t.kind {
+		case "open":
+			if t.name == "script" || t.name == "style" {
+				skipDepth++
+			}
+		case "close":
+			if (t.name == "script" || t.name == "style") && skipDepth > 0 {
+				skipDepth--
+			}
+		case "text":
+			if skipDepth == 0 {
+				lines = append(lines, t.raw)
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // TextFormatter formats text data
 type TextFormatter struct{}
 
@@ -107,31 +392,365 @@ func (f *TextFormatter) Format(data []byte) (string, error) {
 	return string(data), nil
 }
 
+// RawFormatter renders data with visible escape sequences for whitespace
+// and non-printable bytes, without otherwise interpreting them. Unlike the
+// hex dump, printable runs stay readable inline instead of being split out
+// into a separate ASCII column.
+type RawFormatter struct{}
+
+// NewRawFormatter creates a new raw formatter
+func NewRawFormatter() *RawFormatter {
+	return &RawFormatter{}
+}
+
+// Format renders data with \t, \n, \r, \\, and \xNN escapes for tabs,
+// newlines, carriage returns, backslashes, and any other non-printable
+// byte. Printable ASCII bytes are left as-is.
+func (f *RawFormatter) Format(data []byte) (string, error) {
+	var out strings.Builder
+	for _, b := range data {
+		switch b {
+		case '\t':
+			out.WriteString(`\t`)
+		case '\n':
+			out.WriteString(`\n`)
+		case '\r':
+			out.WriteString(`\r`)
+		case '\\':
+			out.WriteString(`\\`)
+		default:
+			if b >= 32 && b < 127 {
+				out.WriteByte(b)
+			} else {
+				fmt.Fprintf(&out, `\x%02x`, b)
+			}
+		}
+	}
+	return out.String(), nil
+}
+
+// ScalarFormatter renders an exact JSON scalar literal (true, false, null,
+// or a number) labeled with its kind, e.g. "number: 42".
+type ScalarFormatter struct{}
+
+// NewScalarFormatter creates a new scalar formatter
+func NewScalarFormatter() *ScalarFormatter {
+	return &ScalarFormatter{}
+}
+
+// Format labels the scalar literal with its kind. The caller is expected to
+// have already confirmed data is a valid JSON scalar (see DetectType).
+func (f *ScalarFormatter) Format(data []byte) (string, error) {
+	s := strings.TrimSpace(string(data))
+	switch s {
+	case "true", "false":
+		return fmt.Sprintf("boolean: %s", s), nil
+	case "null":
+		return "null", nil
+	default:
+		return fmt.Sprintf("number: %s", s), nil
+	}
+}
+
+// JWTFormatter decodes and pretty-prints a JSON Web Token's header and
+// payload without verifying the signature.
+type JWTFormatter struct {
+	jsonFormatter *JSONFormatter
+}
+
+// NewJWTFormatter creates a new JWT formatter
+func NewJWTFormatter() *JWTFormatter {
+	return &JWTFormatter{
+		jsonFormatter: NewJSONFormatter(),
+	}
+}
+
+// SetJSONIndent changes the indentation used when pretty-printing the
+// decoded header and payload.
+func (f *JWTFormatter) SetJSONIndent(indent string) {
+	f.jsonFormatter.SetIndent(indent)
+}
+
+// SetSortKeys enables or disables alphabetical key sorting when
+// pretty-printing the decoded header and payload.
+func (f *JWTFormatter) SetSortKeys(sort bool) {
+	f.jsonFormatter.SetSortKeys(sort)
+}
+
+// Format decodes the header and payload segments of a JWT and renders them
+// as labeled, pretty-printed JSON. The signature segment is shown as-is,
+// since it cannot be verified without the signing key. An expired "exp"
+// claim in the payload is called out with a warning line.
+func (f *JWTFormatter) Format(data []byte) (string, error) {
+	segments := strings.Split(string(data), ".")
+	if len(segments) != 3 {
+		return "", fmt.Errorf("invalid JWT: expected 3 segments, got %d", len(segments))
+	}
+
+	header, err := decodeJWTSegment(segments[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid JWT header: %w", err)
+	}
+	payload, err := decodeJWTSegment(segments[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid JWT payload: %w", err)
+	}
+
+	headerJSON, err := f.jsonFormatter.Format(header)
+	if err != nil {
+		return "", fmt.Errorf("invalid JWT header JSON: %w", err)
+	}
+	payloadJSON, err := f.jsonFormatter.Format(payload)
+	if err != nil {
+		return "", fmt.Errorf("invalid JWT payload JSON: %w", err)
+	}
+
+	var out strings.Builder
+	out.WriteString("HEADER:\n")
+	out.WriteString(headerJSON)
+	out.WriteString("\n\nPAYLOAD:\n")
+	out.WriteString(payloadJSON)
+	if msg := expiryWarning(payload); msg != "" {
+		out.WriteString("\n\n")
+		out.WriteString(msg)
+	}
+	out.WriteString("\n\nSIGNATURE (unverified):\n")
+	out.WriteString(segments[2])
+
+	return out.String(), nil
+}
+
+// expiryWarning returns a warning line if the payload has an "exp" claim
+// that has already passed, or an empty string otherwise.
+func expiryWarning(payload []byte) string {
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return ""
+	}
+	if time.Unix(claims.Exp, 0).Before(time.Now()) {
+		return fmt.Sprintf("WARNING: token expired at %s", time.Unix(claims.Exp, 0).UTC().Format(time.RFC3339))
+	}
+	return ""
+}
+
+// RubyMarshalFormatter renders an informative header for Ruby Marshal data -
+// naming the format and its version - followed by a hex dump, since full
+// decoding of Ruby's object graph format isn't attempted.
+type RubyMarshalFormatter struct {
+	hexFormatter *HexFormatter
+}
+
+// NewRubyMarshalFormatter creates a new Ruby Marshal formatter
+func NewRubyMarshalFormatter() *RubyMarshalFormatter {
+	return &RubyMarshalFormatter{
+		hexFormatter: NewHexFormatter(),
+	}
+}
+
+// Format renders a header naming the Marshal format version followed by a
+// hex dump of the full data, including the magic bytes.
+func (f *RubyMarshalFormatter) Format(data []byte) (string, error) {
+	if !isRubyMarshal(data) {
+		return "", fmt.Errorf("not a Ruby Marshal stream")
+	}
+
+	hexDump, err := f.hexFormatter.Format(data)
+	if err != nil {
+		return "", err
+	}
+
+	header := fmt.Sprintf("Ruby Marshal stream (format version %d.%d)", data[0], data[1])
+	return header + "\n\n" + hexDump, nil
+}
+
+// JavaSerializedFormatter renders an informative header for a Java
+// serialized object stream - naming the format and its stream version -
+// followed by a hex dump, since full decoding of Java's object graph format
+// isn't attempted.
+type JavaSerializedFormatter struct {
+	hexFormatter *HexFormatter
+}
+
+// NewJavaSerializedFormatter creates a new Java serialized object formatter
+func NewJavaSerializedFormatter() *JavaSerializedFormatter {
+	return &JavaSerializedFormatter{
+		hexFormatter: NewHexFormatter(),
+	}
+}
+
+// Format renders a header naming the stream version (when present) followed
+// by a hex dump of the full data, including the magic bytes.
+func (f *JavaSerializedFormatter) Format(data []byte) (string, error) {
+	if !isJavaSerialized(data) {
+		return "", fmt.Errorf("not a Java serialized object stream")
+	}
+
+	hexDump, err := f.hexFormatter.Format(data)
+	if err != nil {
+		return "", err
+	}
+
+	header := "Java serialized object stream"
+	if len(data) >= 4 {
+		version := int(data[2])<<8 | int(data[3])
+		header = fmt.Sprintf("%s (stream version %d)", header, version)
+	}
+	return header + "\n\n" + hexDump, nil
+}
+
 // AutoFormatter automatically detects and formats data
 type AutoFormatter struct {
-	jsonFormatter *JSONFormatter
-	hexFormatter  *HexFormatter
-	textFormatter *TextFormatter
+	jsonFormatter           *JSONFormatter
+	scalarFormatter         *ScalarFormatter
+	hexFormatter            *HexFormatter
+	textFormatter           *TextFormatter
+	jwtFormatter            *JWTFormatter
+	htmlFormatter           *HTMLFormatter
+	queryStringFormatter    *QueryStringFormatter
+	phpFormatter            *PHPSerializedFormatter
+	rubyMarshalFormatter    *RubyMarshalFormatter
+	javaSerializedFormatter *JavaSerializedFormatter
 }
 
 // NewAutoFormatter creates a new auto formatter
 func NewAutoFormatter() *AutoFormatter {
 	return &AutoFormatter{
-		jsonFormatter: NewJSONFormatter(),
-		hexFormatter:  NewHexFormatter(),
-		textFormatter: NewTextFormatter(),
+		jsonFormatter:           NewJSONFormatter(),
+		scalarFormatter:         NewScalarFormatter(),
+		hexFormatter:            NewHexFormatter(),
+		textFormatter:           NewTextFormatter(),
+		jwtFormatter:            NewJWTFormatter(),
+		htmlFormatter:           NewHTMLFormatter(),
+		queryStringFormatter:    NewQueryStringFormatter(),
+		phpFormatter:            NewPHPSerializedFormatter(),
+		rubyMarshalFormatter:    NewRubyMarshalFormatter(),
+		javaSerializedFormatter: NewJavaSerializedFormatter(),
 	}
 }
 
+// SetHexBytesPerLine configures the number of bytes shown per line when
+// auto-detection falls back to a hex dump for binary data.
+func (f *AutoFormatter) SetHexBytesPerLine(n int) {
+	f.hexFormatter.SetBytesPerLine(n)
+}
+
+// SetJSONIndent changes the indentation used when auto-detection renders
+// JSON (including the header/payload of a detected JWT).
+func (f *AutoFormatter) SetJSONIndent(indent string) {
+	f.jsonFormatter.SetIndent(indent)
+	f.jwtFormatter.SetJSONIndent(indent)
+	f.phpFormatter.SetJSONIndent(indent)
+}
+
+// SetHexShowRuler configures whether the hex dump fallback shows a column
+// ruler header (see HexFormatter.SetShowRuler).
+func (f *AutoFormatter) SetHexShowRuler(show bool) {
+	f.hexFormatter.SetShowRuler(show)
+}
+
+// SetSortKeys enables or disables alphabetical key sorting when
+// auto-detection renders JSON (including the header/payload of a detected
+// JWT).
+func (f *AutoFormatter) SetSortKeys(sort bool) {
+	f.jsonFormatter.SetSortKeys(sort)
+	f.jwtFormatter.SetSortKeys(sort)
+	f.phpFormatter.SetSortKeys(sort)
+}
+
 // Format auto-detects and formats data
 func (f *AutoFormatter) Format(data []byte) (string, error) {
-	dt := DetectType(data)
+	return f.FormatAs(data, DetectType(data))
+}
+
+// FormatAs formats data as the given type, bypassing auto-detection. Used to
+// render a manually chosen interpretation, e.g. when cycling through
+// DataType candidates for an ambiguous value (see CandidateTypes).
+func (f *AutoFormatter) FormatAs(data []byte, dt DataType) (string, error) {
 	switch dt {
 	case DataTypeJSON:
 		return f.jsonFormatter.Format(data)
+	case DataTypeJSONScalar:
+		return f.scalarFormatter.Format(data)
+	case DataTypeJWT:
+		return f.jwtFormatter.Format(data)
+	case DataTypeHTML:
+		return f.htmlFormatter.Format(data)
+	case DataTypeQueryString:
+		return f.queryStringFormatter.Format(data)
+	case DataTypePHPSerialized:
+		return f.phpFormatter.Format(data)
+	case DataTypeRubyMarshal:
+		return f.rubyMarshalFormatter.Format(data)
+	case DataTypeJavaSerialized:
+		return f.javaSerializedFormatter.Format(data)
 	case DataTypeBinary, DataTypeCompressedGzip, DataTypeCompressedZlib:
 		return f.hexFormatter.Format(data)
 	default:
 		return f.textFormatter.Format(data)
 	}
 }
+
+// QueryStringFormatter decodes a URL-encoded query string and renders its
+// key/value pairs as an aligned table.
+type QueryStringFormatter struct{}
+
+// NewQueryStringFormatter creates a new query string formatter.
+func NewQueryStringFormatter() *QueryStringFormatter {
+	return &QueryStringFormatter{}
+}
+
+// queryPair is a single decoded key/value pair from a query string.
+type queryPair struct {
+	Key   string
+	Value string
+}
+
+// parseQueryPairs splits a query string on '&' and percent-decodes each
+// pair's key and value. A pair whose value fails to decode keeps its raw
+// form rather than being dropped.
+func parseQueryPairs(s string) []queryPair {
+	rawPairs := strings.Split(s, "&")
+	pairs := make([]queryPair, 0, len(rawPairs))
+	for _, raw := range rawPairs {
+		key, value, _ := strings.Cut(raw, "=")
+		pairs = append(pairs, queryPair{
+			Key:   queryUnescape(key),
+			Value: queryUnescape(value),
+		})
+	}
+	return pairs
+}
+
+// queryUnescape percent-decodes s, falling back to the original string if
+// it isn't validly encoded.
+func queryUnescape(s string) string {
+	decoded, err := url.QueryUnescape(s)
+	if err != nil {
+		return s
+	}
+	return decoded
+}
+
+// Format decodes data as a query string and renders its pairs as a table
+// with the key and value columns aligned, in the order they appear.
+func (f *QueryStringFormatter) Format(data []byte) (string, error) {
+	pairs := parseQueryPairs(strings.TrimSpace(string(data)))
+
+	keyWidth := 0
+	for _, p := range pairs {
+		if len(p.Key) > keyWidth {
+			keyWidth = len(p.Key)
+		}
+	}
+
+	var out strings.Builder
+	for i, p := range pairs {
+		if i > 0 {
+			out.WriteString("\n")
+		}
+		fmt.Fprintf(&out, "%-*s  %s", keyWidth, p.Key, p.Value)
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,443 @@
+package viewer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// IsPHPSerialized reports whether data looks like the output of PHP's
+// serialize() function (e.g. `a:2:{s:3:"foo";i:1;...}`). Detection works by
+// attempting a full decode: malformed or merely PHP-serialize-ish input is
+// rejected rather than guessed at.
+func IsPHPSerialized(data []byte) bool {
+	s := bytes.TrimSpace(data)
+	if len(s) < 2 {
+		return false
+	}
+	switch s[0] {
+	case 'N', 'b', 'i', 'd', 's', 'a', 'O':
+	default:
+		return false
+	}
+	_, err := DecodePHPSerialized(s)
+	return err == nil
+}
+
+// DecodePHPSerialized parses PHP's serialize() wire format and renders it as
+// a compact JSON document: PHP arrays become JSON arrays when their keys are
+// the sequential integers 0..n-1, and JSON objects otherwise; PHP objects
+// become JSON objects with a synthetic "__class__" field carrying the
+// original class name. The result is meant to be re-indented by a
+// JSONFormatter for display.
+func DecodePHPSerialized(data []byte) (string, error) {
+	d := &phpDecoder{data: bytes.TrimSpace(data)}
+	var buf bytes.Buffer
+	if err := d.decodeValue(&buf); err != nil {
+		return "", err
+	}
+	if d.pos != len(d.data) {
+		return "", fmt.Errorf("unexpected trailing data at offset %d", d.pos)
+	}
+	return buf.String(), nil
+}
+
+// phpDecoder is a recursive-descent parser over PHP serialize() output,
+// tracking its read position with pos.
+type phpDecoder struct {
+	data []byte
+	pos  int
+}
+
+// phpPair is one key/value entry of a PHP array or object, decoded in
+// encounter order so renderPHPPairs can tell a list-shaped array from an
+// associative one.
+type phpPair struct {
+	key      string
+	keyIsInt bool
+	keyInt   int64
+	value    string
+}
+
+func (d *phpDecoder) decodeValue(buf *bytes.Buffer) error {
+	if d.pos >= len(d.data) {
+		return fmt.Errorf("unexpected end of input")
+	}
+	switch d.data[d.pos] {
+	case 'N':
+		return d.decodeNull(buf)
+	case 'b':
+		return d.decodeBool(buf)
+	case 'i':
+		return d.decodeInt(buf)
+	case 'd':
+		return d.decodeFloat(buf)
+	case 's':
+		return d.decodeString(buf)
+	case 'a':
+		return d.decodeArray(buf)
+	case 'O':
+		return d.decodeObject(buf)
+	default:
+		return fmt.Errorf("unsupported PHP serialized type %q at offset %d", d.data[d.pos], d.pos)
+	}
+}
+
+// expect consumes b from the current position, or errors if it isn't there.
+func (d *phpDecoder) expect(b byte) error {
+	if d.pos >= len(d.data) || d.data[d.pos] != b {
+		return fmt.Errorf("expected %q at offset %d", b, d.pos)
+	}
+	d.pos++
+	return nil
+}
+
+// readUntil returns the bytes up to (excluding) the next delim, consuming
+// delim itself.
+func (d *phpDecoder) readUntil(delim byte) (string, error) {
+	start := d.pos
+	for d.pos < len(d.data) && d.data[d.pos] != delim {
+		d.pos++
+	}
+	if d.pos >= len(d.data) {
+		return "", fmt.Errorf("expected %q before end of input", delim)
+	}
+	s := string(d.data[start:d.pos])
+	d.pos++
+	return s, nil
+}
+
+// readFixedString reads a `"<n bytes>"` payload, as used by both the s: and
+// O: forms, where n is a byte count rather than a rune count.
+func (d *phpDecoder) readFixedString(n int) (string, error) {
+	if err := d.expect('"'); err != nil {
+		return "", err
+	}
+	if n < 0 || n > len(d.data)-d.pos {
+		return "", fmt.Errorf("string length %d exceeds remaining input", n)
+	}
+	s := string(d.data[d.pos : d.pos+n])
+	d.pos += n
+	if err := d.expect('"'); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+func (d *phpDecoder) decodeNull(buf *bytes.Buffer) error {
+	d.pos++ // 'N'
+	if err := d.expect(';'); err != nil {
+		return err
+	}
+	buf.WriteString("null")
+	return nil
+}
+
+func (d *phpDecoder) decodeBool(buf *bytes.Buffer) error {
+	d.pos++ // 'b'
+	if err := d.expect(':'); err != nil {
+		return err
+	}
+	val, err := d.readUntil(';')
+	if err != nil {
+		return err
+	}
+	switch val {
+	case "0":
+		buf.WriteString("false")
+	case "1":
+		buf.WriteString("true")
+	default:
+		return fmt.Errorf("invalid bool value %q", val)
+	}
+	return nil
+}
+
+func (d *phpDecoder) decodeInt(buf *bytes.Buffer) error {
+	d.pos++ // 'i'
+	if err := d.expect(':'); err != nil {
+		return err
+	}
+	val, err := d.readUntil(';')
+	if err != nil {
+		return err
+	}
+	if _, err := strconv.ParseInt(val, 10, 64); err != nil {
+		return fmt.Errorf("invalid int value %q", val)
+	}
+	buf.WriteString(val)
+	return nil
+}
+
+func (d *phpDecoder) decodeFloat(buf *bytes.Buffer) error {
+	d.pos++ // 'd'
+	if err := d.expect(':'); err != nil {
+		return err
+	}
+	val, err := d.readUntil(';')
+	if err != nil {
+		return err
+	}
+	f, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return fmt.Errorf("invalid float value %q", val)
+	}
+	buf.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+	return nil
+}
+
+func (d *phpDecoder) decodeString(buf *bytes.Buffer) error {
+	d.pos++ // 's'
+	if err := d.expect(':'); err != nil {
+		return err
+	}
+	lenStr, err := d.readUntil(':')
+	if err != nil {
+		return err
+	}
+	n, err := strconv.Atoi(lenStr)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid string length %q", lenStr)
+	}
+	s, err := d.readFixedString(n)
+	if err != nil {
+		return err
+	}
+	if err := d.expect(';'); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	buf.Write(encoded)
+	return nil
+}
+
+// decodeKey parses an array key (always an i: or s: value, never another
+// array or object) and reports whether it was an integer key, for
+// renderPHPPairs to decide between a JSON array and object.
+func (d *phpDecoder) decodeKey() (key string, isInt bool, intVal int64, err error) {
+	if d.pos >= len(d.data) {
+		return "", false, 0, fmt.Errorf("unexpected end of input")
+	}
+	switch d.data[d.pos] {
+	case 'i':
+		d.pos++
+		if err = d.expect(':'); err != nil {
+			return
+		}
+		var raw string
+		raw, err = d.readUntil(';')
+		if err != nil {
+			return
+		}
+		intVal, err = strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return "", false, 0, fmt.Errorf("invalid int key %q", raw)
+		}
+		return raw, true, intVal, nil
+	case 's':
+		d.pos++
+		if err = d.expect(':'); err != nil {
+			return
+		}
+		var lenStr string
+		lenStr, err = d.readUntil(':')
+		if err != nil {
+			return
+		}
+		var n int
+		n, err = strconv.Atoi(lenStr)
+		if err != nil || n < 0 {
+			return "", false, 0, fmt.Errorf("invalid string key length %q", lenStr)
+		}
+		var s string
+		s, err = d.readFixedString(n)
+		if err != nil {
+			return
+		}
+		if err = d.expect(';'); err != nil {
+			return
+		}
+		return s, false, 0, nil
+	default:
+		return "", false, 0, fmt.Errorf("unsupported PHP array key type %q at offset %d", d.data[d.pos], d.pos)
+	}
+}
+
+func (d *phpDecoder) decodeArray(buf *bytes.Buffer) error {
+	d.pos++ // 'a'
+	if err := d.expect(':'); err != nil {
+		return err
+	}
+	countStr, err := d.readUntil(':')
+	if err != nil {
+		return err
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count < 0 || count > len(d.data)-d.pos {
+		return fmt.Errorf("invalid array count %q", countStr)
+	}
+	if err := d.expect('{'); err != nil {
+		return err
+	}
+
+	pairs := make([]phpPair, 0, count)
+	for i := 0; i < count; i++ {
+		key, keyIsInt, keyInt, err := d.decodeKey()
+		if err != nil {
+			return err
+		}
+		var valBuf bytes.Buffer
+		if err := d.decodeValue(&valBuf); err != nil {
+			return err
+		}
+		pairs = append(pairs, phpPair{key: key, keyIsInt: keyIsInt, keyInt: keyInt, value: valBuf.String()})
+	}
+	if err := d.expect('}'); err != nil {
+		return err
+	}
+
+	buf.WriteString(renderPHPPairs(pairs, ""))
+	return nil
+}
+
+func (d *phpDecoder) decodeObject(buf *bytes.Buffer) error {
+	d.pos++ // 'O'
+	if err := d.expect(':'); err != nil {
+		return err
+	}
+	nameLenStr, err := d.readUntil(':')
+	if err != nil {
+		return err
+	}
+	nameLen, err := strconv.Atoi(nameLenStr)
+	if err != nil || nameLen < 0 {
+		return fmt.Errorf("invalid class name length %q", nameLenStr)
+	}
+	className, err := d.readFixedString(nameLen)
+	if err != nil {
+		return err
+	}
+	if err := d.expect(':'); err != nil {
+		return err
+	}
+
+	countStr, err := d.readUntil(':')
+	if err != nil {
+		return err
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count < 0 || count > len(d.data)-d.pos {
+		return fmt.Errorf("invalid property count %q", countStr)
+	}
+	if err := d.expect('{'); err != nil {
+		return err
+	}
+
+	pairs := make([]phpPair, 0, count)
+	for i := 0; i < count; i++ {
+		key, _, _, err := d.decodeKey()
+		if err != nil {
+			return err
+		}
+		var valBuf bytes.Buffer
+		if err := d.decodeValue(&valBuf); err != nil {
+			return err
+		}
+		pairs = append(pairs, phpPair{key: key, value: valBuf.String()})
+	}
+	if err := d.expect('}'); err != nil {
+		return err
+	}
+
+	buf.WriteString(renderPHPPairs(pairs, className))
+	return nil
+}
+
+// renderPHPPairs renders an array's or object's decoded pairs as compact
+// JSON: a PHP array with sequential integer keys 0..len-1 becomes a JSON
+// array; anything else (an associative array, or a PHP object, identified
+// by a non-empty className) becomes a JSON object. Objects get a synthetic
+// "__class__" field first, carrying the original PHP class name.
+func renderPHPPairs(pairs []phpPair, className string) string {
+	isList := className == ""
+	for i, p := range pairs {
+		if !p.keyIsInt || p.keyInt != int64(i) {
+			isList = false
+			break
+		}
+	}
+
+	var b bytes.Buffer
+	if isList {
+		b.WriteByte('[')
+		for i, p := range pairs {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(p.value)
+		}
+		b.WriteByte(']')
+		return b.String()
+	}
+
+	b.WriteByte('{')
+	wroteField := false
+	if className != "" {
+		classJSON, _ := json.Marshal(className)
+		b.WriteString(`"__class__":`)
+		b.Write(classJSON)
+		wroteField = true
+	}
+	for _, p := range pairs {
+		if wroteField {
+			b.WriteByte(',')
+		}
+		wroteField = true
+		keyJSON, _ := json.Marshal(p.key)
+		b.Write(keyJSON)
+		b.WriteByte(':')
+		b.WriteString(p.value)
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// PHPSerializedFormatter decodes PHP serialize() output and renders it as
+// pretty-printed JSON.
+type PHPSerializedFormatter struct {
+	jsonFormatter *JSONFormatter
+}
+
+// NewPHPSerializedFormatter creates a new PHP serialized-value formatter.
+func NewPHPSerializedFormatter() *PHPSerializedFormatter {
+	return &PHPSerializedFormatter{
+		jsonFormatter: NewJSONFormatter(),
+	}
+}
+
+// SetJSONIndent changes the indentation used when pretty-printing the
+// decoded tree.
+func (f *PHPSerializedFormatter) SetJSONIndent(indent string) {
+	f.jsonFormatter.SetIndent(indent)
+}
+
+// SetSortKeys enables or disables alphabetical key sorting when
+// pretty-printing the decoded tree.
+func (f *PHPSerializedFormatter) SetSortKeys(sort bool) {
+	f.jsonFormatter.SetSortKeys(sort)
+}
+
+// Format decodes data as PHP serialize() output and renders it as
+// pretty-printed JSON. Malformed input is returned as an error so callers
+// (see AutoFormatter) can fall back to plain text.
+func (f *PHPSerializedFormatter) Format(data []byte) (string, error) {
+	decoded, err := DecodePHPSerialized(data)
+	if err != nil {
+		return "", fmt.Errorf("invalid PHP serialized data: %w", err)
+	}
+	return f.jsonFormatter.Format([]byte(decoded))
+}